@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+var getAPIKeysCmd = &cobra.Command{
+	Use:   "get-api-keys",
+	Short: "Lists the API keys, revoked ones included.",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, auth := userClient()
+		r, err := client.GetApiKeysWithResponse(context.Background(), auth)
+		if err != nil {
+			log.Fatalf("Failed to list the API keys: %v", err)
+		}
+		if r.JSON200 == nil {
+			fmt.Println("An unexpected error occurred")
+			return
+		}
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"ID", "Name", "Scopes", "Created By", "Expires", "Revoked"})
+		table.SetBorder(true)
+		table.SetColumnSeparator(":")
+		for _, k := range r.JSON200.ApiKeys {
+			expires, revoked := "", ""
+			if k.ExpiresAt != nil {
+				expires = k.ExpiresAt.Local().Format(time.DateTime)
+			}
+			if k.RevokedAt != nil {
+				revoked = k.RevokedAt.Local().Format(time.DateTime)
+			}
+			table.Append([]string{k.Id, k.Name, strings.Join(k.Scopes, " "), k.CreatedBy, expires, revoked})
+		}
+		table.Render()
+	},
+}
+
+var createAPIKeyCmd = &cobra.Command{
+	Use:   "create-api-key",
+	Short: "Creates an API key for a script or device, printing the key, which cannot be shown again.",
+	Run: func(cmd *cobra.Command, args []string) {
+		name, _ := cmd.Flags().GetString("name")
+		scopes, _ := cmd.Flags().GetStringSlice("scopes")
+		expiresIn, _ := cmd.Flags().GetDuration("expires-in")
+		body := v1.CreateApiKeyJSONRequestBody{Name: name, Scopes: scopes}
+		if expiresIn > 0 {
+			expiresAt := time.Now().Add(expiresIn)
+			body.ExpiresAt = &expiresAt
+		}
+		client, auth := userClient()
+		r, err := client.CreateApiKeyWithResponse(context.Background(), body, auth)
+		if err != nil {
+			log.Fatalf("Failed to create API key %s: %v", name, err)
+		}
+		if r.JSON201 != nil {
+			fmt.Printf("Created API key %s (%s)\n", r.JSON201.ApiKey.Id, r.JSON201.ApiKey.Name)
+			fmt.Printf("Key: %s\n", r.JSON201.Key)
+			fmt.Println("Store it now; it cannot be shown again.")
+		} else if r.ApplicationproblemJSON400 != nil {
+			fmt.Printf("Invalid API key: %s\n", r.ApplicationproblemJSON400.Detail)
+		} else {
+			fmt.Println("An unexpected error occurred")
+		}
+	},
+}
+
+var revokeAPIKeyCmd = &cobra.Command{
+	Use:   "revoke-api-key",
+	Short: "Revokes an API key, which is rejected from then on.",
+	Run: func(cmd *cobra.Command, args []string) {
+		id, _ := cmd.Flags().GetString("id")
+		client, auth := userClient()
+		r, err := client.RevokeApiKeyWithResponse(context.Background(), id, auth)
+		if err != nil {
+			log.Fatalf("Failed to revoke API key %s: %v", id, err)
+		}
+		if r.JSON200 != nil {
+			fmt.Printf("Revoked API key %s (%s)\n", r.JSON200.Id, r.JSON200.Name)
+		} else if r.ApplicationproblemJSON404 != nil {
+			fmt.Printf("API key %s not found\n", id)
+		} else {
+			fmt.Println("An unexpected error occurred")
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(getAPIKeysCmd)
+	rootCmd.AddCommand(createAPIKeyCmd)
+	rootCmd.AddCommand(revokeAPIKeyCmd)
+	createAPIKeyCmd.Flags().StringP("name", "", "", "What the key is for, such as the name of the device")
+	createAPIKeyCmd.MarkFlagRequired("name")
+	createAPIKeyCmd.Flags().StringSliceP("scopes", "", nil, "Scopes the key grants, such as vending:read,restock:write")
+	createAPIKeyCmd.MarkFlagRequired("scopes")
+	createAPIKeyCmd.Flags().DurationP("expires-in", "", 0, "How long the key is valid, such as 2160h. Valid until revoked unless given")
+	revokeAPIKeyCmd.Flags().StringP("id", "", "", "ID of the API key")
+	revokeAPIKeyCmd.MarkFlagRequired("id")
+}
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"colaco-api/svc"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// auditPage is how many records are fetched per request, the most the API
+// returns.
+const auditPage = 1000
+
+// fetchAuditTrail returns the whole audit trail, oldest first.
+func fetchAuditTrail() []v1.AuditRecord {
+	client, auth := userClient()
+	limit := auditPage
+	params := &v1.GetAuditTrailParams{Limit: &limit}
+	var records []v1.AuditRecord
+	for {
+		r, err := client.GetAuditTrailWithResponse(context.Background(), params, auth)
+		if err != nil {
+			log.Fatalf("Failed to fetch the audit trail: %v", err)
+		}
+		if r.JSON200 == nil {
+			log.Fatalf("Failed to fetch the audit trail: %s", r.Status())
+		}
+		records = append(records, r.JSON200.Records...)
+		if r.JSON200.NextCursor == nil {
+			return records
+		}
+		params.Cursor = r.JSON200.NextCursor
+	}
+}
+
+var getAuditTrailCmd = &cobra.Command{
+	Use:   "get-audit-trail",
+	Short: "Lists the privileged calls recorded in the audit trail, oldest first.",
+	Run: func(cmd *cobra.Command, args []string) {
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Seq", "Time", "Subject", "Source IP", "Request ID", "Operation", "Target"})
+		table.SetBorder(true)
+		table.SetColumnSeparator(":")
+		for _, r := range fetchAuditTrail() {
+			table.Append([]string{strconv.FormatInt(r.Seq, 10), r.Timestamp.Local().Format(time.DateTime),
+				r.Subject, r.SourceIp, r.RequestId, r.Operation, r.Target})
+		}
+		table.Render()
+	},
+}
+
+var verifyAuditTrailCmd = &cobra.Command{
+	Use:   "verify-audit-trail",
+	Short: "Checks that no record of the audit trail was changed, removed or inserted.",
+	Run: func(cmd *cobra.Command, args []string) {
+		head, _ := cmd.Flags().GetString("head")
+		records := fetchAuditTrail()
+		err := svc.VerifyAuditTrail(records)
+		if head != "" && !containsAuditHash(records, head) {
+			err = errors.Join(err, fmt.Errorf("%w: no record has the hash %s, the trail was truncated or rewritten", svc.ErrAuditTrailBroken, head))
+		}
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		if len(records) == 0 {
+			fmt.Println("The audit trail is empty")
+			return
+		}
+		last := records[len(records)-1]
+		fmt.Printf("The audit trail of %d records is intact\n", len(records))
+		fmt.Printf("Head: record %d, hash %s\n", last.Seq, last.Hash)
+		fmt.Println("Keep the hash and pass it as --head next time to detect records removed from the end.")
+	},
+}
+
+// containsAuditHash reports whether a record of records has the hash.
+func containsAuditHash(records []v1.AuditRecord, hash string) bool {
+	for _, r := range records {
+		if r.Hash == hash {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	rootCmd.AddCommand(getAuditTrailCmd)
+	rootCmd.AddCommand(verifyAuditTrailCmd)
+	verifyAuditTrailCmd.Flags().StringP("head", "", "", "Hash of the last record of an earlier verification, which must still be in the trail")
+}
@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"colaco-api/svc"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+var getCashBoxCmd = &cobra.Command{
+	Use:   "get-cashbox",
+	Short: "Shows the coins and bills in the cash box.",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := v1.NewClientWithResponses(serverURL)
+		if err != nil {
+			log.Fatalf("couldn't create client with error: %v", err)
+		}
+		token, err := authenticate(client)
+		if err != nil {
+			log.Fatalf("authentication failed: %v", err)
+		}
+
+		r, err := client.GetCashBoxWithResponse(context.Background(), func(ctx context.Context, req *http.Request) error {
+			return addAuthHeader(ctx, req, token)
+		})
+		if err != nil {
+			log.Fatalf("Failed to get the cash box: %v", err)
+		}
+
+		if r.JSON200 != nil {
+			printCashBoxTable(*r.JSON200)
+		} else {
+			fmt.Println("An unexpected error occurred")
+		}
+	},
+}
+
+var fillCashBoxCmd = &cobra.Command{
+	Use:   "fill-cashbox",
+	Short: "Adds coins and bills to the cash box.",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := v1.NewClientWithResponses(serverURL)
+		if err != nil {
+			log.Fatalf("couldn't create client with error: %v", err)
+		}
+		token, err := authenticate(client)
+		if err != nil {
+			log.Fatalf("authentication failed: %v", err)
+		}
+
+		coins, err := denominationsFlag(cmd, "coins")
+		if err != nil {
+			log.Fatalf("couldn't read coins flag: %v", err)
+		}
+		body := v1.FillCashBoxJSONRequestBody{Denominations: coins}
+		if currency, _ := cmd.Flags().GetString("currency"); currency != "" {
+			body.Currency = &currency
+		}
+
+		r, err := client.FillCashBoxWithResponse(context.Background(), body, func(ctx context.Context, req *http.Request) error {
+			return addAuthHeader(ctx, req, token)
+		})
+		if err != nil {
+			log.Fatalf("Failed to fill the cash box: %v", err)
+		}
+
+		if r.JSON200 != nil {
+			printCashBoxTable(*r.JSON200)
+		} else if r.ApplicationproblemJSON400 != nil {
+			fmt.Printf("Invalid coins: %s\n", r.ApplicationproblemJSON400.Detail)
+		} else if r.ApplicationproblemJSON409 != nil {
+			fmt.Printf("Cannot fill the cash box: %s\n", r.ApplicationproblemJSON409.Detail)
+		} else {
+			fmt.Println("An unexpected error occurred")
+		}
+	},
+}
+
+var emptyCashBoxCmd = &cobra.Command{
+	Use:   "empty-cashbox",
+	Short: "Takes coins and bills out of the cash box, all of them unless --coins is given.",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := v1.NewClientWithResponses(serverURL)
+		if err != nil {
+			log.Fatalf("couldn't create client with error: %v", err)
+		}
+		token, err := authenticate(client)
+		if err != nil {
+			log.Fatalf("authentication failed: %v", err)
+		}
+
+		coins, err := denominationsFlag(cmd, "coins")
+		if err != nil {
+			log.Fatalf("couldn't read coins flag: %v", err)
+		}
+		var body v1.EmptyCashBoxJSONRequestBody
+		if len(coins) > 0 {
+			body.Denominations = &coins
+		}
+
+		r, err := client.EmptyCashBoxWithResponse(context.Background(), body, func(ctx context.Context, req *http.Request) error {
+			return addAuthHeader(ctx, req, token)
+		})
+		if err != nil {
+			log.Fatalf("Failed to empty the cash box: %v", err)
+		}
+
+		if r.JSON200 != nil {
+			printCashBoxTable(*r.JSON200)
+		} else if r.ApplicationproblemJSON400 != nil {
+			fmt.Printf("Invalid coins: %s\n", r.ApplicationproblemJSON400.Detail)
+		} else if r.ApplicationproblemJSON409 != nil {
+			fmt.Printf("Cannot empty the cash box: %s\n", r.ApplicationproblemJSON409.Detail)
+		} else {
+			fmt.Println("An unexpected error occurred")
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(getCashBoxCmd)
+	rootCmd.AddCommand(fillCashBoxCmd)
+	rootCmd.AddCommand(emptyCashBoxCmd)
+	fillCashBoxCmd.Flags().StringSliceP("coins", "", nil, "Coins and bills to add as VALUE=COUNT in minor units, such as 25=40,100=10")
+	fillCashBoxCmd.Flags().StringP("currency", "", "", "ISO 4217 currency to switch an empty cash box to")
+	fillCashBoxCmd.MarkFlagRequired("coins")
+	emptyCashBoxCmd.Flags().StringSliceP("coins", "", nil, "Coins and bills to take out as VALUE=COUNT in minor units; everything when omitted")
+}
+
+func printCashBoxTable(box v1.CashBox) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.TabIndent)
+	fmt.Fprintln(w, "Denomination\tCount\tValue")
+	for _, d := range box.Denominations {
+		fmt.Fprintf(w, "%s\t%d\t%s\n",
+			svc.FormatMoney(v1.Money{Amount: d.Value, Currency: box.Currency}),
+			d.Count,
+			svc.FormatMoney(v1.Money{Amount: d.Value * int64(d.Count), Currency: box.Currency}),
+		)
+	}
+	if box.Total != nil {
+		fmt.Fprintf(w, "Total\t\t%s\n", svc.FormatMoney(*box.Total))
+	}
+	w.Flush()
+}
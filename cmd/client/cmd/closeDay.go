@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"colaco-api/svc"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+var closeDayCmd = &cobra.Command{
+	Use:   "close-day",
+	Short: "Closes the current period, reconciling the counted cash, and prints its end-of-day report",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := v1.NewClientWithResponses(serverURL)
+		if err != nil {
+			log.Fatalf("couldn't create client with error: %v", err)
+		}
+		token, err := authenticate(client)
+		if err != nil {
+			log.Fatalf("authentication failed: %v", err)
+		}
+
+		counted, err := moneyFlag(cmd, "counted")
+		if err != nil {
+			log.Fatalf("counted cash must be provided: %v", err)
+		}
+		body := v1.CloseDayJSONRequestBody{CountedCash: counted}
+		if note, _ := cmd.Flags().GetString("note"); note != "" {
+			body.Note = &note
+		}
+
+		r, err := client.CloseDayWithResponse(context.Background(), body, func(ctx context.Context, req *http.Request) error {
+			return addAuthHeader(ctx, req, token)
+		})
+		if err != nil {
+			log.Fatalf("Failed to close the day: %v", err)
+		}
+
+		if r.JSON200 != nil {
+			displayDayClose(*r.JSON200)
+		} else if r.ApplicationproblemJSON400 != nil {
+			fmt.Printf("Invalid counted cash: %s\n", r.ApplicationproblemJSON400.Detail)
+		} else if r.ApplicationproblemJSON409 != nil {
+			fmt.Printf("Cannot close the day: %s\n", r.ApplicationproblemJSON409.Detail)
+		} else {
+			fmt.Println("An unexpected error occurred")
+		}
+	},
+}
+
+var getPeriodsCmd = &cobra.Command{
+	Use:   "get-periods",
+	Short: "Lists the closed periods, or prints the end-of-day report of one with --id",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := v1.NewClientWithResponses(serverURL)
+		if err != nil {
+			log.Fatalf("couldn't create client with error: %v", err)
+		}
+		token, err := authenticate(client)
+		if err != nil {
+			log.Fatalf("authentication failed: %v", err)
+		}
+		auth := func(ctx context.Context, req *http.Request) error {
+			return addAuthHeader(ctx, req, token)
+		}
+
+		if id, _ := cmd.Flags().GetInt64("id"); id > 0 {
+			r, err := client.GetDayCloseWithResponse(context.Background(), id, auth)
+			if err != nil {
+				log.Fatalf("Failed to get period %d: %v", id, err)
+			}
+			if r.JSON200 != nil {
+				displayDayClose(*r.JSON200)
+			} else if r.ApplicationproblemJSON404 != nil {
+				fmt.Printf("Period %d not found\n", id)
+			} else {
+				fmt.Println("An unexpected error occurred")
+			}
+			return
+		}
+
+		r, err := client.GetDayClosesWithResponse(context.Background(), auth)
+		if err != nil {
+			log.Fatalf("Failed to list the periods: %v", err)
+		}
+		if r.JSON200 == nil {
+			fmt.Println("An unexpected error occurred")
+			return
+		}
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"ID", "Opened", "Closed", "Closed By", "Expected Cash", "Counted Cash", "Variance"})
+		table.SetBorder(true)
+		table.SetColumnSeparator(":")
+		for _, p := range r.JSON200.Periods {
+			table.Append([]string{
+				strconv.FormatInt(svc.DayCloseID(p), 10),
+				p.OpenedAt.Local().Format(time.DateTime),
+				p.ClosedAt.Local().Format(time.DateTime),
+				p.ClosedBy,
+				svc.FormatMoney(p.ExpectedCash),
+				svc.FormatMoney(p.CountedCash),
+				formatVariance(p),
+			})
+		}
+		table.Render()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(closeDayCmd)
+	rootCmd.AddCommand(getPeriodsCmd)
+	closeDayCmd.Flags().StringP("counted", "", "", "Cash counted in the machine, such as 152.75")
+	closeDayCmd.Flags().StringP("currency", "", svc.DefaultCurrency, "ISO 4217 currency of the counted cash")
+	closeDayCmd.Flags().StringP("note", "", "", "Note to keep with the report")
+	closeDayCmd.MarkFlagRequired("counted")
+	getPeriodsCmd.Flags().Int64P("id", "", 0, "ID of the closed period to print the report of")
+}
+
+// formatVariance formats the signed difference between counted and expected
+// cash, such as -0.10 USD.
+func formatVariance(p v1.DayClose) string {
+	variance := v1.Money{Amount: p.Variance, Currency: p.ExpectedCash.Currency}
+	if p.Variance < 0 {
+		variance.Amount = -variance.Amount
+		return "-" + svc.FormatMoney(variance)
+	}
+	return svc.FormatMoney(variance)
+}
+
+func displayDayClose(p v1.DayClose) {
+	fmt.Printf("Period %d: %s to %s, closed by %s\n", svc.DayCloseID(p),
+		p.OpenedAt.Local().Format(time.DateTime), p.ClosedAt.Local().Format(time.DateTime), p.ClosedBy)
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Slot", "Soda", "Opening", "Sold", "Restocked", "Leftover", "Closing"})
+	table.SetBorder(true)
+	table.SetColumnSeparator(":")
+	for _, s := range p.Slots {
+		soda := ""
+		if s.SodaName != nil {
+			soda = *s.SodaName
+		}
+		table.Append([]string{
+			s.SlotId,
+			soda,
+			strconv.Itoa(s.OpeningStock),
+			strconv.Itoa(s.UnitsSold),
+			strconv.Itoa(s.Restocked),
+			strconv.Itoa(s.Leftover),
+			strconv.Itoa(s.ClosingStock),
+		})
+	}
+	table.Render()
+
+	cash := tablewriter.NewWriter(os.Stdout)
+	cash.SetHeader([]string{"Attribute", "Details"})
+	cash.SetBorder(true)
+	cash.SetColumnSeparator(":")
+	for _, revenue := range p.Revenue {
+		cash.Append([]string{"Revenue", svc.FormatMoney(revenue)})
+	}
+	cash.Append([]string{"Expected Cash", svc.FormatMoney(p.ExpectedCash)})
+	cash.Append([]string{"Counted Cash", svc.FormatMoney(p.CountedCash)})
+	cash.Append([]string{"Variance", formatVariance(p)})
+	if p.Note != nil {
+		cash.Append([]string{"Note", *p.Note})
+	}
+	cash.Render()
+}
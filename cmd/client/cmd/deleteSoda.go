@@ -1,13 +1,12 @@
 package cmd
 
 import (
-	v1 "colaco-api/internal/api/v1"
+	v2 "colaco-api/internal/api/v2"
 	"context"
 	"fmt"
 	"github.com/spf13/cobra"
 	"log"
 	"net/http"
-	"strings"
 )
 
 // deleteSodaCmd represents the deleteSoda command
@@ -15,41 +14,25 @@ var deleteSodaCmd = &cobra.Command{
 	Use:   "delete-soda",
 	Short: "deletes soda from the vending machine by removing the vending slot",
 	Run: func(cmd *cobra.Command, args []string) {
-		client, err := v1.NewClientWithResponses(serverURL)
-		if err != nil {
-			log.Fatalf("couldn't create client with error: %v", err)
-		}
-		resp, err := client.AuthLoginWithResponse(context.Background(), v1.AuthLoginJSONRequestBody{
-			Username: username,
-			Password: password,
-		})
-		var token string
-		if resp.JSON200 != nil {
-			token = *resp.JSON200.Token
-		} else {
-			log.Println("Authentication failed or did not return a token")
-		}
-
-		authHeaderEditor := func(ctx context.Context, req *http.Request) error {
-			req.Header.Add("Content-Type", "application/json")
-			req.Header.Set("Authorization", "Bearer "+token)
-			return nil
-		}
+		client, auth := v2Client()
 
 		soda, err := cmd.Flags().GetString("soda")
 		if err != nil || soda == "" {
 			log.Fatalf("soda name must be provided: %v", err)
 		}
-		soda = strings.ToLower(soda)
 
-		r, err := client.DeleteVendingWithResponse(context.Background(), v1.DeleteVendingJSONRequestBody{
-			Name: strings.ToLower(soda),
-		}, authHeaderEditor)
-		if r.JSON200 != nil {
+		r, err := client.DeleteSlotWithResponse(context.Background(), soda, &v2.DeleteSlotParams{IfMatch: ifMatchFlag(cmd)}, auth)
+		if err != nil {
+			log.Fatalf("Failed to delete the soda: %v", err)
+		}
+		switch r.StatusCode() {
+		case http.StatusNoContent:
 			fmt.Println("soda deleted successfully")
-		} else if r.JSON404 != nil {
+		case http.StatusNotFound:
 			fmt.Printf("soda not found: %v\n", soda)
-		} else {
+		case http.StatusPreconditionFailed:
+			fmt.Printf("soda was changed by someone else and was not deleted: %v\n", r.ApplicationproblemJSON412.Detail)
+		default:
 			fmt.Println("something went wrong")
 		}
 
@@ -58,5 +41,6 @@ var deleteSodaCmd = &cobra.Command{
 
 func init() {
 	rootCmd.AddCommand(deleteSodaCmd)
-	deleteSodaCmd.Flags().StringP("soda", "", "", "soda to delete from the vending machine")
+	deleteSodaCmd.Flags().StringP("soda", "", "", "slot of the soda to delete from the vending machine, such as A1")
+	deleteSodaCmd.Flags().StringP("if-match", "", "", "Only apply the change if the slot still has this ETag, as printed by a previous command")
 }
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var exportDexCmd = &cobra.Command{
+	Use:   "export-dex",
+	Short: "Exports a DEX/UCS (EVA-DTS) audit file of the machine for back-office software",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := v1.NewClientWithResponses(serverURL)
+		if err != nil {
+			log.Fatalf("couldn't create client with error: %v", err)
+		}
+		token, err := authenticate(client)
+		if err != nil {
+			log.Fatalf("authentication failed: %v", err)
+		}
+
+		r, err := client.GetDexAuditWithResponse(context.Background(), func(ctx context.Context, req *http.Request) error {
+			return addAuthHeader(ctx, req, token)
+		})
+		if err != nil {
+			log.Fatalf("Failed to export the DEX audit: %v", err)
+		}
+		if r.StatusCode() != http.StatusOK {
+			fmt.Printf("An unexpected error occurred: %s\n", r.Status())
+			return
+		}
+		out, _ := cmd.Flags().GetString("out")
+		if out == "" {
+			os.Stdout.Write(r.Body)
+			return
+		}
+		if err := os.WriteFile(out, r.Body, 0o644); err != nil {
+			log.Fatalf("couldn't write DEX audit: %v", err)
+		}
+		fmt.Printf("DEX audit written to %s\n", out)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(exportDexCmd)
+	exportDexCmd.Flags().StringP("out", "", "", "File to write the audit to instead of stdout")
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+var getCatalogCmd = &cobra.Command{
+	Use:   "get-catalog",
+	Short: "Lists the sodas in the catalog, whether or not a slot holds them.",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := v1.NewClientWithResponses(serverURL)
+		if err != nil {
+			log.Fatalf("couldn't create client with error: %v", err)
+		}
+		token, err := authenticate(client)
+		if err != nil {
+			log.Fatalf("authentication failed: %v", err)
+		}
+
+		r, err := client.GetSodasWithResponse(context.Background(), func(ctx context.Context, req *http.Request) error {
+			return addAuthHeader(ctx, req, token)
+		})
+		if err != nil {
+			log.Fatalf("Failed to get the catalog: %v", err)
+		}
+
+		if r.JSON200 != nil && r.JSON200.Sodas != nil {
+			printCatalogTable(*r.JSON200.Sodas)
+		} else {
+			fmt.Println("An unexpected error occurred")
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(getCatalogCmd)
+}
+
+func printCatalogTable(sodas []v1.Soda) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.TabIndent)
+	fmt.Fprintln(w, "ID\tSoda Name\tCalories\tOunces\tDescription")
+	for _, soda := range sodas {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%.2f\t%s\n",
+			valueOr(soda.Id),
+			valueOr(soda.Name),
+			valueOr(soda.Calories),
+			valueOr(soda.Ounces),
+			valueOr(soda.Description),
+		)
+	}
+	w.Flush()
+}
+
+// valueOr dereferences p, returning the zero value when it is nil.
+func valueOr[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
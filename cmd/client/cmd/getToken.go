@@ -2,10 +2,10 @@ package cmd
 
 import (
 	v1 "colaco-api/internal/api/v1"
-	"context"
 	"fmt"
 	"github.com/spf13/cobra"
 	"log"
+	"time"
 )
 
 // authLoginCmd represents the authLogin command
@@ -13,19 +13,20 @@ var authLoginCmd = &cobra.Command{
 	Use:   "get-token",
 	Short: "gets token from the server that can be used with other tooling such as postman.",
 	Run: func(cmd *cobra.Command, args []string) {
+		if apiKey != "" {
+			log.Fatalln("API keys are sent as they are; there is no token to get")
+		}
 		client, err := v1.NewClientWithResponses(serverURL)
 		if err != nil {
 			log.Fatalf("couldn't create client with error: %v", err)
 		}
-		resp, err := client.AuthLoginWithResponse(context.Background(), v1.AuthLoginJSONRequestBody{
-			Username: username,
-			Password: password,
-		})
-		if resp.JSON200 != nil {
-			token := resp.JSON200.Token
-			fmt.Printf("Token: %s\n", *token)
-		} else {
-			log.Println("Authentication failed or did not return a token")
+		token, err := authenticate(client)
+		if err != nil {
+			log.Fatalf("authentication failed: %v", err)
+		}
+		fmt.Printf("Token: %s\n", token)
+		if s := loadSession(); s != nil {
+			fmt.Printf("Expires: %s\n", s.ExpiresAt.Local().Format(time.DateTime))
 		}
 
 	},
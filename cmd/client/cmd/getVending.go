@@ -2,10 +2,11 @@ package cmd
 
 import (
 	v1 "colaco-api/internal/api/v1"
+	v2 "colaco-api/internal/api/v2"
+	"colaco-api/svc"
 	"context"
 	"fmt"
 	"log"
-	"net/http"
 	"os"
 	"text/tabwriter"
 
@@ -16,50 +17,68 @@ var getVendingCmd = &cobra.Command{
 	Use:   "get-sodas",
 	Short: "Gathers all the sodas that are in the vending slots.",
 	Run: func(cmd *cobra.Command, args []string) {
-		client, err := v1.NewClientWithResponses(serverURL)
-		if err != nil {
-			log.Fatalf("couldn't create client with error: %v", err)
+		params := &v2.ListSlotsParams{}
+		if name, _ := cmd.Flags().GetString("name"); name != "" {
+			params.Name = &name
 		}
-		token, err := authenticate(client)
-		if err != nil {
-			log.Fatalf("authentication failed: %v", err)
+		if stock, _ := cmd.Flags().GetString("stock"); stock != "" {
+			params.Stock = &stock
+		}
+		if sort, _ := cmd.Flags().GetString("sort"); sort != "" {
+			params.Sort = &sort
+		}
+		if cmd.Flags().Changed("min-price") {
+			price, _ := cmd.Flags().GetFloat32("min-price")
+			amount := svc.NewMoney(price, svc.DefaultCurrency).Amount
+			params.MinPrice = &amount
+		}
+		if cmd.Flags().Changed("max-price") {
+			price, _ := cmd.Flags().GetFloat32("max-price")
+			amount := svc.NewMoney(price, svc.DefaultCurrency).Amount
+			params.MaxPrice = &amount
 		}
 
-		r, err := client.GetVendingWithResponse(context.Background(), v1.GetVendingJSONRequestBody{Name: ""}, func(ctx context.Context, req *http.Request) error {
-			return addAuthHeader(ctx, req, token)
-		})
+		client, auth := v2Client()
+		r, err := client.ListSlotsWithResponse(context.Background(), params, auth)
 		if err != nil {
 			log.Fatalf("Failed to get sodas: %v", err)
 		}
 
-		if r.JSON200 != nil {
-			printSodaTable(*r.JSON200.Slots)
-		} else if r.JSON404 != nil {
+		if r.ApplicationproblemJSON400 != nil {
+			fmt.Println(r.ApplicationproblemJSON400.Detail)
+		} else if r.JSON200 == nil {
+			fmt.Println("An unexpected error occurred")
+		} else if len(r.JSON200.Slots) == 0 {
 			fmt.Println("No sodas found")
 		} else {
-			fmt.Println("An unexpected error occurred")
+			printSodaTable(r.JSON200.Slots)
 		}
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(getVendingCmd)
+	getVendingCmd.Flags().String("name", "", "Only list the sodas whose name contains this text")
+	getVendingCmd.Flags().Float32("min-price", 0, "Only list the sodas costing at least this many dollars")
+	getVendingCmd.Flags().Float32("max-price", 0, "Only list the sodas costing at most this many dollars")
+	getVendingCmd.Flags().String("stock", "", "Only list the slots in_stock, low_stock or sold_out")
+	getVendingCmd.Flags().String("sort", "", "Order by id, name, price, calories, ounces or quantity, prefixed with - to reverse it")
 }
 
-func printSodaTable(sodas []v1.VendingSlot) {
+func printSodaTable(slots []v2.Slot) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.TabIndent)
-	fmt.Fprintln(w, "Soda Name\tCalories\tOunces\tCost\tQuantity\tDescription")
-	for _, soda := range sodas {
-		if soda.OccupiedSoda != nil {
-			fmt.Fprintf(w, "%s\t%d\t%.2f\t%.2f\t%d\t%s\n",
-				*soda.OccupiedSoda.Name,
-				*soda.OccupiedSoda.Calories,
-				*soda.OccupiedSoda.Ounces,
-				*soda.Cost,
-				*soda.Quantity,
-				*soda.OccupiedSoda.Description,
-			)
-		}
+	fmt.Fprintln(w, "Slot\tSoda Name\tCalories\tOunces\tPrice\tQuantity\tETag\tDescription")
+	for _, slot := range slots {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%.2f\t%s\t%d\t\"%d\"\t%s\n",
+			slot.Id,
+			valueOr(slot.Soda.Name),
+			valueOr(slot.Soda.Calories),
+			valueOr(slot.Soda.Ounces),
+			svc.FormatMoney(v1.Money(slot.Price)),
+			slot.Quantity,
+			slot.Version,
+			valueOr(slot.Soda.Description),
+		)
 	}
 	w.Flush()
 }
@@ -2,13 +2,44 @@ package cmd
 
 import (
 	v1 "colaco-api/internal/api/v1"
+	v2 "colaco-api/internal/api/v2"
+	"colaco-api/svc"
 	"context"
 	"fmt"
 	"log"
 	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
 )
 
+// authenticate returns an access token for the user. The token of the saved
+// session is reused until it expires, after which it is transparently
+// refreshed with the refresh token of the session, and only when that fails
+// too the client logs in with the password again. With an API key there is
+// no token: addAuthHeader sends the key instead.
 func authenticate(client *v1.ClientWithResponses) (string, error) {
+	if apiKey != "" {
+		return "", nil
+	}
+	s := loadSession()
+	if s != nil && s.accessValid() {
+		return s.Token, nil
+	}
+	if s != nil && s.refreshValid() {
+		resp, err := client.AuthRefreshWithResponse(context.Background(), v1.AuthRefreshJSONRequestBody{
+			RefreshToken: s.RefreshToken,
+		})
+		if err == nil && resp.JSON200 != nil {
+			return saveSession(*resp.JSON200), nil
+		}
+	}
+	return login(client)
+}
+
+// login logs in with the username and password and saves the session.
+func login(client *v1.ClientWithResponses) (string, error) {
 	resp, err := client.AuthLoginWithResponse(context.Background(), v1.AuthLoginJSONRequestBody{
 		Username: username,
 		Password: password,
@@ -17,14 +48,83 @@ func authenticate(client *v1.ClientWithResponses) (string, error) {
 		return "", err
 	}
 	if resp.JSON200 != nil {
-		return *resp.JSON200.Token, nil
+		return saveSession(*resp.JSON200), nil
+	}
+	if resp.ApplicationproblemJSON429 != nil {
+		return "", fmt.Errorf("too many failed logins, try again in %s seconds", resp.HTTPResponse.Header.Get("Retry-After"))
 	}
 	log.Println("Authentication failed or did not return a token")
 	return "", fmt.Errorf("authentication failed")
 }
 
+// ifMatchFlag returns the value of the --if-match flag, or nil when it was
+// not given so that the request carries no If-Match header.
+func ifMatchFlag(cmd *cobra.Command) *v1.IfMatch {
+	etag, err := cmd.Flags().GetString("if-match")
+	if err != nil || etag == "" {
+		return nil
+	}
+	return &etag
+}
+
+// moneyFlag parses the amount in the named flag, such as 1.50, in the
+// currency of the --currency flag.
+func moneyFlag(cmd *cobra.Command, name string) (v1.Money, error) {
+	amount, err := cmd.Flags().GetString(name)
+	if err != nil {
+		return v1.Money{}, err
+	}
+	currency, err := cmd.Flags().GetString("currency")
+	if err != nil {
+		return v1.Money{}, err
+	}
+	return svc.ParseMoney(amount, currency)
+}
+
+// denominationsFlag parses the named flag, a list of VALUE=COUNT pairs such
+// as 25=4,100=1 with values in the minor unit of the currency.
+func denominationsFlag(cmd *cobra.Command, name string) ([]v1.Denomination, error) {
+	pairs, err := cmd.Flags().GetStringSlice(name)
+	if err != nil {
+		return nil, err
+	}
+	var ds []v1.Denomination
+	for _, pair := range pairs {
+		value, count, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("%q is not VALUE=COUNT", pair)
+		}
+		v, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing value of %q: %w", pair, err)
+		}
+		c, err := strconv.Atoi(strings.TrimSpace(count))
+		if err != nil {
+			return nil, fmt.Errorf("parsing count of %q: %w", pair, err)
+		}
+		ds = append(ds, v1.Denomination{Value: v, Count: c})
+	}
+	return ds, nil
+}
+
+// v2Client returns a client of version 2 of the API and the request editor
+// that authorizes its requests, which are authorized like those of version 1,
+// see userClient.
+func v2Client() (*v2.ClientWithResponses, v2.RequestEditorFn) {
+	_, auth := userClient()
+	client, err := v2.NewClientWithResponses(serverURL)
+	if err != nil {
+		log.Fatalf("couldn't create client with error: %v", err)
+	}
+	return client, v2.RequestEditorFn(auth)
+}
+
 func addAuthHeader(ctx context.Context, req *http.Request, token string) error {
 	req.Header.Add("Content-Type", "application/json")
+	if apiKey != "" {
+		req.Header.Set("X-API-Key", apiKey)
+		return nil
+	}
 	req.Header.Set("Authorization", "Bearer "+token)
 	return nil
 }
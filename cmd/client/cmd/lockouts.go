@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+var getLockoutsCmd = &cobra.Command{
+	Use:   "get-lockouts",
+	Short: "Lists the usernames and client addresses blocked after failed logins.",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, auth := userClient()
+		r, err := client.GetLoginLockoutsWithResponse(context.Background(), auth)
+		if err != nil {
+			log.Fatalf("Failed to list the lockouts: %v", err)
+		}
+		if r.JSON200 == nil {
+			fmt.Println("An unexpected error occurred")
+			return
+		}
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Kind", "Value", "Failures", "Blocked Until", "Locked Out"})
+		table.SetBorder(true)
+		table.SetColumnSeparator(":")
+		for _, l := range r.JSON200.Lockouts {
+			table.Append([]string{string(l.Kind), l.Value, strconv.Itoa(l.Failures),
+				l.BlockedUntil.Local().Format(time.DateTime), strconv.FormatBool(l.LockedOut)})
+		}
+		table.Render()
+	},
+}
+
+var clearLockoutCmd = &cobra.Command{
+	Use:   "clear-lockout",
+	Short: "Forgets the failed logins of a username or client address, lifting its lockout.",
+	Run: func(cmd *cobra.Command, args []string) {
+		var params v1.ClearLoginLockoutParams
+		if name, _ := cmd.Flags().GetString("name"); name != "" {
+			params.Username = &name
+		}
+		if ip, _ := cmd.Flags().GetString("ip"); ip != "" {
+			params.Ip = &ip
+		}
+		if params.Username == nil && params.Ip == nil {
+			log.Fatalln("--name or --ip is required")
+		}
+		client, auth := userClient()
+		r, err := client.ClearLoginLockoutWithResponse(context.Background(), &params, auth)
+		if err != nil {
+			log.Fatalf("Failed to clear the lockout: %v", err)
+		}
+		if r.JSON200 != nil {
+			fmt.Println(*r.JSON200.Message)
+		} else if r.ApplicationproblemJSON404 != nil {
+			fmt.Println(r.ApplicationproblemJSON404.Detail)
+		} else if r.ApplicationproblemJSON400 != nil {
+			fmt.Printf("Invalid request: %s\n", r.ApplicationproblemJSON400.Detail)
+		} else {
+			fmt.Println("An unexpected error occurred")
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(getLockoutsCmd)
+	rootCmd.AddCommand(clearLockoutCmd)
+	clearLockoutCmd.Flags().StringP("name", "", "", "Username whose failed logins are forgotten")
+	clearLockoutCmd.Flags().StringP("ip", "", "", "Client address whose failed logins are forgotten")
+}
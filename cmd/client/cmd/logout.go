@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/spf13/cobra"
+)
+
+var logoutCmd = &cobra.Command{
+	Use:   "logout",
+	Short: "Revokes the tokens of the saved session and forgets it.",
+	Run: func(cmd *cobra.Command, args []string) {
+		s := loadSession()
+		if s == nil {
+			fmt.Println("Not logged in")
+			return
+		}
+		client, err := v1.NewClientWithResponses(serverURL)
+		if err != nil {
+			log.Fatalf("couldn't create client with error: %v", err)
+		}
+		defer clearSession()
+		if !s.accessValid() && !s.refreshValid() {
+			fmt.Println("Logged out")
+			return
+		}
+		token, err := authenticate(client)
+		if err != nil {
+			log.Fatalf("authentication failed: %v", err)
+		}
+		// Authenticating may have refreshed the session.
+		body := v1.AuthLogoutJSONRequestBody{}
+		if s = loadSession(); s != nil && s.RefreshToken != "" {
+			body.RefreshToken = &s.RefreshToken
+		}
+		r, err := client.AuthLogoutWithResponse(context.Background(), body, func(ctx context.Context, req *http.Request) error {
+			return addAuthHeader(ctx, req, token)
+		})
+		if err != nil {
+			log.Fatalf("Failed to log out: %v", err)
+		}
+		if r.JSON200 != nil {
+			fmt.Println("Logged out")
+		} else {
+			fmt.Println("An unexpected error occurred")
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(logoutCmd)
+}
@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"bytes"
+	v1 "colaco-api/internal/api/v1"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var exportPlanogramCmd = &cobra.Command{
+	Use:   "export-planogram",
+	Short: "Exports the machine layout and the sodas assigned to it as JSON or YAML",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := v1.NewClientWithResponses(serverURL)
+		if err != nil {
+			log.Fatalf("couldn't create client with error: %v", err)
+		}
+		token, err := authenticate(client)
+		if err != nil {
+			log.Fatalf("authentication failed: %v", err)
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		out, _ := cmd.Flags().GetString("out")
+		if format == "" {
+			format = formatFromPath(out)
+		}
+		params := &v1.GetPlanogramParams{}
+		if format != "" {
+			f := v1.GetPlanogramParamsFormat(format)
+			params.Format = &f
+		}
+
+		r, err := client.GetPlanogramWithResponse(context.Background(), params, func(ctx context.Context, req *http.Request) error {
+			return addAuthHeader(ctx, req, token)
+		})
+		if err != nil {
+			log.Fatalf("Failed to export planogram: %v", err)
+		}
+		if r.StatusCode() != http.StatusOK {
+			fmt.Printf("An unexpected error occurred: %s\n", r.Status())
+			return
+		}
+		if out == "" {
+			os.Stdout.Write(r.Body)
+			fmt.Fprintf(os.Stderr, "ETag: %s\n", r.HTTPResponse.Header.Get("ETag"))
+			return
+		}
+		if err := os.WriteFile(out, r.Body, 0o644); err != nil {
+			log.Fatalf("couldn't write planogram: %v", err)
+		}
+		fmt.Printf("Planogram written to %s\n", out)
+		fmt.Printf("ETag: %s\n", r.HTTPResponse.Header.Get("ETag"))
+	},
+}
+
+var importPlanogramCmd = &cobra.Command{
+	Use:   "import-planogram",
+	Short: "Replaces the machine layout and the sodas assigned to it with a JSON or YAML planogram",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := v1.NewClientWithResponses(serverURL)
+		if err != nil {
+			log.Fatalf("couldn't create client with error: %v", err)
+		}
+		token, err := authenticate(client)
+		if err != nil {
+			log.Fatalf("authentication failed: %v", err)
+		}
+
+		file, _ := cmd.Flags().GetString("file")
+		data, err := os.ReadFile(file)
+		if err != nil {
+			log.Fatalf("couldn't read planogram: %v", err)
+		}
+		contentType := "application/json"
+		if formatFromPath(file) == "yaml" {
+			contentType = "application/x-yaml"
+		}
+
+		params := &v1.PutPlanogramParams{IfMatch: ifMatchFlag(cmd)}
+		r, err := client.PutPlanogramWithBodyWithResponse(context.Background(), params, contentType, bytes.NewReader(data), func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("Authorization", "Bearer "+token)
+			return nil
+		})
+		if err != nil {
+			log.Fatalf("Failed to import planogram: %v", err)
+		}
+		switch {
+		case r.StatusCode() == http.StatusOK:
+			fmt.Println("Planogram imported successfully")
+			fmt.Printf("ETag: %s\n", r.HTTPResponse.Header.Get("ETag"))
+		case r.ApplicationproblemJSON400 != nil:
+			fmt.Printf("Planogram rejected: %s\n", r.ApplicationproblemJSON400.Detail)
+		case r.ApplicationproblemJSON409 != nil:
+			fmt.Printf("Planogram conflicts with the catalog: %s\n", r.ApplicationproblemJSON409.Detail)
+		case r.ApplicationproblemJSON412 != nil:
+			fmt.Printf("The machine changed since the planogram was exported: %s\n", r.ApplicationproblemJSON412.Detail)
+		default:
+			fmt.Println("An unexpected error occurred")
+		}
+	},
+}
+
+// formatFromPath returns yaml for files with a YAML extension and json for
+// JSON files, or nothing when the extension says neither.
+func formatFromPath(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return "yaml"
+	case ".json":
+		return "json"
+	}
+	return ""
+}
+
+func init() {
+	rootCmd.AddCommand(exportPlanogramCmd)
+	exportPlanogramCmd.Flags().StringP("format", "", "", "Format of the export, json or yaml; defaults to the extension of --out or json")
+	exportPlanogramCmd.Flags().StringP("out", "", "", "File to write the planogram to instead of stdout")
+
+	rootCmd.AddCommand(importPlanogramCmd)
+	importPlanogramCmd.Flags().StringP("file", "", "", "JSON or YAML planogram to import, by extension")
+	importPlanogramCmd.Flags().StringP("if-match", "", "", "Only import if the machine still has this planogram ETag, as printed by export-planogram")
+	importPlanogramCmd.MarkFlagRequired("file")
+}
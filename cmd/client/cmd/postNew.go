@@ -2,6 +2,7 @@ package cmd
 
 import (
 	v1 "colaco-api/internal/api/v1"
+	"colaco-api/svc"
 	"context"
 	"fmt"
 	"github.com/spf13/cobra"
@@ -26,7 +27,7 @@ var addSodaCmd = &cobra.Command{
 		if err != nil || sodaName == "" {
 			log.Fatalf("name must be provided: %v", err)
 		}
-		price, err := cmd.Flags().GetFloat32("price")
+		price, err := moneyFlag(cmd, "price")
 		if err != nil {
 			log.Fatalf("price must be provided: %v", err)
 		}
@@ -51,9 +52,18 @@ var addSodaCmd = &cobra.Command{
 			log.Fatalf("origin must be provided: %v", err)
 		}
 
+		slotID, err := cmd.Flags().GetString("slot")
+		if err != nil {
+			log.Fatalf("couldn't read slot flag: %v", err)
+		}
+		sodaID, err := cmd.Flags().GetString("id")
+		if err != nil {
+			log.Fatalf("couldn't read id flag: %v", err)
+		}
+
 		newSoda := v1.PostNewJSONRequestBody{
 			Slot: v1.VendingSlot{
-				Cost:     &price,
+				Price:    &price,
 				Quantity: &quantity,
 				OccupiedSoda: &v1.Soda{
 					Name:        &sodaName,
@@ -65,6 +75,13 @@ var addSodaCmd = &cobra.Command{
 			},
 		}
 
+		if slotID != "" {
+			newSoda.Slot.Id = &slotID
+		}
+		if sodaID != "" {
+			newSoda.Slot.OccupiedSoda.Id = &sodaID
+		}
+
 		r, err := client.PostNewWithResponse(context.Background(), newSoda, func(ctx context.Context, req *http.Request) error {
 			return addAuthHeader(ctx, req, token)
 		})
@@ -73,8 +90,8 @@ var addSodaCmd = &cobra.Command{
 		}
 		if r.JSON201 != nil {
 			fmt.Println("Soda added successfully")
-		} else if r.JSON409 != nil {
-			fmt.Println("Soda conflict found. Already exists.")
+		} else if r.ApplicationproblemJSON409 != nil {
+			fmt.Println("Soda conflict found. The slot already exists or the soda differs from the catalog.")
 		} else {
 			fmt.Println("An unexpected error occurred")
 		}
@@ -84,9 +101,12 @@ var addSodaCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(addSodaCmd)
 	addSodaCmd.Flags().StringP("name", "", "", "Name of the soda")
+	addSodaCmd.Flags().StringP("slot", "", "", "ID of the new slot, defaults to the soda name")
+	addSodaCmd.Flags().StringP("id", "", "", "Catalog ID of the soda, defaults to a slug of its name")
 	addSodaCmd.Flags().StringP("origin", "", "", "Origin story of the soda")
 	addSodaCmd.Flags().StringP("description", "", "", "Description of the soda")
-	addSodaCmd.Flags().Float32P("price", "", 0.0, "Price of the soda")
+	addSodaCmd.Flags().StringP("price", "", "", "Price of the soda, such as 1.50")
+	addSodaCmd.Flags().StringP("currency", "", svc.DefaultCurrency, "ISO 4217 currency of the price")
 	addSodaCmd.Flags().IntP("quantity", "", 0, "Initial quantity of the soda")
 	addSodaCmd.Flags().IntP("calories", "", 0, "Calories of the soda")
 	addSodaCmd.Flags().Float32P("ounces", "", 0.0, "Ounces of the soda")
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	v1 "colaco-api/internal/api/v1"
+	"colaco-api/svc"
 	"context"
 	"fmt"
 	"github.com/olekukonko/tablewriter"
@@ -9,6 +10,7 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"strings"
 )
 
 var purchaseSodaCmd = &cobra.Command{
@@ -25,17 +27,38 @@ var purchaseSodaCmd = &cobra.Command{
 		}
 
 		sodaName, err := cmd.Flags().GetString("soda")
-		if err != nil || sodaName == "" {
-			log.Fatalf("soda name must be provided: %v", err)
+		if err != nil {
+			log.Fatalf("couldn't read soda flag: %v", err)
+		}
+		slotID, err := cmd.Flags().GetString("slot")
+		if err != nil {
+			log.Fatalf("couldn't read slot flag: %v", err)
+		}
+		if sodaName == "" && slotID == "" {
+			log.Fatalf("either a soda or a slot must be provided")
 		}
-		payment, err := cmd.Flags().GetFloat32("payment")
+		inserted, err := denominationsFlag(cmd, "insert")
 		if err != nil {
-			log.Fatalf("payment must be provided: %v", err)
+			log.Fatalf("couldn't read insert flag: %v", err)
 		}
 
-		purchaseRequest := v1.PostPurchaseJSONRequestBody{
-			Name:    sodaName,
-			Payment: payment,
+		var purchaseRequest v1.PostPurchaseJSONRequestBody
+		if len(inserted) > 0 {
+			purchaseRequest.Inserted = &inserted
+		} else {
+			payment, err := moneyFlag(cmd, "payment")
+			if err != nil {
+				log.Fatalf("payment must be provided: %v", err)
+			}
+			purchaseRequest.Paid = &payment
+		}
+		target := sodaName
+		if sodaName != "" {
+			purchaseRequest.Name = &sodaName
+		}
+		if slotID != "" {
+			purchaseRequest.SlotId = &slotID
+			target = "slot " + slotID
 		}
 
 		r, err := client.PostPurchaseWithResponse(context.Background(), purchaseRequest, func(ctx context.Context, req *http.Request) error {
@@ -47,8 +70,16 @@ var purchaseSodaCmd = &cobra.Command{
 		if r.JSON200 != nil {
 			displayPurchaseDetails(r.JSON200)
 			fmt.Println("\nEnjoy your drink!")
-		} else if r.JSON402 != nil {
+		} else if r.ApplicationproblemJSON402 != nil {
 			fmt.Printf("Insufficient funds. Please add more funds.")
+		} else if r.ApplicationproblemJSON400 != nil {
+			fmt.Printf("Invalid purchase: %s\n", r.ApplicationproblemJSON400.Detail)
+		} else if r.ApplicationproblemJSON422 != nil {
+			fmt.Printf("Exact change only: the machine cannot give your change. Please insert the exact amount.\n")
+		} else if r.ApplicationproblemJSON409 != nil {
+			fmt.Printf("Sorry, %s is sold out.\n", target)
+		} else if r.ApplicationproblemJSON404 != nil {
+			fmt.Printf("'%s' not found.\n", target)
 		} else {
 			fmt.Println("An unexpected error occurred")
 		}
@@ -57,10 +88,13 @@ var purchaseSodaCmd = &cobra.Command{
 
 func init() {
 	rootCmd.AddCommand(purchaseSodaCmd)
-	purchaseSodaCmd.Flags().StringP("soda", "", "", "Name of the soda to purchase")
-	purchaseSodaCmd.Flags().Float32P("payment", "", 0.0, "Payment amount")
-	purchaseSodaCmd.MarkFlagRequired("soda")
-	purchaseSodaCmd.MarkFlagRequired("payment")
+	purchaseSodaCmd.Flags().StringP("soda", "", "", "Name or ID of the soda to purchase, taken from its fullest slot")
+	purchaseSodaCmd.Flags().StringP("slot", "", "", "ID of the slot to purchase from")
+	purchaseSodaCmd.Flags().StringP("payment", "", "", "Payment amount, such as 1.50")
+	purchaseSodaCmd.Flags().StringP("currency", "", svc.DefaultCurrency, "ISO 4217 currency of the payment")
+	purchaseSodaCmd.Flags().StringSliceP("insert", "", nil, "Coins and bills to pay with as VALUE=COUNT in minor units, such as 100=1,25=2, instead of a payment")
+	purchaseSodaCmd.MarkFlagsOneRequired("payment", "insert")
+	purchaseSodaCmd.MarkFlagsMutuallyExclusive("payment", "insert")
 }
 
 func displayPurchaseDetails(details *v1.PurchaseSodaResponse) {
@@ -68,12 +102,27 @@ func displayPurchaseDetails(details *v1.PurchaseSodaResponse) {
 	table.SetHeader([]string{"Attribute", "Details"})
 	table.SetBorder(true)
 	table.SetColumnSeparator(":")
+	if details.SlotId != nil {
+		table.Append([]string{"Slot", *details.SlotId})
+	}
 	table.Append([]string{"Soda Name", *details.Soda.Name})
 	table.Append([]string{"Description", *details.Soda.Description})
 	table.Append([]string{"Calories", fmt.Sprintf("%d", *details.Soda.Calories)})
 	table.Append([]string{"Volume (Ounces)", fmt.Sprintf("%.1f", *details.Soda.Ounces)})
 	table.Append([]string{"Origin Story", *details.Soda.OriginStory})
-	table.Append([]string{"Change Returned", fmt.Sprintf("$%.2f", *details.Change)})
+	if details.ChangeDue != nil {
+		table.Append([]string{"Change Returned", svc.FormatMoney(*details.ChangeDue)})
+	} else {
+		table.Append([]string{"Change Returned", fmt.Sprintf("$%.2f", *details.Change)})
+	}
+	if details.ChangeDenominations != nil && len(*details.ChangeDenominations) > 0 && details.ChangeDue != nil {
+		var coins []string
+		for _, d := range *details.ChangeDenominations {
+			value := svc.FormatMoney(v1.Money{Amount: d.Value, Currency: details.ChangeDue.Currency})
+			coins = append(coins, fmt.Sprintf("%d x %s", d.Count, value))
+		}
+		table.Append([]string{"Change Given As", strings.Join(coins, ", ")})
+	}
 
 	fmt.Println("Dispensing your soda...")
 	table.Render() // Print the table to the console
@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"colaco-api/svc"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Shows the units sold and revenue per soda for each hour, day or week",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := v1.NewClientWithResponses(serverURL)
+		if err != nil {
+			log.Fatalf("couldn't create client with error: %v", err)
+		}
+		token, err := authenticate(client)
+		if err != nil {
+			log.Fatalf("authentication failed: %v", err)
+		}
+
+		params := &v1.GetSalesReportParams{}
+		if params.From, err = timeFlag(cmd, "from"); err != nil {
+			log.Fatalf("couldn't read from flag: %v", err)
+		}
+		if params.To, err = timeFlag(cmd, "to"); err != nil {
+			log.Fatalf("couldn't read to flag: %v", err)
+		}
+		if bucket, _ := cmd.Flags().GetString("bucket"); bucket != "" {
+			b := v1.ReportBucket(bucket)
+			params.Bucket = &b
+		}
+		if soda, _ := cmd.Flags().GetString("soda"); soda != "" {
+			params.SodaId = &soda
+		}
+		asCSV, _ := cmd.Flags().GetBool("csv")
+		if asCSV {
+			f := v1.GetSalesReportParamsFormatCsv
+			params.Format = &f
+		}
+
+		r, err := client.GetSalesReportWithResponse(context.Background(), params, func(ctx context.Context, req *http.Request) error {
+			return addAuthHeader(ctx, req, token)
+		})
+		if err != nil {
+			log.Fatalf("Failed to get the sales report: %v", err)
+		}
+
+		switch {
+		case asCSV && r.StatusCode() == http.StatusOK:
+			os.Stdout.Write(r.Body)
+		case r.JSON200 != nil:
+			displaySalesReport(*r.JSON200)
+		case r.ApplicationproblemJSON400 != nil:
+			fmt.Printf("Invalid report: %s\n", r.ApplicationproblemJSON400.Detail)
+		default:
+			fmt.Println("An unexpected error occurred")
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(reportCmd)
+	reportCmd.Flags().StringP("from", "", "", "Start of the report as RFC 3339 or YYYY-MM-DD; seven days before --to when omitted")
+	reportCmd.Flags().StringP("to", "", "", "End of the report, exclusive, as RFC 3339 or YYYY-MM-DD; now when omitted")
+	reportCmd.Flags().StringP("bucket", "", "day", "Period to group sales by: hour, day or week")
+	reportCmd.Flags().StringP("soda", "", "", "Only report the sales of this catalog soda ID")
+	reportCmd.Flags().BoolP("csv", "", false, "Print the report as CSV")
+}
+
+// timeFlag parses the named flag as an RFC 3339 time or a YYYY-MM-DD date
+// in UTC, or returns nil when it was not given.
+func timeFlag(cmd *cobra.Command, name string) (*time.Time, error) {
+	value, err := cmd.Flags().GetString(name)
+	if err != nil || value == "" {
+		return nil, err
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		if t, err = time.Parse(time.DateOnly, value); err != nil {
+			return nil, fmt.Errorf("%q is neither RFC 3339 nor YYYY-MM-DD", value)
+		}
+	}
+	return &t, nil
+}
+
+func displaySalesReport(report v1.SalesReport) {
+	layout := time.DateTime
+	if report.Bucket != v1.Hour {
+		layout = time.DateOnly
+	}
+	fmt.Printf("Sales from %s to %s by %s\n", report.From.Format(time.RFC3339), report.To.Format(time.RFC3339), report.Bucket)
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Period", "Soda", "Units Sold", "Revenue", "Average Price"})
+	table.SetBorder(true)
+	table.SetColumnSeparator(":")
+	appendRow := func(period string, row v1.SalesReportRow) {
+		soda := row.SodaId
+		if row.SodaName != nil {
+			soda = *row.SodaName
+		}
+		table.Append([]string{
+			period,
+			soda,
+			strconv.Itoa(row.UnitsSold),
+			svc.FormatMoney(row.Revenue),
+			svc.FormatMoney(row.AveragePrice),
+		})
+	}
+	for _, row := range report.Rows {
+		period := ""
+		if row.PeriodStart != nil {
+			period = row.PeriodStart.Format(layout)
+		}
+		appendRow(period, row)
+	}
+	for _, row := range report.Totals {
+		appendRow("Total", row)
+	}
+	table.Render()
+}
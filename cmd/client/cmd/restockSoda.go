@@ -34,7 +34,7 @@ var restockSodaCmd = &cobra.Command{
 			log.Fatalf("quantity must be provided and greater than 0: %v", err)
 		}
 
-		r, err := client.RestockSodaWithResponse(context.Background(), v1.RestockSodaJSONRequestBody{
+		r, err := client.RestockSodaWithResponse(context.Background(), &v1.RestockSodaParams{IfMatch: ifMatchFlag(cmd)}, v1.RestockSodaJSONRequestBody{
 			Name:     sodaName,
 			Quantity: quantity,
 		}, func(ctx context.Context, req *http.Request) error {
@@ -49,8 +49,11 @@ var restockSodaCmd = &cobra.Command{
 			if r.JSON200.Leftover != nil && *r.JSON200.Leftover > 0 {
 				fmt.Printf("Warning: %d units could not be added due to capacity limits.\n", *r.JSON200.Leftover)
 			}
-		} else if r.JSON404 != nil {
+			fmt.Printf("ETag: %s\n", r.HTTPResponse.Header.Get("ETag"))
+		} else if r.ApplicationproblemJSON404 != nil {
 			fmt.Printf("Soda '%s' not found.\n", sodaName)
+		} else if r.ApplicationproblemJSON412 != nil {
+			fmt.Printf("Soda '%s' was changed by someone else, nothing was restocked: %s\n", sodaName, r.ApplicationproblemJSON412.Detail)
 		} else {
 			fmt.Println("An unexpected error occurred.")
 		}
@@ -59,8 +62,9 @@ var restockSodaCmd = &cobra.Command{
 
 func init() {
 	rootCmd.AddCommand(restockSodaCmd)
-	restockSodaCmd.Flags().StringP("soda", "", "", "Soda to replenish in the vending machine")
+	restockSodaCmd.Flags().StringP("soda", "", "", "Slot of the soda to replenish, such as A1")
 	restockSodaCmd.Flags().IntP("qty", "", 0, "Quantity of soda to add")
+	restockSodaCmd.Flags().StringP("if-match", "", "", "Only apply the change if the slot still has this ETag, as printed by a previous command")
 	// Ensuring the necessary flags are marked as required
 	restockSodaCmd.MarkFlagRequired("soda")
 	restockSodaCmd.MarkFlagRequired("qty")
@@ -12,6 +12,8 @@ import (
 var serverURL string
 var username string
 var password string
+var sessionFile string
+var apiKey string
 
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
@@ -44,5 +46,7 @@ func init() {
 	rootCmd.PersistentFlags().StringVarP(&serverURL, "server", "s", "http://localhost:8080", "Server URL of the vending machine service.")
 	rootCmd.PersistentFlags().StringVarP(&username, "username", "u", "admin", "Username to use to communicate with the vending machine.")
 	rootCmd.PersistentFlags().StringVarP(&password, "password", "p", "", "Password to use to communicate with the vending machine.")
+	rootCmd.PersistentFlags().StringVarP(&sessionFile, "session-file", "", defaultSessionFile(), "File keeping the tokens between runs, so the password is only needed when they expired. Empty to log in every time.")
+	rootCmd.PersistentFlags().StringVarP(&apiKey, "api-key", "", os.Getenv("COLACO_API_KEY"), "API key to use instead of the username and password, for scripts and devices. Defaults to $COLACO_API_KEY.")
 	rootCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
 }
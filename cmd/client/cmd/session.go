@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"encoding/json"
+	"errors"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// expiryMargin is how long before they expire tokens are no longer used, so
+// that they do not expire on the way to the server.
+const expiryMargin = 30 * time.Second
+
+// session holds the tokens the client keeps in the --session-file between
+// runs, for one user of one server.
+type session struct {
+	Server           string    `json:"server"`
+	Username         string    `json:"username"`
+	Token            string    `json:"token"`
+	ExpiresAt        time.Time `json:"expiresAt"`
+	RefreshToken     string    `json:"refreshToken,omitempty"`
+	RefreshExpiresAt time.Time `json:"refreshExpiresAt,omitempty"`
+}
+
+func (s *session) accessValid() bool {
+	return s.Token != "" && time.Now().Add(expiryMargin).Before(s.ExpiresAt)
+}
+
+func (s *session) refreshValid() bool {
+	return s.RefreshToken != "" && time.Now().Add(expiryMargin).Before(s.RefreshExpiresAt)
+}
+
+// defaultSessionFile returns where sessions are kept unless --session-file
+// says otherwise, or "" when there is no user configuration directory.
+func defaultSessionFile() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "colaco", "session.json")
+}
+
+// loadSession returns the saved session of the --username on the --server, or
+// nil if there is none.
+func loadSession() *session {
+	if sessionFile == "" {
+		return nil
+	}
+	b, err := os.ReadFile(sessionFile)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Printf("ignoring the saved session: %v", err)
+		}
+		return nil
+	}
+	var s session
+	if err := json.Unmarshal(b, &s); err != nil {
+		log.Printf("ignoring the saved session: %v", err)
+		return nil
+	}
+	if s.Server != serverURL || s.Username != username {
+		return nil
+	}
+	return &s
+}
+
+// saveSession saves the tokens of resp as the session of the --username on
+// the --server and returns the access token. Failing to save it only means
+// the next run logs in again.
+func saveSession(resp v1.AuthTokenResponse) string {
+	s := session{Server: serverURL, Username: username, Token: *resp.Token}
+	if resp.ExpiresAt != nil {
+		s.ExpiresAt = *resp.ExpiresAt
+	}
+	if resp.RefreshToken != nil && resp.RefreshExpiresAt != nil {
+		s.RefreshToken, s.RefreshExpiresAt = *resp.RefreshToken, *resp.RefreshExpiresAt
+	}
+	if sessionFile == "" {
+		return s.Token
+	}
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err == nil {
+		err = os.MkdirAll(filepath.Dir(sessionFile), 0o700)
+	}
+	if err == nil {
+		// The tokens grant access to the machine, so only the owner may
+		// read them.
+		err = os.WriteFile(sessionFile, b, 0o600)
+	}
+	if err != nil {
+		log.Printf("couldn't save the session: %v", err)
+	}
+	return s.Token
+}
+
+// clearSession forgets the saved session.
+func clearSession() {
+	if sessionFile == "" {
+		return
+	}
+	if err := os.Remove(sessionFile); err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Printf("couldn't remove the session: %v", err)
+	}
+}
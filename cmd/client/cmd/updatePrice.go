@@ -2,6 +2,7 @@ package cmd
 
 import (
 	v1 "colaco-api/internal/api/v1"
+	"colaco-api/svc"
 	"context"
 	"fmt"
 	"github.com/spf13/cobra"
@@ -29,14 +30,14 @@ var updatePriceCmd = &cobra.Command{
 		}
 		soda = strings.ToLower(soda)
 
-		price, err := cmd.Flags().GetFloat32("price")
-		if err != nil || price <= 0 { // Assuming price must be greater than 0
+		price, err := moneyFlag(cmd, "price")
+		if err != nil || price.Amount <= 0 { // Assuming price must be greater than 0
 			log.Fatalf("valid soda price must be provided: %v", err)
 		}
 
-		r, err := client.UpdatePriceWithResponse(context.Background(), v1.UpdatePriceJSONRequestBody{
-			Name:     soda,
-			NewPrice: price,
+		r, err := client.UpdatePriceWithResponse(context.Background(), &v1.UpdatePriceParams{IfMatch: ifMatchFlag(cmd)}, v1.UpdatePriceJSONRequestBody{
+			Name:  soda,
+			Price: &price,
 		}, func(ctx context.Context, req *http.Request) error {
 			return addAuthHeader(ctx, req, token)
 		})
@@ -44,9 +45,18 @@ var updatePriceCmd = &cobra.Command{
 			log.Fatalf("Failed to update soda price: %v", err)
 		}
 		if r.JSON200 != nil {
-			fmt.Printf("Soda price updated successfully from %v to %v.\n", *r.JSON200.OldPrice, *r.JSON200.NewPrice)
-		} else if r.JSON404 != nil {
+			previous := "no price"
+			if r.JSON200.PreviousPrice != nil {
+				previous = svc.FormatMoney(*r.JSON200.PreviousPrice)
+			}
+			fmt.Printf("Soda price updated successfully from %v to %v.\n", previous, svc.FormatMoney(*r.JSON200.Price))
+			fmt.Printf("ETag: %s\n", r.HTTPResponse.Header.Get("ETag"))
+		} else if r.ApplicationproblemJSON400 != nil {
+			fmt.Printf("Invalid price: %s\n", r.ApplicationproblemJSON400.Detail)
+		} else if r.ApplicationproblemJSON404 != nil {
 			fmt.Printf("Soda not found: %v\n", soda)
+		} else if r.ApplicationproblemJSON412 != nil {
+			fmt.Printf("Soda %v was changed by someone else, price not updated: %s\n", soda, r.ApplicationproblemJSON412.Detail)
 		} else {
 			fmt.Println("An unexpected error occurred")
 		}
@@ -55,8 +65,10 @@ var updatePriceCmd = &cobra.Command{
 
 func init() {
 	rootCmd.AddCommand(updatePriceCmd)
-	updatePriceCmd.Flags().StringP("soda", "", "", "Soda to update price on")
-	updatePriceCmd.Flags().Float32P("price", "", 1.00, "Price to update soda to")
+	updatePriceCmd.Flags().StringP("soda", "", "", "Slot of the soda to update the price of, such as A1")
+	updatePriceCmd.Flags().StringP("price", "", "1.00", "Price to update soda to, such as 1.50")
+	updatePriceCmd.Flags().StringP("currency", "", svc.DefaultCurrency, "ISO 4217 currency of the price")
+	updatePriceCmd.Flags().StringP("if-match", "", "", "Only apply the change if the slot still has this ETag, as printed by a previous command")
 	updatePriceCmd.MarkFlagRequired("soda")
 	updatePriceCmd.MarkFlagRequired("price")
 }
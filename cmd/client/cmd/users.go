@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// userClient returns a client and the request editor authenticating its
+// requests with a freshly obtained token.
+func userClient() (*v1.ClientWithResponses, v1.RequestEditorFn) {
+	client, err := v1.NewClientWithResponses(serverURL)
+	if err != nil {
+		log.Fatalf("couldn't create client with error: %v", err)
+	}
+	token, err := authenticate(client)
+	if err != nil {
+		log.Fatalf("authentication failed: %v", err)
+	}
+	return client, func(ctx context.Context, req *http.Request) error {
+		return addAuthHeader(ctx, req, token)
+	}
+}
+
+var getUsersCmd = &cobra.Command{
+	Use:   "get-users",
+	Short: "Lists the users who can log in to the vending machine.",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, auth := userClient()
+		r, err := client.GetUsersWithResponse(context.Background(), auth)
+		if err != nil {
+			log.Fatalf("Failed to list the users: %v", err)
+		}
+		if r.JSON200 == nil {
+			fmt.Println("An unexpected error occurred")
+			return
+		}
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Username", "Role", "Disabled", "Updated"})
+		table.SetBorder(true)
+		table.SetColumnSeparator(":")
+		for _, u := range r.JSON200.Users {
+			updated := ""
+			if u.UpdatedAt != nil {
+				updated = u.UpdatedAt.Local().Format(time.DateTime)
+			}
+			table.Append([]string{u.Username, string(u.Role), strconv.FormatBool(u.Disabled), updated})
+		}
+		table.Render()
+	},
+}
+
+var createUserCmd = &cobra.Command{
+	Use:   "create-user",
+	Short: "Creates a user who can log in to the vending machine.",
+	Run: func(cmd *cobra.Command, args []string) {
+		name, _ := cmd.Flags().GetString("name")
+		password, _ := cmd.Flags().GetString("new-password")
+		role, _ := cmd.Flags().GetString("role")
+		client, auth := userClient()
+		r, err := client.CreateUserWithResponse(context.Background(), v1.CreateUserJSONRequestBody{
+			Username: name,
+			Password: password,
+			Role:     (*v1.Role)(&role),
+		}, auth)
+		if err != nil {
+			log.Fatalf("Failed to create user %s: %v", name, err)
+		}
+		if r.JSON201 != nil {
+			fmt.Printf("Created %s %s\n", r.JSON201.Role, r.JSON201.Username)
+		} else if r.ApplicationproblemJSON400 != nil {
+			fmt.Printf("Invalid user: %s\n", r.ApplicationproblemJSON400.Detail)
+		} else if r.ApplicationproblemJSON409 != nil {
+			fmt.Printf("User %s already exists\n", name)
+		} else {
+			fmt.Println("An unexpected error occurred")
+		}
+	},
+}
+
+var disableUserCmd = &cobra.Command{
+	Use:   "disable-user",
+	Short: "Stops a user from logging in until they are enabled again.",
+	Run: func(cmd *cobra.Command, args []string) {
+		name, _ := cmd.Flags().GetString("name")
+		client, auth := userClient()
+		r, err := client.DisableUserWithResponse(context.Background(), name, auth)
+		if err != nil {
+			log.Fatalf("Failed to disable user %s: %v", name, err)
+		}
+		displayUserUpdate(name, "Disabled", r.JSON200, r.ApplicationproblemJSON404)
+	},
+}
+
+var enableUserCmd = &cobra.Command{
+	Use:   "enable-user",
+	Short: "Lets a disabled user log in again.",
+	Run: func(cmd *cobra.Command, args []string) {
+		name, _ := cmd.Flags().GetString("name")
+		client, auth := userClient()
+		r, err := client.EnableUserWithResponse(context.Background(), name, auth)
+		if err != nil {
+			log.Fatalf("Failed to enable user %s: %v", name, err)
+		}
+		displayUserUpdate(name, "Enabled", r.JSON200, r.ApplicationproblemJSON404)
+	},
+}
+
+var resetPasswordCmd = &cobra.Command{
+	Use:   "reset-password",
+	Short: "Replaces the password of a user.",
+	Run: func(cmd *cobra.Command, args []string) {
+		name, _ := cmd.Flags().GetString("name")
+		password, _ := cmd.Flags().GetString("new-password")
+		client, auth := userClient()
+		r, err := client.ResetUserPasswordWithResponse(context.Background(), name,
+			v1.ResetUserPasswordJSONRequestBody{Password: password}, auth)
+		if err != nil {
+			log.Fatalf("Failed to reset the password of %s: %v", name, err)
+		}
+		if r.ApplicationproblemJSON400 != nil {
+			fmt.Printf("Invalid password: %s\n", r.ApplicationproblemJSON400.Detail)
+			return
+		}
+		displayUserUpdate(name, "Reset the password of", r.JSON200, r.ApplicationproblemJSON404)
+	},
+}
+
+var setRoleCmd = &cobra.Command{
+	Use:   "set-role",
+	Short: "Gives a user another role, which applies from their next login.",
+	Run: func(cmd *cobra.Command, args []string) {
+		name, _ := cmd.Flags().GetString("name")
+		role, _ := cmd.Flags().GetString("role")
+		client, auth := userClient()
+		r, err := client.SetUserRoleWithResponse(context.Background(), name,
+			v1.SetUserRoleJSONRequestBody{Role: v1.Role(role)}, auth)
+		if err != nil {
+			log.Fatalf("Failed to change the role of %s: %v", name, err)
+		}
+		if r.ApplicationproblemJSON400 != nil {
+			fmt.Printf("Invalid role: %s\n", r.ApplicationproblemJSON400.Detail)
+			return
+		}
+		displayUserUpdate(name, "Changed the role of", r.JSON200, r.ApplicationproblemJSON404)
+	},
+}
+
+func displayUserUpdate(name, done string, user *v1.UserResponse, notFound *v1.ErrorResp) {
+	if user != nil {
+		fmt.Printf("%s user %s\n", done, user.Username)
+	} else if notFound != nil {
+		fmt.Printf("User %s not found\n", name)
+	} else {
+		fmt.Println("An unexpected error occurred")
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(getUsersCmd)
+	rootCmd.AddCommand(createUserCmd)
+	rootCmd.AddCommand(disableUserCmd)
+	rootCmd.AddCommand(enableUserCmd)
+	rootCmd.AddCommand(resetPasswordCmd)
+	rootCmd.AddCommand(setRoleCmd)
+	for _, c := range []*cobra.Command{createUserCmd, disableUserCmd, enableUserCmd, resetPasswordCmd, setRoleCmd} {
+		c.Flags().StringP("name", "", "", "Username of the user")
+		c.MarkFlagRequired("name")
+	}
+	for _, c := range []*cobra.Command{createUserCmd, resetPasswordCmd} {
+		c.Flags().StringP("new-password", "", "", "Password the user logs in with, at least 8 characters")
+		c.MarkFlagRequired("new-password")
+	}
+	createUserCmd.Flags().StringP("role", "", string(v1.Customer), "Role of the user: customer, operator or admin")
+	setRoleCmd.Flags().StringP("role", "", "", "New role of the user: customer, operator or admin")
+	setRoleCmd.MarkFlagRequired("role")
+}
@@ -1,23 +1,235 @@
 package main
 
 import (
+	"bufio"
 	v1 "colaco-api/internal/api/v1"
+	"colaco-api/internal/jwt"
 	"colaco-api/internal/server"
 	"colaco-api/internal/storage"
+	"colaco-api/svc"
+	"context"
+	"crypto/ecdsa"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
 )
 
+var (
+	storageBackend = flag.String("storage", "memory", "Storage backend to use: memory, file or sqlite.")
+	dataDir        = flag.String("data-dir", "data", "Directory used by the file storage backend.")
+	sqliteDSN      = flag.String("sqlite-dsn", "colaco.db", "Database file used by the sqlite storage backend.")
+	machineSerial  = flag.String("machine-serial", svc.DefaultMachineIdentity.Serial, "Serial number identifying the machine in DEX audit files.")
+	machineModel   = flag.String("machine-model", svc.DefaultMachineIdentity.Model, "Model number identifying the machine in DEX audit files.")
+	machineAsset   = flag.String("machine-asset", "", "Asset number of the machine in DEX audit files.")
+	machineLoc     = flag.String("machine-location", "", "Location of the machine in DEX audit files.")
+	usersFile      = flag.String("users-file", "users.json", "File holding the user directory.")
+	apiKeysFile    = flag.String("api-keys-file", "apikeys.json", "File holding the API keys.")
+	auditFile      = flag.String("audit-file", "audit.jsonl", "Append-only file holding the audit trail of privileged calls.")
+	accessTTL      = flag.Duration("access-token-ttl", jwt.DefaultAccessTokenTTL, "How long access tokens are valid.")
+	refreshTTL     = flag.Duration("refresh-token-ttl", jwt.DefaultRefreshTokenTTL, "How long refresh tokens are valid.")
+	signingKeys    = flag.String("signing-keys", "", "Comma-separated PEM files of the ECDSA keys tokens are signed with. The first one signs, the others only validate tokens during a rotation.")
+	acceptTokens   = flag.String("accept-tokens", "builtin", "Tokens that authorize requests: builtin for those issued by /auth/login, oidc for those of the -oidc-issuer identity provider, or both.")
+	oidcIssuer     = flag.String("oidc-issuer", "", "Issuer URL of the OIDC identity provider whose tokens are accepted, its discovery document being found under it.")
+	oidcAudience   = flag.String("oidc-audience", "", "Audience the tokens of the OIDC identity provider must be issued for.")
+	oidcClaim      = flag.String("oidc-claim", jwt.DefaultOIDCClaim, "Claim of the OIDC tokens whose values are mapped onto roles with -oidc-roles.")
+	oidcRoles      = flag.String("oidc-roles", "", "Comma-separated value=role pairs giving the tokens with a value in -oidc-claim the scopes of the role, e.g. vending-staff=operator,it=admin.")
+	oidcSubject    = flag.String("oidc-subject-claim", "", "Claim of the OIDC tokens recorded as who made a change, e.g. email. Empty keeps sub.")
+	trustProxy     = flag.Bool("trust-proxy", false, "Take the client address throttling failed logins from X-Forwarded-For when the request comes from a private or loopback address, as behind a reverse proxy.")
+	setup          = flag.Bool("setup", false, "Create the first admin from a username and password read from stdin, then exit.")
+)
+
+// The first admin is created from these environment variables when the user
+// directory is empty.
+const (
+	adminUsernameEnv = "COLACO_ADMIN_USERNAME"
+	adminPasswordEnv = "COLACO_ADMIN_PASSWORD"
+)
+
+// signingKeysEnv holds the PEM blocks of the signing keys when they are not
+// given with -signing-keys, the first one signing.
+const signingKeysEnv = "COLACO_SIGNING_KEYS"
+
 func main() {
+	flag.Parse()
+	users, err := storage.NewFileUserStore(*usersFile)
+	if err != nil {
+		log.Fatalln("error opening user directory:", err.Error())
+	}
+	if *setup {
+		setupAdmin(users)
+		return
+	}
+	bootstrapAdmin(users)
+	apiKeys, err := storage.NewFileAPIKeyStore(*apiKeysFile)
+	if err != nil {
+		log.Fatalln("error opening API keys:", err.Error())
+	}
+	audit, err := storage.NewFileAuditStore(*auditFile)
+	if err != nil {
+		log.Fatalln("error opening audit trail:", err.Error())
+	}
 	vendingMachine := server.NewVendingMachine(
-		server.WithStorage(storage.NewMemoryStorage()),
+		newStorage(),
+		server.WithUserStore(users),
+		server.WithAPIKeyStore(apiKeys),
+		server.WithAuditStore(audit),
+		server.WithTokenTTL(*accessTTL, *refreshTTL),
+		server.WithSigningKeys(loadSigningKeys()...),
+		tokenValidators(),
+		server.WithTrustedProxy(*trustProxy),
 		server.WithStartingSodas(startingSodas),
+		server.WithMachineIdentity(svc.MachineIdentity{
+			Serial:   *machineSerial,
+			Model:    *machineModel,
+			Asset:    *machineAsset,
+			Location: *machineLoc,
+		}),
 		server.WithPort("8080"),
 	)
 	vendingMachine.Run()
 }
 
+// bootstrapAdmin creates the first admin from the COLACO_ADMIN_USERNAME and
+// COLACO_ADMIN_PASSWORD environment variables when the user directory is
+// empty. Without them nobody can log in until -setup is run.
+func bootstrapAdmin(users svc.UserStore) {
+	ctx := context.Background()
+	if password := os.Getenv(adminPasswordEnv); password != "" {
+		username := os.Getenv(adminUsernameEnv)
+		if username == "" {
+			username = "admin"
+		}
+		created, err := svc.BootstrapAdmin(ctx, users, username, password)
+		if err != nil {
+			log.Fatalln("error creating the first admin:", err.Error())
+		}
+		if created {
+			log.Printf("created admin %q from %s", username, adminPasswordEnv)
+		}
+		return
+	}
+	existing, err := users.GetUsers(ctx)
+	if err != nil {
+		log.Fatalln("error reading user directory:", err.Error())
+	}
+	if len(existing) == 0 {
+		log.Printf("the user directory %s is empty: set %s or run with -setup to create the first admin", *usersFile, adminPasswordEnv)
+	}
+}
+
+// setupAdmin is the one-time setup command: it prompts for the username and
+// password of the first admin and creates it, unless there already are users.
+func setupAdmin(users svc.UserStore) {
+	in := bufio.NewReader(os.Stdin)
+	prompt := func(label string) string {
+		fmt.Print(label)
+		line, err := in.ReadString('\n')
+		if err != nil && line == "" {
+			log.Fatalln("error reading from stdin:", err.Error())
+		}
+		return strings.TrimSpace(line)
+	}
+	username := prompt("Admin username [admin]: ")
+	if username == "" {
+		username = "admin"
+	}
+	password := prompt("Admin password: ")
+	created, err := svc.BootstrapAdmin(context.Background(), users, username, password)
+	if err != nil {
+		log.Fatalln("error creating the first admin:", err.Error())
+	}
+	if !created {
+		log.Fatalln("the user directory", *usersFile, "already has users; manage them through the API instead")
+	}
+	fmt.Printf("Created admin %q in %s\n", username, *usersFile)
+}
+
+// loadSigningKeys loads the keys given with -signing-keys or in the
+// COLACO_SIGNING_KEYS environment variable. Without either the server signs
+// with a key of its own, and the tokens it issues are invalid after a restart.
+func loadSigningKeys() []*ecdsa.PrivateKey {
+	var keys []*ecdsa.PrivateKey
+	var err error
+	switch {
+	case *signingKeys != "":
+		keys, err = jwt.LoadPrivateKeyFiles(strings.Split(*signingKeys, ",")...)
+	case os.Getenv(signingKeysEnv) != "":
+		keys, err = jwt.ParsePrivateKeys([]byte(os.Getenv(signingKeysEnv)))
+	default:
+		log.Printf("no signing keys given with -signing-keys or %s: tokens will be invalid after a restart", signingKeysEnv)
+		return nil
+	}
+	if err != nil {
+		log.Fatalln("error loading signing keys:", err.Error())
+	}
+	return keys
+}
+
+// tokenValidators selects the tokens that authorize requests with the
+// -accept-tokens flag, validating those of an external identity provider with
+// the -oidc flags.
+func tokenValidators() func(*server.VendingMachine) {
+	switch *acceptTokens {
+	case "builtin":
+		return server.WithTokenValidators(true)
+	case "oidc", "both":
+	default:
+		log.Fatalln("unknown -accept-tokens:", *acceptTokens)
+	}
+	scopes := make(map[string][]string)
+	if *oidcRoles != "" {
+		for _, pair := range strings.Split(*oidcRoles, ",") {
+			value, role, ok := strings.Cut(pair, "=")
+			if !ok || svc.ValidRole(v1.Role(role)) != nil {
+				log.Fatalln("invalid -oidc-roles pair:", pair)
+			}
+			scopes[value] = svc.RoleScopes(v1.Role(role))
+		}
+	}
+	options := []func(*jwt.OIDCValidator){jwt.WithClaimMapping(*oidcClaim, scopes)}
+	if *oidcSubject != "" {
+		options = append(options, jwt.WithSubjectClaim(*oidcSubject))
+	}
+	oidc, err := jwt.NewOIDCValidator(context.Background(), *oidcIssuer, *oidcAudience, options...)
+	if err != nil {
+		log.Fatalln("error setting up the OIDC identity provider:", err.Error())
+	}
+	return server.WithTokenValidators(*acceptTokens == "both", oidc)
+}
+
+// newStorage configures the storage backend selected with the -storage flag.
+// The file and sqlite backends are svc.VendingStore implementations that
+// report their failures; the memory backend goes through the legacy adapter.
+func newStorage() func(*server.VendingMachine) {
+	switch *storageBackend {
+	case "memory":
+		return server.WithStorage(storage.NewMemoryStorage())
+	case "file":
+		fs, err := storage.NewFileStorage(*dataDir)
+		if err != nil {
+			log.Fatalln("error opening file storage:", err.Error())
+		}
+		return server.WithStore(fs)
+	case "sqlite":
+		// Schema migrations are applied while the storage is opened.
+		db, err := storage.NewSQLiteStorage(*sqliteDSN)
+		if err != nil {
+			log.Fatalln("error opening sqlite storage:", err.Error())
+		}
+		return server.WithStore(db)
+	default:
+		log.Fatalf("unknown storage backend %q", *storageBackend)
+	}
+	return nil
+}
+
 var startingSodas = []v1.VendingSlot{
 	{
-		Cost:        f322p(1.00),
+		Id:          s2p("A1"),
+		Position:    &v1.SlotPosition{Row: "A", Column: 1},
+		Price:       &v1.Money{Amount: 100, Currency: svc.DefaultCurrency},
 		MaxQuantity: i2p(100),
 		OccupiedSoda: &v1.Soda{
 			Calories:    i2p(190),
@@ -29,7 +241,9 @@ var startingSodas = []v1.VendingSlot{
 		Quantity: i2p(100),
 	},
 	{
-		Cost:        f322p(1.00),
+		Id:          s2p("A2"),
+		Position:    &v1.SlotPosition{Row: "A", Column: 2},
+		Price:       &v1.Money{Amount: 100, Currency: svc.DefaultCurrency},
 		MaxQuantity: i2p(100),
 		OccupiedSoda: &v1.Soda{
 			Calories:    i2p(185),
@@ -41,7 +255,9 @@ var startingSodas = []v1.VendingSlot{
 		Quantity: i2p(100),
 	},
 	{
-		Cost:        f322p(1.00),
+		Id:          s2p("A3"),
+		Position:    &v1.SlotPosition{Row: "A", Column: 3},
+		Price:       &v1.Money{Amount: 100, Currency: svc.DefaultCurrency},
 		MaxQuantity: i2p(200),
 		OccupiedSoda: &v1.Soda{
 			Calories:    i2p(225),
@@ -53,7 +269,9 @@ var startingSodas = []v1.VendingSlot{
 		Quantity: i2p(200),
 	},
 	{
-		Cost:        f322p(1.00),
+		Id:          s2p("A4"),
+		Position:    &v1.SlotPosition{Row: "A", Column: 4},
+		Price:       &v1.Money{Amount: 100, Currency: svc.DefaultCurrency},
 		MaxQuantity: i2p(150),
 		OccupiedSoda: &v1.Soda{
 			Calories:    i2p(356),
@@ -15,42 +15,491 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
 )
 
 const (
+	ApiKeyAuthScopes = "ApiKeyAuth.Scopes"
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for LoginLockoutKind.
+const (
+	Ip       LoginLockoutKind = "ip"
+	Username LoginLockoutKind = "username"
+)
+
+// Defines values for PaymentMethod.
+const (
+	Cash     PaymentMethod = "cash"
+	Cashless PaymentMethod = "cashless"
+)
+
+// Defines values for ReportBucket.
+const (
+	Day  ReportBucket = "day"
+	Hour ReportBucket = "hour"
+	Week ReportBucket = "week"
+)
+
+// Defines values for Role.
+const (
+	Admin    Role = "admin"
+	Customer Role = "customer"
+	Operator Role = "operator"
+)
+
+// Defines values for TransactionOperation.
+const (
+	Add         TransactionOperation = "add"
+	Delete      TransactionOperation = "delete"
+	PriceChange TransactionOperation = "price_change"
+	Purchase    TransactionOperation = "purchase"
+	Restock     TransactionOperation = "restock"
+)
+
+// Defines values for GetPlanogramParamsFormat.
+const (
+	GetPlanogramParamsFormatJson GetPlanogramParamsFormat = "json"
+	GetPlanogramParamsFormatYaml GetPlanogramParamsFormat = "yaml"
+)
+
+// Defines values for GetSalesReportParamsFormat.
+const (
+	GetSalesReportParamsFormatCsv  GetSalesReportParamsFormat = "csv"
+	GetSalesReportParamsFormatJson GetSalesReportParamsFormat = "json"
+)
+
+// APIKey An API key, without the key itself. Requests made with it are recorded in the ledger as made by apikey: followed by its ID.
+type APIKey struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CreatedBy Who created the key.
+	CreatedBy string `json:"createdBy"`
+
+	// ExpiresAt When the key stops being accepted. Keys without it are valid until revoked.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Id ID of the key, which is also the part of the key after colaco_ and before the next underscore.
+	Id string `json:"id"`
+
+	// Name What the key is used for, such as the name of the device.
+	Name string `json:"name"`
+
+	// RevokedAt When the key was revoked.
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+	Scopes    []string   `json:"scopes"`
+}
+
+// AuditRecord A privileged call recorded in the audit trail. Its hash is the hex SHA-256 of the record encoded as JSON with an empty hash, its keys and those of before and after sorted and without whitespace, and prevHash is the hash of the record before it, empty for the first one.
+type AuditRecord struct {
+	// After The state of the target after the call, absent when it was deleted by it.
+	After *map[string]interface{} `json:"after,omitempty"`
+
+	// Before The state of the target before the call, absent when it was created by it.
+	Before *map[string]interface{} `json:"before,omitempty"`
+	Hash   string                  `json:"hash"`
+
+	// Operation The operationId of the call.
+	Operation string `json:"operation"`
+	PrevHash  string `json:"prevHash"`
+
+	// RequestId ID of the request, as returned in its X-Request-Id header.
+	RequestId string `json:"requestId"`
+
+	// Seq Position of the record in the trail, counting from 1 without gaps.
+	Seq int64 `json:"seq"`
+
+	// SourceIp Client address the call came from.
+	SourceIp string `json:"sourceIp"`
+
+	// Subject Who made the call: the subject of their token, or apikey: followed by the ID of their API key.
+	Subject string `json:"subject"`
+
+	// Target What the call changed: a slot ID, a username, an API key ID or the lockout that was cleared.
+	Target    string    `json:"target"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// CashBox The coins and bills the vending machine holds to give change, largest denomination first.
+type CashBox struct {
+	Currency      string         `json:"currency"`
+	Denominations []Denomination `json:"denominations"`
+
+	// Total An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	Total *Money `json:"total,omitempty"`
+}
+
+// Coil A coil of a row and the number of sodas it can hold.
+type Coil struct {
+	Capacity int `json:"capacity"`
+	Column   int `json:"column"`
+}
+
+// DayClose The end-of-day report (Z-report) of a closed period.
+type DayClose struct {
+	ClosedAt time.Time `json:"closedAt"`
+
+	// ClosedBy The user who closed the period.
+	ClosedBy string `json:"closedBy"`
+
+	// CountedCash An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	CountedCash Money `json:"countedCash"`
+
+	// ExpectedCash An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	ExpectedCash Money `json:"expectedCash"`
+
+	// FromTransactionId The period covers the ledger transactions after this ID, 0 for the first period.
+	FromTransactionId int64   `json:"fromTransactionId"`
+	Id                *int64  `json:"id,omitempty"`
+	Note              *string `json:"note,omitempty"`
+
+	// OpenedAt When the previous period was closed, or when the first transaction was recorded for the first period.
+	OpenedAt time.Time `json:"openedAt"`
+
+	// Revenue Revenue of the sales in the period, one amount per currency.
+	Revenue []Money `json:"revenue"`
+
+	// Slots Stock movements per slot, ordered by slot ID.
+	Slots []DayCloseSlot `json:"slots"`
+
+	// ThroughTransactionId ID of the last ledger transaction of the period.
+	ThroughTransactionId int64 `json:"throughTransactionId"`
+
+	// Variance Counted minus expected cash in the minor unit of their currency; negative when cash is missing.
+	Variance int64 `json:"variance"`
+}
+
+// DayCloseSlot The stock movements of a slot over a period. A slot deleted during the period closes with no stock.
+type DayCloseSlot struct {
+	ClosingStock int `json:"closingStock"`
+
+	// Leftover Units that did not fit in the slot when it was restocked.
+	Leftover     int `json:"leftover"`
+	OpeningStock int `json:"openingStock"`
+
+	// Restocked Units added to the slot by restocks.
+	Restocked int     `json:"restocked"`
+	SlotId    string  `json:"slotId"`
+	SodaId    *string `json:"sodaId,omitempty"`
+	SodaName  *string `json:"sodaName,omitempty"`
+	UnitsSold int     `json:"unitsSold"`
+}
+
+// Denomination A number of coins or bills of one value, in the minor unit of the cash box currency: value 25 is a quarter in USD.
+type Denomination struct {
+	Count int   `json:"count"`
+	Value int64 `json:"value"`
+}
+
+// FieldError A field of the request that failed validation.
+type FieldError struct {
+	// Field The query, path or header parameter by name, or the field of the body by its JSON path such as slot.price.amount.
+	Field string `json:"field"`
+
+	// Message What is wrong with the field.
+	Message string `json:"message"`
+}
+
+// LayoutRow A row, or tray, of the machine such as A, with its coils.
+type LayoutRow struct {
+	Coils []Coil `json:"coils"`
+	Row   string `json:"row"`
+}
+
+// LoginLockout A username or client address whose login attempts are blocked after failed logins.
+type LoginLockout struct {
+	// BlockedUntil When the next attempt is allowed.
+	BlockedUntil time.Time `json:"blockedUntil"`
+
+	// Failures Failed logins counted since the last success or reset.
+	Failures int              `json:"failures"`
+	Kind     LoginLockoutKind `json:"kind"`
+
+	// LockedOut Whether it is locked out, rather than delayed by a backoff.
+	LockedOut bool `json:"lockedOut"`
+
+	// Value The username, in lower case, or the client address.
+	Value string `json:"value"`
+}
+
+// LoginLockoutKind defines model for LoginLockout.Kind.
+type LoginLockoutKind string
+
+// MachineLayout The physical layout of the vending machine.
+type MachineLayout struct {
+	Rows []LayoutRow `json:"rows"`
+}
+
+// Money An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+type Money struct {
+	// Amount Amount in the minor unit of the currency, such as cents.
+	Amount int64 `json:"amount"`
+
+	// Currency ISO 4217 currency code.
+	Currency string `json:"currency"`
+}
+
+// PaymentMethod How a purchase was paid: with coins and bills inserted in the machine, or cashless.
+type PaymentMethod string
+
+// Planogram A machine layout together with the sodas assigned to its coils.
+type Planogram struct {
+	Assignments *[]PlanogramAssignment `json:"assignments,omitempty"`
+
+	// Layout The physical layout of the vending machine.
+	Layout MachineLayout `json:"layout"`
+}
+
+// PlanogramAssignment Assigns a soda to the coil of a slot, with its price and the number of sodas loaded, which cannot exceed the capacity of the coil.
+type PlanogramAssignment struct {
+	// Cost Use price instead. A price in USD given as a float, used when price is absent.
+	// Deprecated:
+	Cost *float32 `json:"cost,omitempty"`
+
+	// Price An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	Price    *Money `json:"price,omitempty"`
+	Quantity *int   `json:"quantity,omitempty"`
+
+	// SlotId ID of the slot, its row followed by its column such as A1.
+	SlotId string `json:"slotId"`
+
+	// Soda Represents a soda available for purchase, including metadata such as name, description, origin story, calories, and volume in ounces. This schema is used to detail the sodas offered by the vending machine, allowing users to make informed choices.
+	Soda Soda `json:"soda"`
+}
+
+// Problem An error, reported as RFC 7807 problem details with the application/problem+json media type. type identifies the kind of problem:
+//
+// - about:blank: the status code says it all, and title is its reason phrase.
+// - /problems/validation: the request does not match the spec; errors lists what is wrong with each field.
+// - /problems/currency-mismatch: the payment is in another currency than the price.
+// - /problems/insufficient-funds: the payment does not cover the price.
+// - /problems/sold-out: no slot holding the soda has any left.
+// - /problems/exact-change-only: the cash box cannot give the change.
+type Problem struct {
+	// Detail What went wrong with this request.
+	Detail string `json:"detail"`
+
+	// Errors The fields of the request that failed validation.
+	Errors *[]FieldError `json:"errors,omitempty"`
+
+	// Instance Path of the request the problem occurred on.
+	Instance *string `json:"instance,omitempty"`
+
+	// Status HTTP status code of the response.
+	Status int `json:"status"`
+
+	// Title Short summary of the kind of problem, the same for every occurrence.
+	Title string `json:"title"`
+
+	// Type URI reference identifying the kind of problem.
+	Type string `json:"type"`
+}
+
+// ReportBucket defines model for ReportBucket.
+type ReportBucket string
+
+// Role What a user may do, granted as the scopes of their token. Customers browse and buy sodas; operators also restock, reprice and lay out the slots, handle the cash box and close the day; admins also manage the users.
+type Role string
+
+// SalesReport defines model for SalesReport.
+type SalesReport struct {
+	Bucket ReportBucket `json:"bucket"`
+	From   time.Time    `json:"from"`
+
+	// Rows Sales per period and soda, ordered by period and soda ID.
+	Rows []SalesReportRow `json:"rows"`
+	To   time.Time        `json:"to"`
+
+	// Totals Sales per soda over the whole range, ordered by soda ID.
+	Totals []SalesReportRow `json:"totals"`
+}
+
+// SalesReportRow The sales of one soda in one currency, over one period or, for totals, over the whole range.
+type SalesReportRow struct {
+	// AveragePrice An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	AveragePrice Money `json:"averagePrice"`
+
+	// PeriodStart Start of the period. Absent from totals.
+	PeriodStart *time.Time `json:"periodStart,omitempty"`
+
+	// Revenue An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	Revenue   Money   `json:"revenue"`
+	SodaId    string  `json:"sodaId"`
+	SodaName  *string `json:"sodaName,omitempty"`
+	UnitsSold int     `json:"unitsSold"`
+}
+
+// SlotPosition Physical position of a slot: the row, or tray, of the machine and the column of the coil within it.
+type SlotPosition struct {
+	Column int    `json:"column"`
+	Row    string `json:"row"`
+}
+
 // Soda Represents a soda available for purchase, including metadata such as name, description, origin story, calories, and volume in ounces. This schema is used to detail the sodas offered by the vending machine, allowing users to make informed choices.
 type Soda struct {
-	Calories    *int     `json:"calories,omitempty"`
-	Description *string  `json:"description,omitempty"`
+	Calories    *int    `json:"calories,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// Id Catalog ID of the soda, such as cola. IDs are case-insensitive; when omitted the ID is derived from the name by lower-casing it and replacing spaces with dashes.
+	Id          *string  `json:"id,omitempty"`
 	Name        *string  `json:"name,omitempty"`
 	OriginStory *string  `json:"originStory,omitempty"`
 	Ounces      *float32 `json:"ounces,omitempty"`
 }
 
+// Transaction One entry of the transaction ledger. Amounts that do not apply to the operation are left out: a purchase records the price, what was paid and the change, a price change the previous and the new price.
+type Transaction struct {
+	// Actor The user who made the change, taken from the subject of their token.
+	Actor string `json:"actor"`
+
+	// Change An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	Change *Money `json:"change,omitempty"`
+	Id     *int64 `json:"id,omitempty"`
+
+	// Leftover Units of a restock that did not fit in the slot.
+	Leftover  *int                 `json:"leftover,omitempty"`
+	Operation TransactionOperation `json:"operation"`
+
+	// Paid An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	Paid *Money `json:"paid,omitempty"`
+
+	// PaymentMethod How a purchase was paid: with coins and bills inserted in the machine, or cashless.
+	PaymentMethod *PaymentMethod `json:"paymentMethod,omitempty"`
+
+	// PreviousPrice An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	PreviousPrice *Money `json:"previousPrice,omitempty"`
+
+	// Price An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	Price          *Money    `json:"price,omitempty"`
+	QuantityAfter  *int      `json:"quantityAfter,omitempty"`
+	QuantityBefore *int      `json:"quantityBefore,omitempty"`
+	SlotId         string    `json:"slotId"`
+	SodaId         *string   `json:"sodaId,omitempty"`
+	SodaName       *string   `json:"sodaName,omitempty"`
+	Timestamp      time.Time `json:"timestamp"`
+}
+
+// TransactionOperation defines model for TransactionOperation.
+type TransactionOperation string
+
+// User A user of the directory who can log in.
+type User struct {
+	// Admin Use role instead. Whether the user has the admin role.
+	// Deprecated:
+	Admin     *bool      `json:"admin,omitempty"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// Disabled Disabled users cannot log in.
+	Disabled bool `json:"disabled"`
+
+	// Role What a user may do, granted as the scopes of their token. Customers browse and buy sodas; operators also restock, reprice and lay out the slots, handle the cash box and close the day; admins also manage the users.
+	Role      Role       `json:"role"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	Username  string     `json:"username"`
+}
+
 // VendingSlot Defines a slot within the vending machine, containing a soda, its cost, maximum quantity, and current stock level. This schema is crucial for managing the inventory and pricing of sodas, ensuring a seamless vending operation.
 type VendingSlot struct {
-	Cost        *float32 `json:"cost,omitempty"`
-	MaxQuantity *int     `json:"maxQuantity,omitempty"`
+	// Cost Use price instead. The price as a float in major units, kept in step with price.
+	// Deprecated:
+	Cost *float32 `json:"cost,omitempty"`
+
+	// Id ID of the slot, usually its position in the machine such as A1 or B3. Slot IDs are case-insensitive.
+	Id          *string `json:"id,omitempty"`
+	MaxQuantity *int    `json:"maxQuantity,omitempty"`
 
 	// OccupiedSoda Represents a soda available for purchase, including metadata such as name, description, origin story, calories, and volume in ounces. This schema is used to detail the sodas offered by the vending machine, allowing users to make informed choices.
 	OccupiedSoda *Soda `json:"occupiedSoda,omitempty"`
-	Quantity     *int  `json:"quantity,omitempty"`
+
+	// Position Physical position of a slot: the row, or tray, of the machine and the column of the coil within it.
+	Position *SlotPosition `json:"position,omitempty"`
+
+	// Price An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	Price    *Money `json:"price,omitempty"`
+	Quantity *int   `json:"quantity,omitempty"`
+
+	// Version Monotonically increasing version of the slot, bumped by every change to it. It is also returned as the ETag of responses about the slot and can be sent back in an If-Match header to make sure a change is only applied to the version that was read.
+	Version *int64 `json:"version,omitempty"`
+}
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// APIKeyResponse An API key, without the key itself. Requests made with it are recorded in the ledger as made by apikey: followed by its ID.
+type APIKeyResponse = APIKey
+
+// APIKeysResponse defines model for APIKeysResponse.
+type APIKeysResponse struct {
+	ApiKeys []APIKey `json:"apiKeys"`
+}
+
+// AuditTrailResponse defines model for AuditTrailResponse.
+type AuditTrailResponse struct {
+	// NextCursor Pass as cursor to get the next page. Absent on the last page.
+	NextCursor *string       `json:"nextCursor,omitempty"`
+	Records    []AuditRecord `json:"records"`
 }
 
 // AuthTokenResponse defines model for AuthTokenResponse.
 type AuthTokenResponse struct {
+	// ExpiresAt When the access token expires.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// RefreshExpiresAt When the refresh token expires.
+	RefreshExpiresAt *time.Time `json:"refreshExpiresAt,omitempty"`
+
+	// RefreshToken Exchanged at /auth/refresh for new tokens once the access token expired. It cannot authorize other requests.
+	RefreshToken *string `json:"refreshToken,omitempty"`
+
+	// Token The access token.
 	Token *string `json:"token,omitempty"`
 }
 
-// ErrorResp defines model for ErrorResp.
-type ErrorResp struct {
-	Error *string `json:"error,omitempty"`
+// CashBoxResponse The coins and bills the vending machine holds to give change, largest denomination first.
+type CashBoxResponse = CashBox
+
+// CreatedAPIKeyResponse defines model for CreatedAPIKeyResponse.
+type CreatedAPIKeyResponse struct {
+	// ApiKey An API key, without the key itself. Requests made with it are recorded in the ledger as made by apikey: followed by its ID.
+	ApiKey APIKey `json:"apiKey"`
+
+	// Key The key, to send in the X-API-Key header.
+	Key string `json:"key"`
+}
+
+// DayCloseResponse The end-of-day report (Z-report) of a closed period.
+type DayCloseResponse = DayClose
+
+// DayClosesResponse defines model for DayClosesResponse.
+type DayClosesResponse struct {
+	Periods []DayClose `json:"periods"`
+}
+
+// ErrorResp An error, reported as RFC 7807 problem details with the application/problem+json media type. type identifies the kind of problem:
+//
+// - about:blank: the status code says it all, and title is its reason phrase.
+// - /problems/validation: the request does not match the spec; errors lists what is wrong with each field.
+// - /problems/currency-mismatch: the payment is in another currency than the price.
+// - /problems/insufficient-funds: the payment does not cover the price.
+// - /problems/sold-out: no slot holding the soda has any left.
+// - /problems/exact-change-only: the cash box cannot give the change.
+type ErrorResp = Problem
+
+// JWKSResponse defines model for JWKSResponse.
+type JWKSResponse struct {
+	Keys []map[string]interface{} `json:"keys"`
+}
+
+// LoginLockoutsResponse defines model for LoginLockoutsResponse.
+type LoginLockoutsResponse struct {
+	Lockouts []LoginLockout `json:"lockouts"`
 }
 
 // MessageResponse defines model for MessageResponse.
@@ -58,10 +507,24 @@ type MessageResponse struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// PlanogramResponse A machine layout together with the sodas assigned to its coils.
+type PlanogramResponse = Planogram
+
 // PurchaseSodaResponse defines model for PurchaseSodaResponse.
 type PurchaseSodaResponse struct {
+	// Change Use changeDue instead.
+	// Deprecated:
 	Change *float32 `json:"change,omitempty"`
 
+	// ChangeDenominations The coins and bills the change was given in, for purchases paid with inserted cash.
+	ChangeDenominations *[]Denomination `json:"changeDenominations,omitempty"`
+
+	// ChangeDue An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	ChangeDue *Money `json:"changeDue,omitempty"`
+
+	// SlotId ID of the slot the soda was dispensed from.
+	SlotId *string `json:"slotId,omitempty"`
+
 	// Soda Represents a soda available for purchase, including metadata such as name, description, origin story, calories, and volume in ounces. This schema is used to detail the sodas offered by the vending machine, allowing users to make informed choices.
 	Soda *Soda `json:"soda,omitempty"`
 }
@@ -73,17 +536,64 @@ type RestockResponse struct {
 	OldQuantity *int `json:"oldQuantity,omitempty"`
 }
 
+// SalesReportResponse defines model for SalesReportResponse.
+type SalesReportResponse = SalesReport
+
+// SodaCatalogResponse defines model for SodaCatalogResponse.
+type SodaCatalogResponse struct {
+	Sodas *[]Soda `json:"sodas,omitempty"`
+	Total *int    `json:"total,omitempty"`
+}
+
+// TooManyAttemptsResp An error, reported as RFC 7807 problem details with the application/problem+json media type. type identifies the kind of problem:
+//
+// - about:blank: the status code says it all, and title is its reason phrase.
+// - /problems/validation: the request does not match the spec; errors lists what is wrong with each field.
+// - /problems/currency-mismatch: the payment is in another currency than the price.
+// - /problems/insufficient-funds: the payment does not cover the price.
+// - /problems/sold-out: no slot holding the soda has any left.
+// - /problems/exact-change-only: the cash box cannot give the change.
+type TooManyAttemptsResp = Problem
+
+// TransactionsResponse defines model for TransactionsResponse.
+type TransactionsResponse struct {
+	// NextCursor Pass as cursor to get the next page. Absent on the last page.
+	NextCursor   *string       `json:"nextCursor,omitempty"`
+	Transactions []Transaction `json:"transactions"`
+}
+
 // UpdatePriceResp defines model for UpdatePriceResp.
 type UpdatePriceResp struct {
+	// NewPrice Use price instead.
+	// Deprecated:
 	NewPrice *float32 `json:"newPrice,omitempty"`
+
+	// OldPrice Use previousPrice instead.
+	// Deprecated:
 	OldPrice *float32 `json:"oldPrice,omitempty"`
-	SlotName *string  `json:"slotName,omitempty"`
+
+	// PreviousPrice An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	PreviousPrice *Money `json:"previousPrice,omitempty"`
+
+	// Price An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	Price    *Money  `json:"price,omitempty"`
+	SlotName *string `json:"slotName,omitempty"`
+}
+
+// UserResponse A user of the directory who can log in.
+type UserResponse = User
+
+// UsersResponse defines model for UsersResponse.
+type UsersResponse struct {
+	Users []User `json:"users"`
 }
 
 // VendingMachineResponse defines model for VendingMachineResponse.
 type VendingMachineResponse struct {
-	Slots *[]VendingSlot `json:"slots,omitempty"`
-	Total *int           `json:"total,omitempty"`
+	// NextCursor Pass as cursor to get the next page. Absent on the last page.
+	NextCursor *string        `json:"nextCursor,omitempty"`
+	Slots      *[]VendingSlot `json:"slots,omitempty"`
+	Total      *int           `json:"total,omitempty"`
 }
 
 // AuthRequestBody defines model for AuthRequestBody.
@@ -92,28 +602,111 @@ type AuthRequestBody struct {
 	Username string `json:"username"`
 }
 
+// CloseDayBody defines model for CloseDayBody.
+type CloseDayBody struct {
+	// CountedCash An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	CountedCash Money   `json:"countedCash"`
+	Note        *string `json:"note,omitempty"`
+}
+
+// CreateAPIKeyBody defines model for CreateAPIKeyBody.
+type CreateAPIKeyBody struct {
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Name      string     `json:"name"`
+	Scopes    []string   `json:"scopes"`
+}
+
+// CreateUserBody defines model for CreateUserBody.
+type CreateUserBody struct {
+	// Admin Use role instead. Creates an admin when no role is given.
+	// Deprecated:
+	Admin    *bool  `json:"admin,omitempty"`
+	Password string `json:"password"`
+
+	// Role What a user may do, granted as the scopes of their token. Customers browse and buy sodas; operators also restock, reprice and lay out the slots, handle the cash box and close the day; admins also manage the users.
+	Role     *Role  `json:"role,omitempty"`
+	Username string `json:"username"`
+}
+
+// EmptyCashBoxBody defines model for EmptyCashBoxBody.
+type EmptyCashBoxBody struct {
+	Denominations *[]Denomination `json:"denominations,omitempty"`
+}
+
+// FillCashBoxBody defines model for FillCashBoxBody.
+type FillCashBoxBody struct {
+	Currency      *string        `json:"currency,omitempty"`
+	Denominations []Denomination `json:"denominations"`
+}
+
+// LogoutBody defines model for LogoutBody.
+type LogoutBody struct {
+	// RefreshToken The refresh token issued with the access token, to revoke as well.
+	RefreshToken *string `json:"refreshToken,omitempty"`
+}
+
 // NewVendingSlotRequestBody defines model for NewVendingSlotRequestBody.
 type NewVendingSlotRequestBody struct {
 	// Slot Defines a slot within the vending machine, containing a soda, its cost, maximum quantity, and current stock level. This schema is crucial for managing the inventory and pricing of sodas, ensuring a seamless vending operation.
 	Slot VendingSlot `json:"slot"`
 }
 
+// PlanogramBody A machine layout together with the sodas assigned to its coils.
+type PlanogramBody = Planogram
+
 // PurchaseSodaBody defines model for PurchaseSodaBody.
 type PurchaseSodaBody struct {
-	Name    string  `json:"name"`
-	Payment float32 `json:"payment"`
+	// Inserted The coins and bills inserted to pay, in the currency of the cash box. When given, paid may be omitted and must otherwise match their total. At most 100000 minor units can be inserted, and at most 32 distinct denominations fit in the cash box.
+	Inserted *[]Denomination `json:"inserted,omitempty"`
+
+	// Name Name or catalog ID of the soda to buy.
+	Name *string `json:"name,omitempty"`
+
+	// Paid An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	Paid *Money `json:"paid,omitempty"`
+
+	// Payment Use paid instead. The payment in USD as a float.
+	// Deprecated:
+	Payment *float32 `json:"payment,omitempty"`
+
+	// SlotId ID of the slot to buy from. When given the name may be omitted.
+	SlotId *string `json:"slotId,omitempty"`
+}
+
+// RefreshTokenBody defines model for RefreshTokenBody.
+type RefreshTokenBody struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// ResetPasswordBody defines model for ResetPasswordBody.
+type ResetPasswordBody struct {
+	Password string `json:"password"`
 }
 
 // RestockRequestBody defines model for RestockRequestBody.
 type RestockRequestBody struct {
-	Name     string `json:"name"`
-	Quantity int    `json:"quantity"`
+	Name string `json:"name"`
+
+	// Quantity How many sodas to load, at least 1.
+	Quantity int `json:"quantity"`
+}
+
+// SetRoleBody defines model for SetRoleBody.
+type SetRoleBody struct {
+	// Role What a user may do, granted as the scopes of their token. Customers browse and buy sodas; operators also restock, reprice and lay out the slots, handle the cash box and close the day; admins also manage the users.
+	Role Role `json:"role"`
 }
 
 // UpdatePriceBody defines model for UpdatePriceBody.
 type UpdatePriceBody struct {
-	Name     string  `json:"name"`
-	NewPrice float32 `json:"newPrice"`
+	Name string `json:"name"`
+
+	// NewPrice Use price instead. The new price as a float in major units of the slot's currency.
+	// Deprecated:
+	NewPrice *float32 `json:"newPrice,omitempty"`
+
+	// Price An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	Price *Money `json:"price,omitempty"`
 }
 
 // VendingSlotRequestBody defines model for VendingSlotRequestBody.
@@ -121,100 +714,348 @@ type VendingSlotRequestBody struct {
 	Name string `json:"name"`
 }
 
+// CreateApiKeyJSONBody defines parameters for CreateApiKey.
+type CreateApiKeyJSONBody struct {
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Name      string     `json:"name"`
+	Scopes    []string   `json:"scopes"`
+}
+
+// GetAuditTrailParams defines parameters for GetAuditTrail.
+type GetAuditTrailParams struct {
+	// Limit Maximum number of records to return, 100 unless given.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor The nextCursor of the previous page, to continue after it.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
 // AuthLoginJSONBody defines parameters for AuthLogin.
 type AuthLoginJSONBody struct {
 	Password string `json:"password"`
 	Username string `json:"username"`
 }
 
-// PostPurchaseJSONBody defines parameters for PostPurchase.
-type PostPurchaseJSONBody struct {
-	Name    string  `json:"name"`
-	Payment float32 `json:"payment"`
+// AuthLogoutJSONBody defines parameters for AuthLogout.
+type AuthLogoutJSONBody struct {
+	// RefreshToken The refresh token issued with the access token, to revoke as well.
+	RefreshToken *string `json:"refreshToken,omitempty"`
 }
 
-// RestockSodaJSONBody defines parameters for RestockSoda.
-type RestockSodaJSONBody struct {
-	Name     string `json:"name"`
-	Quantity int    `json:"quantity"`
+// AuthRefreshJSONBody defines parameters for AuthRefresh.
+type AuthRefreshJSONBody struct {
+	RefreshToken string `json:"refreshToken"`
 }
 
-// UpdatePriceJSONBody defines parameters for UpdatePrice.
-type UpdatePriceJSONBody struct {
-	Name     string  `json:"name"`
-	NewPrice float32 `json:"newPrice"`
+// EmptyCashBoxJSONBody defines parameters for EmptyCashBox.
+type EmptyCashBoxJSONBody struct {
+	Denominations *[]Denomination `json:"denominations,omitempty"`
 }
 
-// DeleteVendingJSONBody defines parameters for DeleteVending.
-type DeleteVendingJSONBody struct {
-	Name string `json:"name"`
+// FillCashBoxJSONBody defines parameters for FillCashBox.
+type FillCashBoxJSONBody struct {
+	Currency      *string        `json:"currency,omitempty"`
+	Denominations []Denomination `json:"denominations"`
 }
 
-// GetVendingJSONBody defines parameters for GetVending.
-type GetVendingJSONBody struct {
-	Name string `json:"name"`
-}
+// ClearLoginLockoutParams defines parameters for ClearLoginLockout.
+type ClearLoginLockoutParams struct {
+	// Username Username whose failed logins are forgotten, case-insensitive.
+	Username *string `form:"username,omitempty" json:"username,omitempty"`
 
-// PostNewJSONBody defines parameters for PostNew.
-type PostNewJSONBody struct {
-	// Slot Defines a slot within the vending machine, containing a soda, its cost, maximum quantity, and current stock level. This schema is crucial for managing the inventory and pricing of sodas, ensuring a seamless vending operation.
-	Slot VendingSlot `json:"slot"`
+	// Ip Client address whose failed logins are forgotten.
+	Ip *string `form:"ip,omitempty" json:"ip,omitempty"`
 }
 
-// AuthLoginJSONRequestBody defines body for AuthLogin for application/json ContentType.
-type AuthLoginJSONRequestBody AuthLoginJSONBody
+// CloseDayJSONBody defines parameters for CloseDay.
+type CloseDayJSONBody struct {
+	// CountedCash An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	CountedCash Money   `json:"countedCash"`
+	Note        *string `json:"note,omitempty"`
+}
 
-// PostPurchaseJSONRequestBody defines body for PostPurchase for application/json ContentType.
-type PostPurchaseJSONRequestBody PostPurchaseJSONBody
+// GetPlanogramParams defines parameters for GetPlanogram.
+type GetPlanogramParams struct {
+	// Format Document format of the export, json unless yaml is requested.
+	Format *GetPlanogramParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
 
-// RestockSodaJSONRequestBody defines body for RestockSoda for application/json ContentType.
-type RestockSodaJSONRequestBody RestockSodaJSONBody
+// GetPlanogramParamsFormat defines parameters for GetPlanogram.
+type GetPlanogramParamsFormat string
 
-// UpdatePriceJSONRequestBody defines body for UpdatePrice for application/json ContentType.
-type UpdatePriceJSONRequestBody UpdatePriceJSONBody
+// PutPlanogramParams defines parameters for PutPlanogram.
+type PutPlanogramParams struct {
+	// IfMatch ETag of the planogram as previously returned by the API. The planogram is only imported if no slot was added, removed or changed since, otherwise 412 Precondition Failed is returned.
+	IfMatch *string `json:"If-Match,omitempty"`
+}
 
-// DeleteVendingJSONRequestBody defines body for DeleteVending for application/json ContentType.
-type DeleteVendingJSONRequestBody DeleteVendingJSONBody
+// PostPurchaseJSONBody defines parameters for PostPurchase.
+type PostPurchaseJSONBody struct {
+	// Inserted The coins and bills inserted to pay, in the currency of the cash box. When given, paid may be omitted and must otherwise match their total. At most 100000 minor units can be inserted, and at most 32 distinct denominations fit in the cash box.
+	Inserted *[]Denomination `json:"inserted,omitempty"`
 
-// GetVendingJSONRequestBody defines body for GetVending for application/json ContentType.
-type GetVendingJSONRequestBody GetVendingJSONBody
+	// Name Name or catalog ID of the soda to buy.
+	Name *string `json:"name,omitempty"`
 
-// PostNewJSONRequestBody defines body for PostNew for application/json ContentType.
-type PostNewJSONRequestBody PostNewJSONBody
+	// Paid An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	Paid *Money `json:"paid,omitempty"`
 
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
+	// Payment Use paid instead. The payment in USD as a float.
+	// Deprecated:
+	Payment *float32 `json:"payment,omitempty"`
 
-// Doer performs HTTP requests.
-//
-// The standard http.Client implements this interface.
-type HttpRequestDoer interface {
-	Do(req *http.Request) (*http.Response, error)
+	// SlotId ID of the slot to buy from. When given the name may be omitted.
+	SlotId *string `json:"slotId,omitempty"`
 }
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	// The endpoint of the server conforming to this interface, with scheme,
-	// https://api.deepmap.com for example. This can contain a path relative
-	// to the server, such as https://api.deepmap.com/dev-test, and all the
-	// paths in the swagger spec will be appended to the server.
-	Server string
+// GetSalesReportParams defines parameters for GetSalesReport.
+type GetSalesReportParams struct {
+	// From Start of the reported range, inclusive. Seven days before to unless given.
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
 
-	// Doer for performing requests, typically a *http.Client with any
-	// customized settings, such as certificate chains.
-	Client HttpRequestDoer
+	// To End of the reported range, exclusive. Now unless given.
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
 
-	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
-	RequestEditors []RequestEditorFn
+	// Bucket Length of the periods sales are grouped in, day unless given.
+	Bucket *ReportBucket `form:"bucket,omitempty" json:"bucket,omitempty"`
+
+	// SodaId Only report the sales of this catalog soda.
+	SodaId *string `form:"sodaId,omitempty" json:"sodaId,omitempty"`
+
+	// Format Document format of the report, json unless csv is requested.
+	Format *GetSalesReportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
 }
 
-// ClientOption allows setting custom parameters during construction
-type ClientOption func(*Client) error
+// GetSalesReportParamsFormat defines parameters for GetSalesReport.
+type GetSalesReportParamsFormat string
 
-// Creates a new Client, with reasonable defaults
-func NewClient(server string, opts ...ClientOption) (*Client, error) {
-	// create a client with sane default values
+// RestockSodaJSONBody defines parameters for RestockSoda.
+type RestockSodaJSONBody struct {
+	Name string `json:"name"`
+
+	// Quantity How many sodas to load, at least 1.
+	Quantity int `json:"quantity"`
+}
+
+// RestockSodaParams defines parameters for RestockSoda.
+type RestockSodaParams struct {
+	// IfMatch ETag of the slot as previously returned by the API. The change is only applied if the slot still has this version, otherwise 412 Precondition Failed is returned. Use * to only require that the slot exists.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetTransactionsParams defines parameters for GetTransactions.
+type GetTransactionsParams struct {
+	// Operation Only list transactions of this operation.
+	Operation *TransactionOperation `form:"operation,omitempty" json:"operation,omitempty"`
+
+	// SlotId Only list transactions of this slot.
+	SlotId *string `form:"slotId,omitempty" json:"slotId,omitempty"`
+
+	// SodaId Only list transactions of this catalog soda.
+	SodaId *string `form:"sodaId,omitempty" json:"sodaId,omitempty"`
+
+	// Actor Only list transactions made by this user.
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// Since Only list transactions recorded at or after this time.
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only list transactions recorded before this time.
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// Limit Maximum number of transactions to return, 50 unless given.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor The nextCursor of the previous page, to continue after it.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// UpdatePriceJSONBody defines parameters for UpdatePrice.
+type UpdatePriceJSONBody struct {
+	Name string `json:"name"`
+
+	// NewPrice Use price instead. The new price as a float in major units of the slot's currency.
+	// Deprecated:
+	NewPrice *float32 `json:"newPrice,omitempty"`
+
+	// Price An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	Price *Money `json:"price,omitempty"`
+}
+
+// UpdatePriceParams defines parameters for UpdatePrice.
+type UpdatePriceParams struct {
+	// IfMatch ETag of the slot as previously returned by the API. The change is only applied if the slot still has this version, otherwise 412 Precondition Failed is returned. Use * to only require that the slot exists.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// CreateUserJSONBody defines parameters for CreateUser.
+type CreateUserJSONBody struct {
+	// Admin Use role instead. Creates an admin when no role is given.
+	// Deprecated:
+	Admin    *bool  `json:"admin,omitempty"`
+	Password string `json:"password"`
+
+	// Role What a user may do, granted as the scopes of their token. Customers browse and buy sodas; operators also restock, reprice and lay out the slots, handle the cash box and close the day; admins also manage the users.
+	Role     *Role  `json:"role,omitempty"`
+	Username string `json:"username"`
+}
+
+// ResetUserPasswordJSONBody defines parameters for ResetUserPassword.
+type ResetUserPasswordJSONBody struct {
+	Password string `json:"password"`
+}
+
+// SetUserRoleJSONBody defines parameters for SetUserRole.
+type SetUserRoleJSONBody struct {
+	// Role What a user may do, granted as the scopes of their token. Customers browse and buy sodas; operators also restock, reprice and lay out the slots, handle the cash box and close the day; admins also manage the users.
+	Role Role `json:"role"`
+}
+
+// DeleteVendingJSONBody defines parameters for DeleteVending.
+type DeleteVendingJSONBody struct {
+	Name string `json:"name"`
+}
+
+// DeleteVendingParams defines parameters for DeleteVending.
+type DeleteVendingParams struct {
+	// IfMatch ETag of the slot as previously returned by the API. The change is only applied if the slot still has this version, otherwise 412 Precondition Failed is returned. Use * to only require that the slot exists.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetVendingJSONBody defines parameters for GetVending.
+type GetVendingJSONBody struct {
+	Name string `json:"name"`
+}
+
+// GetVendingParams defines parameters for GetVending.
+type GetVendingParams struct {
+	// Name Only list slots whose soda name contains this text, case-insensitive.
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	// MinPrice Only list slots priced at least this amount, in the minor unit of currency.
+	MinPrice *int64 `form:"minPrice,omitempty" json:"minPrice,omitempty"`
+
+	// MaxPrice Only list slots priced at most this amount, in the minor unit of currency.
+	MaxPrice *int64 `form:"maxPrice,omitempty" json:"maxPrice,omitempty"`
+
+	// Currency Currency of minPrice and maxPrice, USD unless given. Slots priced in another currency are not listed when either is given.
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
+
+	// MinCalories Only list slots whose soda has at least this many calories.
+	MinCalories *int `form:"minCalories,omitempty" json:"minCalories,omitempty"`
+
+	// MaxCalories Only list slots whose soda has at most this many calories.
+	MaxCalories *int `form:"maxCalories,omitempty" json:"maxCalories,omitempty"`
+
+	// MinOunces Only list slots whose soda is at least this many ounces.
+	MinOunces *float32 `form:"minOunces,omitempty" json:"minOunces,omitempty"`
+
+	// MaxOunces Only list slots whose soda is at most this many ounces.
+	MaxOunces *float32 `form:"maxOunces,omitempty" json:"maxOunces,omitempty"`
+
+	// Stock Only list slots in_stock, holding at least one soda, low_stock, holding at least one but no more than a fifth of their maxQuantity, or sold_out.
+	Stock *string `form:"stock,omitempty" json:"stock,omitempty"`
+
+	// Sort What to order the slots by: id, name, price, calories, ounces or quantity, prefixed with - to reverse the order. Slots without the value come first, and ties are ordered by slot ID. id unless given.
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit Maximum number of slots to return. Every matching slot is returned unless given.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor The nextCursor of the previous page, to continue after it with the same sort.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// PostNewJSONBody defines parameters for PostNew.
+type PostNewJSONBody struct {
+	// Slot Defines a slot within the vending machine, containing a soda, its cost, maximum quantity, and current stock level. This schema is crucial for managing the inventory and pricing of sodas, ensuring a seamless vending operation.
+	Slot VendingSlot `json:"slot"`
+}
+
+// CreateApiKeyJSONRequestBody defines body for CreateApiKey for application/json ContentType.
+type CreateApiKeyJSONRequestBody CreateApiKeyJSONBody
+
+// AuthLoginJSONRequestBody defines body for AuthLogin for application/json ContentType.
+type AuthLoginJSONRequestBody AuthLoginJSONBody
+
+// AuthLogoutJSONRequestBody defines body for AuthLogout for application/json ContentType.
+type AuthLogoutJSONRequestBody AuthLogoutJSONBody
+
+// AuthRefreshJSONRequestBody defines body for AuthRefresh for application/json ContentType.
+type AuthRefreshJSONRequestBody AuthRefreshJSONBody
+
+// EmptyCashBoxJSONRequestBody defines body for EmptyCashBox for application/json ContentType.
+type EmptyCashBoxJSONRequestBody EmptyCashBoxJSONBody
+
+// FillCashBoxJSONRequestBody defines body for FillCashBox for application/json ContentType.
+type FillCashBoxJSONRequestBody FillCashBoxJSONBody
+
+// CloseDayJSONRequestBody defines body for CloseDay for application/json ContentType.
+type CloseDayJSONRequestBody CloseDayJSONBody
+
+// PutPlanogramJSONRequestBody defines body for PutPlanogram for application/json ContentType.
+type PutPlanogramJSONRequestBody = Planogram
+
+// PostPurchaseJSONRequestBody defines body for PostPurchase for application/json ContentType.
+type PostPurchaseJSONRequestBody PostPurchaseJSONBody
+
+// RestockSodaJSONRequestBody defines body for RestockSoda for application/json ContentType.
+type RestockSodaJSONRequestBody RestockSodaJSONBody
+
+// UpdatePriceJSONRequestBody defines body for UpdatePrice for application/json ContentType.
+type UpdatePriceJSONRequestBody UpdatePriceJSONBody
+
+// CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
+type CreateUserJSONRequestBody CreateUserJSONBody
+
+// ResetUserPasswordJSONRequestBody defines body for ResetUserPassword for application/json ContentType.
+type ResetUserPasswordJSONRequestBody ResetUserPasswordJSONBody
+
+// SetUserRoleJSONRequestBody defines body for SetUserRole for application/json ContentType.
+type SetUserRoleJSONRequestBody SetUserRoleJSONBody
+
+// DeleteVendingJSONRequestBody defines body for DeleteVending for application/json ContentType.
+type DeleteVendingJSONRequestBody DeleteVendingJSONBody
+
+// GetVendingJSONRequestBody defines body for GetVending for application/json ContentType.
+type GetVendingJSONRequestBody GetVendingJSONBody
+
+// PostNewJSONRequestBody defines body for PostNew for application/json ContentType.
+type PostNewJSONRequestBody PostNewJSONBody
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
 	client := Client{
 		Server: server,
 	}
@@ -255,35 +1096,136 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetJwks request
+	GetJwks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiKeys request
+	GetApiKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateApiKeyWithBody request with any body
+	CreateApiKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateApiKey(ctx context.Context, body CreateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeApiKey request
+	RevokeApiKey(ctx context.Context, keyId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDexAudit request
+	GetDexAudit(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAuditTrail request
+	GetAuditTrail(ctx context.Context, params *GetAuditTrailParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// AuthLoginWithBody request with any body
 	AuthLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	AuthLogin(ctx context.Context, body AuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AuthLogoutWithBody request with any body
+	AuthLogoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthLogout(ctx context.Context, body AuthLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthRefreshWithBody request with any body
+	AuthRefreshWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthRefresh(ctx context.Context, body AuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCashBox request
+	GetCashBox(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EmptyCashBoxWithBody request with any body
+	EmptyCashBoxWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EmptyCashBox(ctx context.Context, body EmptyCashBoxJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FillCashBoxWithBody request with any body
+	FillCashBoxWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	FillCashBox(ctx context.Context, body FillCashBoxJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ClearLoginLockout request
+	ClearLoginLockout(ctx context.Context, params *ClearLoginLockoutParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLoginLockouts request
+	GetLoginLockouts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDayCloses request
+	GetDayCloses(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CloseDayWithBody request with any body
+	CloseDayWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CloseDay(ctx context.Context, body CloseDayJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDayClose request
+	GetDayClose(ctx context.Context, periodId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPlanogram request
+	GetPlanogram(ctx context.Context, params *GetPlanogramParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutPlanogramWithBody request with any body
+	PutPlanogramWithBody(ctx context.Context, params *PutPlanogramParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutPlanogram(ctx context.Context, params *PutPlanogramParams, body PutPlanogramJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostPurchaseWithBody request with any body
 	PostPurchaseWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostPurchase(ctx context.Context, body PostPurchaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetSalesReport request
+	GetSalesReport(ctx context.Context, params *GetSalesReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// RestockSodaWithBody request with any body
-	RestockSodaWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	RestockSodaWithBody(ctx context.Context, params *RestockSodaParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RestockSoda(ctx context.Context, params *RestockSodaParams, body RestockSodaJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSodas request
+	GetSodas(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	RestockSoda(ctx context.Context, body RestockSodaJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// GetTransactions request
+	GetTransactions(ctx context.Context, params *GetTransactionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdatePriceWithBody request with any body
-	UpdatePriceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpdatePriceWithBody(ctx context.Context, params *UpdatePriceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	UpdatePrice(ctx context.Context, body UpdatePriceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpdatePrice(ctx context.Context, params *UpdatePriceParams, body UpdatePriceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsers request
+	GetUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateUserWithBody request with any body
+	CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateUser(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DisableUser request
+	DisableUser(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EnableUser request
+	EnableUser(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResetUserPasswordWithBody request with any body
+	ResetUserPasswordWithBody(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ResetUserPassword(ctx context.Context, username string, body ResetUserPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetUserRoleWithBody request with any body
+	SetUserRoleWithBody(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetUserRole(ctx context.Context, username string, body SetUserRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteVendingWithBody request with any body
-	DeleteVendingWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteVendingWithBody(ctx context.Context, params *DeleteVendingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	DeleteVending(ctx context.Context, body DeleteVendingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteVending(ctx context.Context, params *DeleteVendingParams, body DeleteVendingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetVendingWithBody request with any body
-	GetVendingWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetVendingWithBody(ctx context.Context, params *GetVendingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	GetVending(ctx context.Context, body GetVendingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetVending(ctx context.Context, params *GetVendingParams, body GetVendingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// PostNewWithBody request with any body
 	PostNewWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -291,8 +1233,8 @@ type ClientInterface interface {
 	PostNew(ctx context.Context, body PostNewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) AuthLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAuthLoginRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetJwks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJwksRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -303,8 +1245,8 @@ func (c *Client) AuthLoginWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) AuthLogin(ctx context.Context, body AuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAuthLoginRequest(c.Server, body)
+func (c *Client) GetApiKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiKeysRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -315,8 +1257,8 @@ func (c *Client) AuthLogin(ctx context.Context, body AuthLoginJSONRequestBody, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostPurchaseWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostPurchaseRequestWithBody(c.Server, contentType, body)
+func (c *Client) CreateApiKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateApiKeyRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -327,8 +1269,8 @@ func (c *Client) PostPurchaseWithBody(ctx context.Context, contentType string, b
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostPurchase(ctx context.Context, body PostPurchaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostPurchaseRequest(c.Server, body)
+func (c *Client) CreateApiKey(ctx context.Context, body CreateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateApiKeyRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -339,8 +1281,8 @@ func (c *Client) PostPurchase(ctx context.Context, body PostPurchaseJSONRequestB
 	return c.Client.Do(req)
 }
 
-func (c *Client) RestockSodaWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRestockSodaRequestWithBody(c.Server, contentType, body)
+func (c *Client) RevokeApiKey(ctx context.Context, keyId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeApiKeyRequest(c.Server, keyId)
 	if err != nil {
 		return nil, err
 	}
@@ -351,8 +1293,8 @@ func (c *Client) RestockSodaWithBody(ctx context.Context, contentType string, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) RestockSoda(ctx context.Context, body RestockSodaJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRestockSodaRequest(c.Server, body)
+func (c *Client) GetDexAudit(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDexAuditRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -363,8 +1305,8 @@ func (c *Client) RestockSoda(ctx context.Context, body RestockSodaJSONRequestBod
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePriceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePriceRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetAuditTrail(ctx context.Context, params *GetAuditTrailParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuditTrailRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -375,8 +1317,8 @@ func (c *Client) UpdatePriceWithBody(ctx context.Context, contentType string, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePrice(ctx context.Context, body UpdatePriceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePriceRequest(c.Server, body)
+func (c *Client) AuthLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthLoginRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -387,8 +1329,8 @@ func (c *Client) UpdatePrice(ctx context.Context, body UpdatePriceJSONRequestBod
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteVendingWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteVendingRequestWithBody(c.Server, contentType, body)
+func (c *Client) AuthLogin(ctx context.Context, body AuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthLoginRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -399,8 +1341,8 @@ func (c *Client) DeleteVendingWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteVending(ctx context.Context, body DeleteVendingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteVendingRequest(c.Server, body)
+func (c *Client) AuthLogoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthLogoutRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -411,8 +1353,8 @@ func (c *Client) DeleteVending(ctx context.Context, body DeleteVendingJSONReques
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetVendingWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetVendingRequestWithBody(c.Server, contentType, body)
+func (c *Client) AuthLogout(ctx context.Context, body AuthLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthLogoutRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -423,8 +1365,8 @@ func (c *Client) GetVendingWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetVending(ctx context.Context, body GetVendingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetVendingRequest(c.Server, body)
+func (c *Client) AuthRefreshWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthRefreshRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -435,8 +1377,8 @@ func (c *Client) GetVending(ctx context.Context, body GetVendingJSONRequestBody,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostNewWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostNewRequestWithBody(c.Server, contentType, body)
+func (c *Client) AuthRefresh(ctx context.Context, body AuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthRefreshRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -447,8 +1389,8 @@ func (c *Client) PostNewWithBody(ctx context.Context, contentType string, body i
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostNew(ctx context.Context, body PostNewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostNewRequest(c.Server, body)
+func (c *Client) GetCashBox(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCashBoxRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -459,947 +1401,6337 @@ func (c *Client) PostNew(ctx context.Context, body PostNewJSONRequestBody, reqEd
 	return c.Client.Do(req)
 }
 
-// NewAuthLoginRequest calls the generic AuthLogin builder with application/json body
-func NewAuthLoginRequest(server string, body AuthLoginJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) EmptyCashBoxWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEmptyCashBoxRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAuthLoginRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAuthLoginRequestWithBody generates requests for AuthLogin with any type of body
-func NewAuthLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) EmptyCashBox(ctx context.Context, body EmptyCashBoxJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEmptyCashBoxRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/login")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) FillCashBoxWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFillCashBoxRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostPurchaseRequest calls the generic PostPurchase builder with application/json body
-func NewPostPurchaseRequest(server string, body PostPurchaseJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) FillCashBox(ctx context.Context, body FillCashBoxJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFillCashBoxRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostPurchaseRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostPurchaseRequestWithBody generates requests for PostPurchase with any type of body
-func NewPostPurchaseRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ClearLoginLockout(ctx context.Context, params *ClearLoginLockoutParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewClearLoginLockoutRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/purchase")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetLoginLockouts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLoginLockoutsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) GetDayCloses(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDayClosesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewRestockSodaRequest calls the generic RestockSoda builder with application/json body
-func NewRestockSodaRequest(server string, body RestockSodaJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) CloseDayWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloseDayRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewRestockSodaRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewRestockSodaRequestWithBody generates requests for RestockSoda with any type of body
-func NewRestockSodaRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CloseDay(ctx context.Context, body CloseDayJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloseDayRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/restock")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetDayClose(ctx context.Context, periodId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDayCloseRequest(c.Server, periodId)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdatePriceRequest calls the generic UpdatePrice builder with application/json body
-func NewUpdatePriceRequest(server string, body UpdatePriceJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetPlanogram(ctx context.Context, params *GetPlanogramParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPlanogramRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdatePriceRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdatePriceRequestWithBody generates requests for UpdatePrice with any type of body
-func NewUpdatePriceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PutPlanogramWithBody(ctx context.Context, params *PutPlanogramParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutPlanogramRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/updatePrice")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PutPlanogram(ctx context.Context, params *PutPlanogramParams, body PutPlanogramJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutPlanogramRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) PostPurchaseWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPurchaseRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeleteVendingRequest calls the generic DeleteVending builder with application/json body
-func NewDeleteVendingRequest(server string, body DeleteVendingJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostPurchase(ctx context.Context, body PostPurchaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPurchaseRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewDeleteVendingRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeleteVendingRequestWithBody generates requests for DeleteVending with any type of body
-func NewDeleteVendingRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetSalesReport(ctx context.Context, params *GetSalesReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSalesReportRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/vending")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RestockSodaWithBody(ctx context.Context, params *RestockSodaParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestockSodaRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+func (c *Client) RestockSoda(ctx context.Context, params *RestockSodaParams, body RestockSodaJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestockSodaRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetVendingRequest calls the generic GetVending builder with application/json body
-func NewGetVendingRequest(server string, body GetVendingJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetSodas(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSodasRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewGetVendingRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetVendingRequestWithBody generates requests for GetVending with any type of body
-func NewGetVendingRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetTransactions(ctx context.Context, params *GetTransactionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTransactionsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/vending")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdatePriceWithBody(ctx context.Context, params *UpdatePriceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePriceRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), body)
+func (c *Client) UpdatePrice(ctx context.Context, params *UpdatePriceParams, body UpdatePriceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePriceRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostNewRequest calls the generic PostNew builder with application/json body
-func NewPostNewRequest(server string, body PostNewJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostNewRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostNewRequestWithBody generates requests for PostNew with any type of body
-func NewPostNewRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/vending")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateUser(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) DisableUser(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisableUserRequest(c.Server, username)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *Client) EnableUser(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEnableUserRequest(c.Server, username)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return nil
+	return c.Client.Do(req)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+func (c *Client) ResetUserPasswordWithBody(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetUserPasswordRequestWithBody(c.Server, username, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+func (c *Client) ResetUserPassword(ctx context.Context, username string, body ResetUserPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetUserPasswordRequest(c.Server, username, body)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+func (c *Client) SetUserRoleWithBody(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetUserRoleRequestWithBody(c.Server, username, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// AuthLoginWithBodyWithResponse request with any body
-	AuthLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthLoginResponse, error)
-
-	AuthLoginWithResponse(ctx context.Context, body AuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthLoginResponse, error)
-
-	// PostPurchaseWithBodyWithResponse request with any body
-	PostPurchaseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPurchaseResponse, error)
-
-	PostPurchaseWithResponse(ctx context.Context, body PostPurchaseJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPurchaseResponse, error)
-
-	// RestockSodaWithBodyWithResponse request with any body
-	RestockSodaWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RestockSodaResponse, error)
-
-	RestockSodaWithResponse(ctx context.Context, body RestockSodaJSONRequestBody, reqEditors ...RequestEditorFn) (*RestockSodaResponse, error)
-
-	// UpdatePriceWithBodyWithResponse request with any body
-	UpdatePriceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePriceResponse, error)
-
-	UpdatePriceWithResponse(ctx context.Context, body UpdatePriceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePriceResponse, error)
-
-	// DeleteVendingWithBodyWithResponse request with any body
-	DeleteVendingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteVendingResponse, error)
-
-	DeleteVendingWithResponse(ctx context.Context, body DeleteVendingJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteVendingResponse, error)
-
-	// GetVendingWithBodyWithResponse request with any body
-	GetVendingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetVendingResponse, error)
-
-	GetVendingWithResponse(ctx context.Context, body GetVendingJSONRequestBody, reqEditors ...RequestEditorFn) (*GetVendingResponse, error)
-
-	// PostNewWithBodyWithResponse request with any body
-	PostNewWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostNewResponse, error)
-
-	PostNewWithResponse(ctx context.Context, body PostNewJSONRequestBody, reqEditors ...RequestEditorFn) (*PostNewResponse, error)
-}
-
-type AuthLoginResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AuthTokenResponse
-	JSON401      *MessageResponse
-}
-
-// Status returns HTTPResponse.Status
-func (r AuthLoginResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) SetUserRole(ctx context.Context, username string, body SetUserRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetUserRoleRequest(c.Server, username, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r AuthLoginResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type PostPurchaseResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PurchaseSodaResponse
-	JSON402      *MessageResponse
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostPurchaseResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) DeleteVendingWithBody(ctx context.Context, params *DeleteVendingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteVendingRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostPurchaseResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type RestockSodaResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *RestockResponse
-	JSON404      *MessageResponse
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r RestockSodaResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) DeleteVending(ctx context.Context, params *DeleteVendingParams, body DeleteVendingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteVendingRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r RestockSodaResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type UpdatePriceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UpdatePriceResp
-	JSON404      *MessageResponse
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdatePriceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) GetVendingWithBody(ctx context.Context, params *GetVendingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetVendingRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdatePriceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type DeleteVendingResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MessageResponse
-	JSON404      *MessageResponse
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteVendingResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) GetVending(ctx context.Context, params *GetVendingParams, body GetVendingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetVendingRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteVendingResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type GetVendingResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *VendingMachineResponse
-	JSON404      *MessageResponse
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetVendingResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostNewWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostNewRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetVendingResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) PostNew(ctx context.Context, body PostNewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostNewRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type PostNewResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *MessageResponse
-	JSON409      *MessageResponse
-}
+// NewGetJwksRequest generates requests for GetJwks
+func NewGetJwksRequest(server string) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r PostNewResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostNewResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/.well-known/jwks.json")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-// AuthLoginWithBodyWithResponse request with arbitrary body returning *AuthLoginResponse
-func (c *ClientWithResponses) AuthLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthLoginResponse, error) {
-	rsp, err := c.AuthLoginWithBody(ctx, contentType, body, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAuthLoginResponse(rsp)
-}
 
-func (c *ClientWithResponses) AuthLoginWithResponse(ctx context.Context, body AuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthLoginResponse, error) {
-	rsp, err := c.AuthLogin(ctx, body, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAuthLoginResponse(rsp)
+
+	return req, nil
 }
 
-// PostPurchaseWithBodyWithResponse request with arbitrary body returning *PostPurchaseResponse
-func (c *ClientWithResponses) PostPurchaseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPurchaseResponse, error) {
-	rsp, err := c.PostPurchaseWithBody(ctx, contentType, body, reqEditors...)
+// NewGetApiKeysRequest generates requests for GetApiKeys
+func NewGetApiKeysRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostPurchaseResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostPurchaseWithResponse(ctx context.Context, body PostPurchaseJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPurchaseResponse, error) {
-	rsp, err := c.PostPurchase(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/apikeys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParsePostPurchaseResponse(rsp)
-}
 
-// RestockSodaWithBodyWithResponse request with arbitrary body returning *RestockSodaResponse
-func (c *ClientWithResponses) RestockSodaWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RestockSodaResponse, error) {
-	rsp, err := c.RestockSodaWithBody(ctx, contentType, body, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseRestockSodaResponse(rsp)
-}
 
-func (c *ClientWithResponses) RestockSodaWithResponse(ctx context.Context, body RestockSodaJSONRequestBody, reqEditors ...RequestEditorFn) (*RestockSodaResponse, error) {
-	rsp, err := c.RestockSoda(ctx, body, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseRestockSodaResponse(rsp)
+
+	return req, nil
 }
 
-// UpdatePriceWithBodyWithResponse request with arbitrary body returning *UpdatePriceResponse
-func (c *ClientWithResponses) UpdatePriceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePriceResponse, error) {
-	rsp, err := c.UpdatePriceWithBody(ctx, contentType, body, reqEditors...)
+// NewCreateApiKeyRequest calls the generic CreateApiKey builder with application/json body
+func NewCreateApiKeyRequest(server string, body CreateApiKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdatePriceResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateApiKeyRequestWithBody(server, "application/json", bodyReader)
 }
 
-func (c *ClientWithResponses) UpdatePriceWithResponse(ctx context.Context, body UpdatePriceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePriceResponse, error) {
-	rsp, err := c.UpdatePrice(ctx, body, reqEditors...)
+// NewCreateApiKeyRequestWithBody generates requests for CreateApiKey with any type of body
+func NewCreateApiKeyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdatePriceResponse(rsp)
-}
 
-// DeleteVendingWithBodyWithResponse request with arbitrary body returning *DeleteVendingResponse
-func (c *ClientWithResponses) DeleteVendingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteVendingResponse, error) {
-	rsp, err := c.DeleteVendingWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/apikeys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseDeleteVendingResponse(rsp)
-}
 
-func (c *ClientWithResponses) DeleteVendingWithResponse(ctx context.Context, body DeleteVendingJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteVendingResponse, error) {
-	rsp, err := c.DeleteVending(ctx, body, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteVendingResponse(rsp)
-}
 
-// GetVendingWithBodyWithResponse request with arbitrary body returning *GetVendingResponse
-func (c *ClientWithResponses) GetVendingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetVendingResponse, error) {
-	rsp, err := c.GetVendingWithBody(ctx, contentType, body, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetVendingResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) GetVendingWithResponse(ctx context.Context, body GetVendingJSONRequestBody, reqEditors ...RequestEditorFn) (*GetVendingResponse, error) {
-	rsp, err := c.GetVending(ctx, body, reqEditors...)
+// NewRevokeApiKeyRequest generates requests for RevokeApiKey
+func NewRevokeApiKeyRequest(server string, keyId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "keyId", runtime.ParamLocationPath, keyId)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetVendingResponse(rsp)
-}
 
-// PostNewWithBodyWithResponse request with arbitrary body returning *PostNewResponse
-func (c *ClientWithResponses) PostNewWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostNewResponse, error) {
-	rsp, err := c.PostNewWithBody(ctx, contentType, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostNewResponse(rsp)
+
+	operationPath := fmt.Sprintf("/apikeys/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) PostNewWithResponse(ctx context.Context, body PostNewJSONRequestBody, reqEditors ...RequestEditorFn) (*PostNewResponse, error) {
-	rsp, err := c.PostNew(ctx, body, reqEditors...)
+// NewGetDexAuditRequest generates requests for GetDexAudit
+func NewGetDexAuditRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostNewResponse(rsp)
+
+	operationPath := fmt.Sprintf("/audit/dex")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// ParseAuthLoginResponse parses an HTTP response from a AuthLoginWithResponse call
-func ParseAuthLoginResponse(rsp *http.Response) (*AuthLoginResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewGetAuditTrailRequest generates requests for GetAuditTrail
+func NewGetAuditTrailRequest(server string, params *GetAuditTrailParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AuthLoginResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/audit/trail")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AuthTokenResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
 		}
-		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest MessageResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
 		}
-		response.JSON401 = &dest
 
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// ParsePostPurchaseResponse parses an HTTP response from a PostPurchaseWithResponse call
-func ParsePostPurchaseResponse(rsp *http.Response) (*PostPurchaseResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewAuthLoginRequest calls the generic AuthLogin builder with application/json body
+func NewAuthLoginRequest(server string, body AuthLoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthLoginRequestWithBody(server, "application/json", bodyReader)
+}
 
-	response := &PostPurchaseResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// NewAuthLoginRequestWithBody generates requests for AuthLogin with any type of body
+func NewAuthLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PurchaseSodaResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	operationPath := fmt.Sprintf("/auth/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 402:
-		var dest MessageResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON402 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// ParseRestockSodaResponse parses an HTTP response from a RestockSodaWithResponse call
-func ParseRestockSodaResponse(rsp *http.Response) (*RestockSodaResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewAuthLogoutRequest calls the generic AuthLogout builder with application/json body
+func NewAuthLogoutRequest(server string, body AuthLogoutJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthLogoutRequestWithBody(server, "application/json", bodyReader)
+}
 
-	response := &RestockSodaResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// NewAuthLogoutRequestWithBody generates requests for AuthLogout with any type of body
+func NewAuthLogoutRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest RestockResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	operationPath := fmt.Sprintf("/auth/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAuthRefreshRequest calls the generic AuthRefresh builder with application/json body
+func NewAuthRefreshRequest(server string, body AuthRefreshJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthRefreshRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthRefreshRequestWithBody generates requests for AuthRefresh with any type of body
+func NewAuthRefreshRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetCashBoxRequest generates requests for GetCashBox
+func NewGetCashBoxRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cashbox")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewEmptyCashBoxRequest calls the generic EmptyCashBox builder with application/json body
+func NewEmptyCashBoxRequest(server string, body EmptyCashBoxJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEmptyCashBoxRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewEmptyCashBoxRequestWithBody generates requests for EmptyCashBox with any type of body
+func NewEmptyCashBoxRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cashbox/empty")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewFillCashBoxRequest calls the generic FillCashBox builder with application/json body
+func NewFillCashBoxRequest(server string, body FillCashBoxJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewFillCashBoxRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewFillCashBoxRequestWithBody generates requests for FillCashBox with any type of body
+func NewFillCashBoxRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cashbox/fill")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewClearLoginLockoutRequest generates requests for ClearLoginLockout
+func NewClearLoginLockoutRequest(server string, params *ClearLoginLockoutParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lockouts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Username != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, *params.Username); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Ip != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ip", runtime.ParamLocationQuery, *params.Ip); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetLoginLockoutsRequest generates requests for GetLoginLockouts
+func NewGetLoginLockoutsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lockouts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDayClosesRequest generates requests for GetDayCloses
+func NewGetDayClosesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/periods")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCloseDayRequest calls the generic CloseDay builder with application/json body
+func NewCloseDayRequest(server string, body CloseDayJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCloseDayRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCloseDayRequestWithBody generates requests for CloseDay with any type of body
+func NewCloseDayRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/periods/close")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetDayCloseRequest generates requests for GetDayClose
+func NewGetDayCloseRequest(server string, periodId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "periodId", runtime.ParamLocationPath, periodId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/periods/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPlanogramRequest generates requests for GetPlanogram
+func NewGetPlanogramRequest(server string, params *GetPlanogramParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/planogram")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutPlanogramRequest calls the generic PutPlanogram builder with application/json body
+func NewPutPlanogramRequest(server string, params *PutPlanogramParams, body PutPlanogramJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutPlanogramRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPutPlanogramRequestWithBody generates requests for PutPlanogram with any type of body
+func NewPutPlanogramRequestWithBody(server string, params *PutPlanogramParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/planogram")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostPurchaseRequest calls the generic PostPurchase builder with application/json body
+func NewPostPurchaseRequest(server string, body PostPurchaseJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPurchaseRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPurchaseRequestWithBody generates requests for PostPurchase with any type of body
+func NewPostPurchaseRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSalesReportRequest generates requests for GetSalesReport
+func NewGetSalesReportRequest(server string, params *GetSalesReportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/reports/sales")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Bucket != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bucket", runtime.ParamLocationQuery, *params.Bucket); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SodaId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sodaId", runtime.ParamLocationQuery, *params.SodaId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRestockSodaRequest calls the generic RestockSoda builder with application/json body
+func NewRestockSodaRequest(server string, params *RestockSodaParams, body RestockSodaJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRestockSodaRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewRestockSodaRequestWithBody generates requests for RestockSoda with any type of body
+func NewRestockSodaRequestWithBody(server string, params *RestockSodaParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/restock")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetSodasRequest generates requests for GetSodas
+func NewGetSodasRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sodas")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTransactionsRequest generates requests for GetTransactions
+func NewGetTransactionsRequest(server string, params *GetTransactionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transactions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Operation != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "operation", runtime.ParamLocationQuery, *params.Operation); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SlotId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "slotId", runtime.ParamLocationQuery, *params.SlotId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SodaId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sodaId", runtime.ParamLocationQuery, *params.SodaId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Actor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor", runtime.ParamLocationQuery, *params.Actor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Until != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdatePriceRequest calls the generic UpdatePrice builder with application/json body
+func NewUpdatePriceRequest(server string, params *UpdatePriceParams, body UpdatePriceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePriceRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewUpdatePriceRequestWithBody generates requests for UpdatePrice with any type of body
+func NewUpdatePriceRequestWithBody(server string, params *UpdatePriceParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/updatePrice")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetUsersRequest generates requests for GetUsers
+func NewGetUsersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateUserRequest calls the generic CreateUser builder with application/json body
+func NewCreateUserRequest(server string, body CreateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateUserRequestWithBody generates requests for CreateUser with any type of body
+func NewCreateUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDisableUserRequest generates requests for DisableUser
+func NewDisableUserRequest(server string, username string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/disable", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewEnableUserRequest generates requests for EnableUser
+func NewEnableUserRequest(server string, username string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/enable", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewResetUserPasswordRequest calls the generic ResetUserPassword builder with application/json body
+func NewResetUserPasswordRequest(server string, username string, body ResetUserPasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewResetUserPasswordRequestWithBody(server, username, "application/json", bodyReader)
+}
+
+// NewResetUserPasswordRequestWithBody generates requests for ResetUserPassword with any type of body
+func NewResetUserPasswordRequestWithBody(server string, username string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/password", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetUserRoleRequest calls the generic SetUserRole builder with application/json body
+func NewSetUserRoleRequest(server string, username string, body SetUserRoleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetUserRoleRequestWithBody(server, username, "application/json", bodyReader)
+}
+
+// NewSetUserRoleRequestWithBody generates requests for SetUserRole with any type of body
+func NewSetUserRoleRequestWithBody(server string, username string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/role", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteVendingRequest calls the generic DeleteVending builder with application/json body
+func NewDeleteVendingRequest(server string, params *DeleteVendingParams, body DeleteVendingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeleteVendingRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewDeleteVendingRequestWithBody generates requests for DeleteVending with any type of body
+func NewDeleteVendingRequestWithBody(server string, params *DeleteVendingParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/vending")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetVendingRequest calls the generic GetVending builder with application/json body
+func NewGetVendingRequest(server string, params *GetVendingParams, body GetVendingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewGetVendingRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewGetVendingRequestWithBody generates requests for GetVending with any type of body
+func NewGetVendingRequestWithBody(server string, params *GetVendingParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/vending")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Name != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinPrice != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "minPrice", runtime.ParamLocationQuery, *params.MinPrice); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxPrice != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxPrice", runtime.ParamLocationQuery, *params.MaxPrice); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Currency != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "currency", runtime.ParamLocationQuery, *params.Currency); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinCalories != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "minCalories", runtime.ParamLocationQuery, *params.MinCalories); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxCalories != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxCalories", runtime.ParamLocationQuery, *params.MaxCalories); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinOunces != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "minOunces", runtime.ParamLocationQuery, *params.MinOunces); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxOunces != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxOunces", runtime.ParamLocationQuery, *params.MaxOunces); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Stock != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "stock", runtime.ParamLocationQuery, *params.Stock); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostNewRequest calls the generic PostNew builder with application/json body
+func NewPostNewRequest(server string, body PostNewJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostNewRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostNewRequestWithBody generates requests for PostNew with any type of body
+func NewPostNewRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/vending")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetJwksWithResponse request
+	GetJwksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJwksResponse, error)
+
+	// GetApiKeysWithResponse request
+	GetApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiKeysResponse, error)
+
+	// CreateApiKeyWithBodyWithResponse request with any body
+	CreateApiKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateApiKeyResponse, error)
+
+	CreateApiKeyWithResponse(ctx context.Context, body CreateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateApiKeyResponse, error)
+
+	// RevokeApiKeyWithResponse request
+	RevokeApiKeyWithResponse(ctx context.Context, keyId string, reqEditors ...RequestEditorFn) (*RevokeApiKeyResponse, error)
+
+	// GetDexAuditWithResponse request
+	GetDexAuditWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDexAuditResponse, error)
+
+	// GetAuditTrailWithResponse request
+	GetAuditTrailWithResponse(ctx context.Context, params *GetAuditTrailParams, reqEditors ...RequestEditorFn) (*GetAuditTrailResponse, error)
+
+	// AuthLoginWithBodyWithResponse request with any body
+	AuthLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthLoginResponse, error)
+
+	AuthLoginWithResponse(ctx context.Context, body AuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthLoginResponse, error)
+
+	// AuthLogoutWithBodyWithResponse request with any body
+	AuthLogoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthLogoutResponse, error)
+
+	AuthLogoutWithResponse(ctx context.Context, body AuthLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthLogoutResponse, error)
+
+	// AuthRefreshWithBodyWithResponse request with any body
+	AuthRefreshWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthRefreshResponse, error)
+
+	AuthRefreshWithResponse(ctx context.Context, body AuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthRefreshResponse, error)
+
+	// GetCashBoxWithResponse request
+	GetCashBoxWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCashBoxResponse, error)
+
+	// EmptyCashBoxWithBodyWithResponse request with any body
+	EmptyCashBoxWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EmptyCashBoxResponse, error)
+
+	EmptyCashBoxWithResponse(ctx context.Context, body EmptyCashBoxJSONRequestBody, reqEditors ...RequestEditorFn) (*EmptyCashBoxResponse, error)
+
+	// FillCashBoxWithBodyWithResponse request with any body
+	FillCashBoxWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*FillCashBoxResponse, error)
+
+	FillCashBoxWithResponse(ctx context.Context, body FillCashBoxJSONRequestBody, reqEditors ...RequestEditorFn) (*FillCashBoxResponse, error)
+
+	// ClearLoginLockoutWithResponse request
+	ClearLoginLockoutWithResponse(ctx context.Context, params *ClearLoginLockoutParams, reqEditors ...RequestEditorFn) (*ClearLoginLockoutResponse, error)
+
+	// GetLoginLockoutsWithResponse request
+	GetLoginLockoutsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLoginLockoutsResponse, error)
+
+	// GetDayClosesWithResponse request
+	GetDayClosesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDayClosesResponse, error)
+
+	// CloseDayWithBodyWithResponse request with any body
+	CloseDayWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CloseDayResponse, error)
+
+	CloseDayWithResponse(ctx context.Context, body CloseDayJSONRequestBody, reqEditors ...RequestEditorFn) (*CloseDayResponse, error)
+
+	// GetDayCloseWithResponse request
+	GetDayCloseWithResponse(ctx context.Context, periodId int64, reqEditors ...RequestEditorFn) (*GetDayCloseResponse, error)
+
+	// GetPlanogramWithResponse request
+	GetPlanogramWithResponse(ctx context.Context, params *GetPlanogramParams, reqEditors ...RequestEditorFn) (*GetPlanogramResponse, error)
+
+	// PutPlanogramWithBodyWithResponse request with any body
+	PutPlanogramWithBodyWithResponse(ctx context.Context, params *PutPlanogramParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutPlanogramResponse, error)
+
+	PutPlanogramWithResponse(ctx context.Context, params *PutPlanogramParams, body PutPlanogramJSONRequestBody, reqEditors ...RequestEditorFn) (*PutPlanogramResponse, error)
+
+	// PostPurchaseWithBodyWithResponse request with any body
+	PostPurchaseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPurchaseResponse, error)
+
+	PostPurchaseWithResponse(ctx context.Context, body PostPurchaseJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPurchaseResponse, error)
+
+	// GetSalesReportWithResponse request
+	GetSalesReportWithResponse(ctx context.Context, params *GetSalesReportParams, reqEditors ...RequestEditorFn) (*GetSalesReportResponse, error)
+
+	// RestockSodaWithBodyWithResponse request with any body
+	RestockSodaWithBodyWithResponse(ctx context.Context, params *RestockSodaParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RestockSodaResponse, error)
+
+	RestockSodaWithResponse(ctx context.Context, params *RestockSodaParams, body RestockSodaJSONRequestBody, reqEditors ...RequestEditorFn) (*RestockSodaResponse, error)
+
+	// GetSodasWithResponse request
+	GetSodasWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSodasResponse, error)
+
+	// GetTransactionsWithResponse request
+	GetTransactionsWithResponse(ctx context.Context, params *GetTransactionsParams, reqEditors ...RequestEditorFn) (*GetTransactionsResponse, error)
+
+	// UpdatePriceWithBodyWithResponse request with any body
+	UpdatePriceWithBodyWithResponse(ctx context.Context, params *UpdatePriceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePriceResponse, error)
+
+	UpdatePriceWithResponse(ctx context.Context, params *UpdatePriceParams, body UpdatePriceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePriceResponse, error)
+
+	// GetUsersWithResponse request
+	GetUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersResponse, error)
+
+	// CreateUserWithBodyWithResponse request with any body
+	CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+
+	CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+
+	// DisableUserWithResponse request
+	DisableUserWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*DisableUserResponse, error)
+
+	// EnableUserWithResponse request
+	EnableUserWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*EnableUserResponse, error)
+
+	// ResetUserPasswordWithBodyWithResponse request with any body
+	ResetUserPasswordWithBodyWithResponse(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResetUserPasswordResponse, error)
+
+	ResetUserPasswordWithResponse(ctx context.Context, username string, body ResetUserPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ResetUserPasswordResponse, error)
+
+	// SetUserRoleWithBodyWithResponse request with any body
+	SetUserRoleWithBodyWithResponse(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetUserRoleResponse, error)
+
+	SetUserRoleWithResponse(ctx context.Context, username string, body SetUserRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*SetUserRoleResponse, error)
+
+	// DeleteVendingWithBodyWithResponse request with any body
+	DeleteVendingWithBodyWithResponse(ctx context.Context, params *DeleteVendingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteVendingResponse, error)
+
+	DeleteVendingWithResponse(ctx context.Context, params *DeleteVendingParams, body DeleteVendingJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteVendingResponse, error)
+
+	// GetVendingWithBodyWithResponse request with any body
+	GetVendingWithBodyWithResponse(ctx context.Context, params *GetVendingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetVendingResponse, error)
+
+	GetVendingWithResponse(ctx context.Context, params *GetVendingParams, body GetVendingJSONRequestBody, reqEditors ...RequestEditorFn) (*GetVendingResponse, error)
+
+	// PostNewWithBodyWithResponse request with any body
+	PostNewWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostNewResponse, error)
+
+	PostNewWithResponse(ctx context.Context, body PostNewJSONRequestBody, reqEditors ...RequestEditorFn) (*PostNewResponse, error)
+}
+
+type GetJwksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JWKSResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJwksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJwksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiKeysResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *APIKeysResponse
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateApiKeyResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *CreatedAPIKeyResponse
+	ApplicationproblemJSON400 *ErrorResp
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateApiKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateApiKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeApiKeyResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *APIKeyResponse
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON404 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeApiKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeApiKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDexAuditResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDexAuditResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDexAuditResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAuditTrailResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AuditTrailResponse
+	ApplicationproblemJSON400 *ErrorResp
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuditTrailResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuditTrailResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthLoginResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AuthTokenResponse
+	ApplicationproblemJSON400 *ErrorResp
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON429 *TooManyAttemptsResp
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthLoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthLoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthLogoutResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *MessageResponse
+	ApplicationproblemJSON400 *ErrorResp
+	ApplicationproblemJSON401 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthLogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthLogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthRefreshResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AuthTokenResponse
+	ApplicationproblemJSON400 *ErrorResp
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthRefreshResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthRefreshResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCashBoxResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *CashBoxResponse
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCashBoxResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCashBoxResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EmptyCashBoxResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *CashBoxResponse
+	ApplicationproblemJSON400 *ErrorResp
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON409 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r EmptyCashBoxResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EmptyCashBoxResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FillCashBoxResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *CashBoxResponse
+	ApplicationproblemJSON400 *ErrorResp
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON409 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r FillCashBoxResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FillCashBoxResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ClearLoginLockoutResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *MessageResponse
+	ApplicationproblemJSON400 *ErrorResp
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON404 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r ClearLoginLockoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ClearLoginLockoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLoginLockoutsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *LoginLockoutsResponse
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLoginLockoutsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLoginLockoutsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDayClosesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *DayClosesResponse
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDayClosesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDayClosesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CloseDayResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *DayCloseResponse
+	ApplicationproblemJSON400 *ErrorResp
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON409 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r CloseDayResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CloseDayResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDayCloseResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *DayCloseResponse
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON404 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDayCloseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDayCloseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPlanogramResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *PlanogramResponse
+	YAML200                   *PlanogramResponse
+	ApplicationproblemJSON400 *ErrorResp
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPlanogramResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPlanogramResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutPlanogramResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *PlanogramResponse
+	YAML200                   *PlanogramResponse
+	ApplicationproblemJSON400 *ErrorResp
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON409 *ErrorResp
+	ApplicationproblemJSON412 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r PutPlanogramResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutPlanogramResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPurchaseResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *PurchaseSodaResponse
+	ApplicationproblemJSON400 *ErrorResp
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON402 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON404 *ErrorResp
+	ApplicationproblemJSON409 *ErrorResp
+	ApplicationproblemJSON422 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPurchaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPurchaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSalesReportResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SalesReportResponse
+	ApplicationproblemJSON400 *ErrorResp
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSalesReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSalesReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestockSodaResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *RestockResponse
+	ApplicationproblemJSON400 *ErrorResp
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON404 *ErrorResp
+	ApplicationproblemJSON409 *ErrorResp
+	ApplicationproblemJSON412 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r RestockSodaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestockSodaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSodasResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SodaCatalogResponse
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSodasResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSodasResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTransactionsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TransactionsResponse
+	ApplicationproblemJSON400 *ErrorResp
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTransactionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTransactionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdatePriceResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *UpdatePriceResp
+	ApplicationproblemJSON400 *ErrorResp
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON404 *ErrorResp
+	ApplicationproblemJSON412 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdatePriceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdatePriceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *UsersResponse
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateUserResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *UserResponse
+	ApplicationproblemJSON400 *ErrorResp
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON409 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DisableUserResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *UserResponse
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON404 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r DisableUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DisableUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EnableUserResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *UserResponse
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON404 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r EnableUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EnableUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResetUserPasswordResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *UserResponse
+	ApplicationproblemJSON400 *ErrorResp
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON404 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r ResetUserPasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResetUserPasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetUserRoleResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *UserResponse
+	ApplicationproblemJSON400 *ErrorResp
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON404 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r SetUserRoleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetUserRoleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteVendingResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *MessageResponse
+	ApplicationproblemJSON400 *ErrorResp
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON404 *ErrorResp
+	ApplicationproblemJSON412 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteVendingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteVendingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetVendingResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *VendingMachineResponse
+	ApplicationproblemJSON400 *ErrorResp
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON404 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r GetVendingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetVendingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostNewResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *MessageResponse
+	ApplicationproblemJSON400 *ErrorResp
+	ApplicationproblemJSON401 *ErrorResp
+	ApplicationproblemJSON403 *ErrorResp
+	ApplicationproblemJSON409 *ErrorResp
+	ApplicationproblemJSON503 *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r PostNewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostNewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetJwksWithResponse request returning *GetJwksResponse
+func (c *ClientWithResponses) GetJwksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJwksResponse, error) {
+	rsp, err := c.GetJwks(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJwksResponse(rsp)
+}
+
+// GetApiKeysWithResponse request returning *GetApiKeysResponse
+func (c *ClientWithResponses) GetApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiKeysResponse, error) {
+	rsp, err := c.GetApiKeys(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiKeysResponse(rsp)
+}
+
+// CreateApiKeyWithBodyWithResponse request with arbitrary body returning *CreateApiKeyResponse
+func (c *ClientWithResponses) CreateApiKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateApiKeyResponse, error) {
+	rsp, err := c.CreateApiKeyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateApiKeyResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateApiKeyWithResponse(ctx context.Context, body CreateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateApiKeyResponse, error) {
+	rsp, err := c.CreateApiKey(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateApiKeyResponse(rsp)
+}
+
+// RevokeApiKeyWithResponse request returning *RevokeApiKeyResponse
+func (c *ClientWithResponses) RevokeApiKeyWithResponse(ctx context.Context, keyId string, reqEditors ...RequestEditorFn) (*RevokeApiKeyResponse, error) {
+	rsp, err := c.RevokeApiKey(ctx, keyId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeApiKeyResponse(rsp)
+}
+
+// GetDexAuditWithResponse request returning *GetDexAuditResponse
+func (c *ClientWithResponses) GetDexAuditWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDexAuditResponse, error) {
+	rsp, err := c.GetDexAudit(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDexAuditResponse(rsp)
+}
+
+// GetAuditTrailWithResponse request returning *GetAuditTrailResponse
+func (c *ClientWithResponses) GetAuditTrailWithResponse(ctx context.Context, params *GetAuditTrailParams, reqEditors ...RequestEditorFn) (*GetAuditTrailResponse, error) {
+	rsp, err := c.GetAuditTrail(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuditTrailResponse(rsp)
+}
+
+// AuthLoginWithBodyWithResponse request with arbitrary body returning *AuthLoginResponse
+func (c *ClientWithResponses) AuthLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthLoginResponse, error) {
+	rsp, err := c.AuthLoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthLoginWithResponse(ctx context.Context, body AuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthLoginResponse, error) {
+	rsp, err := c.AuthLogin(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthLoginResponse(rsp)
+}
+
+// AuthLogoutWithBodyWithResponse request with arbitrary body returning *AuthLogoutResponse
+func (c *ClientWithResponses) AuthLogoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthLogoutResponse, error) {
+	rsp, err := c.AuthLogoutWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthLogoutResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthLogoutWithResponse(ctx context.Context, body AuthLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthLogoutResponse, error) {
+	rsp, err := c.AuthLogout(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthLogoutResponse(rsp)
+}
+
+// AuthRefreshWithBodyWithResponse request with arbitrary body returning *AuthRefreshResponse
+func (c *ClientWithResponses) AuthRefreshWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthRefreshResponse, error) {
+	rsp, err := c.AuthRefreshWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthRefreshResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthRefreshWithResponse(ctx context.Context, body AuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthRefreshResponse, error) {
+	rsp, err := c.AuthRefresh(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthRefreshResponse(rsp)
+}
+
+// GetCashBoxWithResponse request returning *GetCashBoxResponse
+func (c *ClientWithResponses) GetCashBoxWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCashBoxResponse, error) {
+	rsp, err := c.GetCashBox(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCashBoxResponse(rsp)
+}
+
+// EmptyCashBoxWithBodyWithResponse request with arbitrary body returning *EmptyCashBoxResponse
+func (c *ClientWithResponses) EmptyCashBoxWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EmptyCashBoxResponse, error) {
+	rsp, err := c.EmptyCashBoxWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEmptyCashBoxResponse(rsp)
+}
+
+func (c *ClientWithResponses) EmptyCashBoxWithResponse(ctx context.Context, body EmptyCashBoxJSONRequestBody, reqEditors ...RequestEditorFn) (*EmptyCashBoxResponse, error) {
+	rsp, err := c.EmptyCashBox(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEmptyCashBoxResponse(rsp)
+}
+
+// FillCashBoxWithBodyWithResponse request with arbitrary body returning *FillCashBoxResponse
+func (c *ClientWithResponses) FillCashBoxWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*FillCashBoxResponse, error) {
+	rsp, err := c.FillCashBoxWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFillCashBoxResponse(rsp)
+}
+
+func (c *ClientWithResponses) FillCashBoxWithResponse(ctx context.Context, body FillCashBoxJSONRequestBody, reqEditors ...RequestEditorFn) (*FillCashBoxResponse, error) {
+	rsp, err := c.FillCashBox(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFillCashBoxResponse(rsp)
+}
+
+// ClearLoginLockoutWithResponse request returning *ClearLoginLockoutResponse
+func (c *ClientWithResponses) ClearLoginLockoutWithResponse(ctx context.Context, params *ClearLoginLockoutParams, reqEditors ...RequestEditorFn) (*ClearLoginLockoutResponse, error) {
+	rsp, err := c.ClearLoginLockout(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseClearLoginLockoutResponse(rsp)
+}
+
+// GetLoginLockoutsWithResponse request returning *GetLoginLockoutsResponse
+func (c *ClientWithResponses) GetLoginLockoutsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLoginLockoutsResponse, error) {
+	rsp, err := c.GetLoginLockouts(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLoginLockoutsResponse(rsp)
+}
+
+// GetDayClosesWithResponse request returning *GetDayClosesResponse
+func (c *ClientWithResponses) GetDayClosesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDayClosesResponse, error) {
+	rsp, err := c.GetDayCloses(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDayClosesResponse(rsp)
+}
+
+// CloseDayWithBodyWithResponse request with arbitrary body returning *CloseDayResponse
+func (c *ClientWithResponses) CloseDayWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CloseDayResponse, error) {
+	rsp, err := c.CloseDayWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloseDayResponse(rsp)
+}
+
+func (c *ClientWithResponses) CloseDayWithResponse(ctx context.Context, body CloseDayJSONRequestBody, reqEditors ...RequestEditorFn) (*CloseDayResponse, error) {
+	rsp, err := c.CloseDay(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloseDayResponse(rsp)
+}
+
+// GetDayCloseWithResponse request returning *GetDayCloseResponse
+func (c *ClientWithResponses) GetDayCloseWithResponse(ctx context.Context, periodId int64, reqEditors ...RequestEditorFn) (*GetDayCloseResponse, error) {
+	rsp, err := c.GetDayClose(ctx, periodId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDayCloseResponse(rsp)
+}
+
+// GetPlanogramWithResponse request returning *GetPlanogramResponse
+func (c *ClientWithResponses) GetPlanogramWithResponse(ctx context.Context, params *GetPlanogramParams, reqEditors ...RequestEditorFn) (*GetPlanogramResponse, error) {
+	rsp, err := c.GetPlanogram(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPlanogramResponse(rsp)
+}
+
+// PutPlanogramWithBodyWithResponse request with arbitrary body returning *PutPlanogramResponse
+func (c *ClientWithResponses) PutPlanogramWithBodyWithResponse(ctx context.Context, params *PutPlanogramParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutPlanogramResponse, error) {
+	rsp, err := c.PutPlanogramWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutPlanogramResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutPlanogramWithResponse(ctx context.Context, params *PutPlanogramParams, body PutPlanogramJSONRequestBody, reqEditors ...RequestEditorFn) (*PutPlanogramResponse, error) {
+	rsp, err := c.PutPlanogram(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutPlanogramResponse(rsp)
+}
+
+// PostPurchaseWithBodyWithResponse request with arbitrary body returning *PostPurchaseResponse
+func (c *ClientWithResponses) PostPurchaseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPurchaseResponse, error) {
+	rsp, err := c.PostPurchaseWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPurchaseResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPurchaseWithResponse(ctx context.Context, body PostPurchaseJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPurchaseResponse, error) {
+	rsp, err := c.PostPurchase(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPurchaseResponse(rsp)
+}
+
+// GetSalesReportWithResponse request returning *GetSalesReportResponse
+func (c *ClientWithResponses) GetSalesReportWithResponse(ctx context.Context, params *GetSalesReportParams, reqEditors ...RequestEditorFn) (*GetSalesReportResponse, error) {
+	rsp, err := c.GetSalesReport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSalesReportResponse(rsp)
+}
+
+// RestockSodaWithBodyWithResponse request with arbitrary body returning *RestockSodaResponse
+func (c *ClientWithResponses) RestockSodaWithBodyWithResponse(ctx context.Context, params *RestockSodaParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RestockSodaResponse, error) {
+	rsp, err := c.RestockSodaWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestockSodaResponse(rsp)
+}
+
+func (c *ClientWithResponses) RestockSodaWithResponse(ctx context.Context, params *RestockSodaParams, body RestockSodaJSONRequestBody, reqEditors ...RequestEditorFn) (*RestockSodaResponse, error) {
+	rsp, err := c.RestockSoda(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestockSodaResponse(rsp)
+}
+
+// GetSodasWithResponse request returning *GetSodasResponse
+func (c *ClientWithResponses) GetSodasWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSodasResponse, error) {
+	rsp, err := c.GetSodas(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSodasResponse(rsp)
+}
+
+// GetTransactionsWithResponse request returning *GetTransactionsResponse
+func (c *ClientWithResponses) GetTransactionsWithResponse(ctx context.Context, params *GetTransactionsParams, reqEditors ...RequestEditorFn) (*GetTransactionsResponse, error) {
+	rsp, err := c.GetTransactions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTransactionsResponse(rsp)
+}
+
+// UpdatePriceWithBodyWithResponse request with arbitrary body returning *UpdatePriceResponse
+func (c *ClientWithResponses) UpdatePriceWithBodyWithResponse(ctx context.Context, params *UpdatePriceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePriceResponse, error) {
+	rsp, err := c.UpdatePriceWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePriceResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdatePriceWithResponse(ctx context.Context, params *UpdatePriceParams, body UpdatePriceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePriceResponse, error) {
+	rsp, err := c.UpdatePrice(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePriceResponse(rsp)
+}
+
+// GetUsersWithResponse request returning *GetUsersResponse
+func (c *ClientWithResponses) GetUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersResponse, error) {
+	rsp, err := c.GetUsers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersResponse(rsp)
+}
+
+// CreateUserWithBodyWithResponse request with arbitrary body returning *CreateUserResponse
+func (c *ClientWithResponses) CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
+	rsp, err := c.CreateUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
+	rsp, err := c.CreateUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserResponse(rsp)
+}
+
+// DisableUserWithResponse request returning *DisableUserResponse
+func (c *ClientWithResponses) DisableUserWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*DisableUserResponse, error) {
+	rsp, err := c.DisableUser(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisableUserResponse(rsp)
+}
+
+// EnableUserWithResponse request returning *EnableUserResponse
+func (c *ClientWithResponses) EnableUserWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*EnableUserResponse, error) {
+	rsp, err := c.EnableUser(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnableUserResponse(rsp)
+}
+
+// ResetUserPasswordWithBodyWithResponse request with arbitrary body returning *ResetUserPasswordResponse
+func (c *ClientWithResponses) ResetUserPasswordWithBodyWithResponse(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResetUserPasswordResponse, error) {
+	rsp, err := c.ResetUserPasswordWithBody(ctx, username, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetUserPasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) ResetUserPasswordWithResponse(ctx context.Context, username string, body ResetUserPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ResetUserPasswordResponse, error) {
+	rsp, err := c.ResetUserPassword(ctx, username, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetUserPasswordResponse(rsp)
+}
+
+// SetUserRoleWithBodyWithResponse request with arbitrary body returning *SetUserRoleResponse
+func (c *ClientWithResponses) SetUserRoleWithBodyWithResponse(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetUserRoleResponse, error) {
+	rsp, err := c.SetUserRoleWithBody(ctx, username, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetUserRoleResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetUserRoleWithResponse(ctx context.Context, username string, body SetUserRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*SetUserRoleResponse, error) {
+	rsp, err := c.SetUserRole(ctx, username, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetUserRoleResponse(rsp)
+}
+
+// DeleteVendingWithBodyWithResponse request with arbitrary body returning *DeleteVendingResponse
+func (c *ClientWithResponses) DeleteVendingWithBodyWithResponse(ctx context.Context, params *DeleteVendingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteVendingResponse, error) {
+	rsp, err := c.DeleteVendingWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteVendingResponse(rsp)
+}
+
+func (c *ClientWithResponses) DeleteVendingWithResponse(ctx context.Context, params *DeleteVendingParams, body DeleteVendingJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteVendingResponse, error) {
+	rsp, err := c.DeleteVending(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteVendingResponse(rsp)
+}
+
+// GetVendingWithBodyWithResponse request with arbitrary body returning *GetVendingResponse
+func (c *ClientWithResponses) GetVendingWithBodyWithResponse(ctx context.Context, params *GetVendingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetVendingResponse, error) {
+	rsp, err := c.GetVendingWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVendingResponse(rsp)
+}
+
+func (c *ClientWithResponses) GetVendingWithResponse(ctx context.Context, params *GetVendingParams, body GetVendingJSONRequestBody, reqEditors ...RequestEditorFn) (*GetVendingResponse, error) {
+	rsp, err := c.GetVending(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVendingResponse(rsp)
+}
+
+// PostNewWithBodyWithResponse request with arbitrary body returning *PostNewResponse
+func (c *ClientWithResponses) PostNewWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostNewResponse, error) {
+	rsp, err := c.PostNewWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostNewResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostNewWithResponse(ctx context.Context, body PostNewJSONRequestBody, reqEditors ...RequestEditorFn) (*PostNewResponse, error) {
+	rsp, err := c.PostNew(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostNewResponse(rsp)
+}
+
+// ParseGetJwksResponse parses an HTTP response from a GetJwksWithResponse call
+func ParseGetJwksResponse(rsp *http.Response) (*GetJwksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetJwksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JWKSResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiKeysResponse parses an HTTP response from a GetApiKeysWithResponse call
+func ParseGetApiKeysResponse(rsp *http.Response) (*GetApiKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest APIKeysResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateApiKeyResponse parses an HTTP response from a CreateApiKeyWithResponse call
+func ParseCreateApiKeyResponse(rsp *http.Response) (*CreateApiKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateApiKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreatedAPIKeyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokeApiKeyResponse parses an HTTP response from a RevokeApiKeyWithResponse call
+func ParseRevokeApiKeyResponse(rsp *http.Response) (*RevokeApiKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeApiKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest APIKeyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDexAuditResponse parses an HTTP response from a GetDexAuditWithResponse call
+func ParseGetDexAuditResponse(rsp *http.Response) (*GetDexAuditResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDexAuditResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAuditTrailResponse parses an HTTP response from a GetAuditTrailWithResponse call
+func ParseGetAuditTrailResponse(rsp *http.Response) (*GetAuditTrailResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuditTrailResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuditTrailResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthLoginResponse parses an HTTP response from a AuthLoginWithResponse call
+func ParseAuthLoginResponse(rsp *http.Response) (*AuthLoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthLoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthTokenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyAttemptsResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthLogoutResponse parses an HTTP response from a AuthLogoutWithResponse call
+func ParseAuthLogoutResponse(rsp *http.Response) (*AuthLogoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthLogoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MessageResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthRefreshResponse parses an HTTP response from a AuthRefreshWithResponse call
+func ParseAuthRefreshResponse(rsp *http.Response) (*AuthRefreshResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthRefreshResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthTokenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCashBoxResponse parses an HTTP response from a GetCashBoxWithResponse call
+func ParseGetCashBoxResponse(rsp *http.Response) (*GetCashBoxResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCashBoxResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CashBoxResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEmptyCashBoxResponse parses an HTTP response from a EmptyCashBoxWithResponse call
+func ParseEmptyCashBoxResponse(rsp *http.Response) (*EmptyCashBoxResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EmptyCashBoxResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CashBoxResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFillCashBoxResponse parses an HTTP response from a FillCashBoxWithResponse call
+func ParseFillCashBoxResponse(rsp *http.Response) (*FillCashBoxResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FillCashBoxResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CashBoxResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseClearLoginLockoutResponse parses an HTTP response from a ClearLoginLockoutWithResponse call
+func ParseClearLoginLockoutResponse(rsp *http.Response) (*ClearLoginLockoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ClearLoginLockoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MessageResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLoginLockoutsResponse parses an HTTP response from a GetLoginLockoutsWithResponse call
+func ParseGetLoginLockoutsResponse(rsp *http.Response) (*GetLoginLockoutsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLoginLockoutsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginLockoutsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDayClosesResponse parses an HTTP response from a GetDayClosesWithResponse call
+func ParseGetDayClosesResponse(rsp *http.Response) (*GetDayClosesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDayClosesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DayClosesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCloseDayResponse parses an HTTP response from a CloseDayWithResponse call
+func ParseCloseDayResponse(rsp *http.Response) (*CloseDayResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CloseDayResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DayCloseResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDayCloseResponse parses an HTTP response from a GetDayCloseWithResponse call
+func ParseGetDayCloseResponse(rsp *http.Response) (*GetDayCloseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDayCloseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DayCloseResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPlanogramResponse parses an HTTP response from a GetPlanogramWithResponse call
+func ParseGetPlanogramResponse(rsp *http.Response) (*GetPlanogramResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPlanogramResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PlanogramResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
+		var dest PlanogramResponse
+		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.YAML200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutPlanogramResponse parses an HTTP response from a PutPlanogramWithResponse call
+func ParsePutPlanogramResponse(rsp *http.Response) (*PutPlanogramResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutPlanogramResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PlanogramResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
+		var dest PlanogramResponse
+		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.YAML200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPurchaseResponse parses an HTTP response from a PostPurchaseWithResponse call
+func ParsePostPurchaseResponse(rsp *http.Response) (*PostPurchaseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPurchaseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PurchaseSodaResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 402:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON402 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSalesReportResponse parses an HTTP response from a GetSalesReportWithResponse call
+func ParseGetSalesReportResponse(rsp *http.Response) (*GetSalesReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSalesReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SalesReportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseRestockSodaResponse parses an HTTP response from a RestockSodaWithResponse call
+func ParseRestockSodaResponse(rsp *http.Response) (*RestockSodaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestockSodaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RestockResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSodasResponse parses an HTTP response from a GetSodasWithResponse call
+func ParseGetSodasResponse(rsp *http.Response) (*GetSodasResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSodasResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SodaCatalogResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTransactionsResponse parses an HTTP response from a GetTransactionsWithResponse call
+func ParseGetTransactionsResponse(rsp *http.Response) (*GetTransactionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTransactionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransactionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdatePriceResponse parses an HTTP response from a UpdatePriceWithResponse call
+func ParseUpdatePriceResponse(rsp *http.Response) (*UpdatePriceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdatePriceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UpdatePriceResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersResponse parses an HTTP response from a GetUsersWithResponse call
+func ParseGetUsersResponse(rsp *http.Response) (*GetUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UsersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateUserResponse parses an HTTP response from a CreateUserWithResponse call
+func ParseCreateUserResponse(rsp *http.Response) (*CreateUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest UserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDisableUserResponse parses an HTTP response from a DisableUserWithResponse call
+func ParseDisableUserResponse(rsp *http.Response) (*DisableUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DisableUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEnableUserResponse parses an HTTP response from a EnableUserWithResponse call
+func ParseEnableUserResponse(rsp *http.Response) (*EnableUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EnableUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResetUserPasswordResponse parses an HTTP response from a ResetUserPasswordWithResponse call
+func ParseResetUserPasswordResponse(rsp *http.Response) (*ResetUserPasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResetUserPasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetUserRoleResponse parses an HTTP response from a SetUserRoleWithResponse call
+func ParseSetUserRoleResponse(rsp *http.Response) (*SetUserRoleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetUserRoleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteVendingResponse parses an HTTP response from a DeleteVendingWithResponse call
+func ParseDeleteVendingResponse(rsp *http.Response) (*DeleteVendingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteVendingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MessageResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetVendingResponse parses an HTTP response from a GetVendingWithResponse call
+func ParseGetVendingResponse(rsp *http.Response) (*GetVendingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetVendingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VendingMachineResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostNewResponse parses an HTTP response from a PostNewWithResponse call
+func ParsePostNewResponse(rsp *http.Response) (*PostNewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostNewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest MessageResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Public keys tokens are signed with
+	// (GET /.well-known/jwks.json)
+	GetJwks(ctx echo.Context) error
+	// List API keys
+	// (GET /apikeys)
+	GetApiKeys(ctx echo.Context) error
+	// Create an API key
+	// (POST /apikeys)
+	CreateApiKey(ctx echo.Context) error
+	// Revoke an API key
+	// (DELETE /apikeys/{keyId})
+	RevokeApiKey(ctx echo.Context, keyId string) error
+	// Export a DEX audit file
+	// (GET /audit/dex)
+	GetDexAudit(ctx echo.Context) error
+	// List the audit trail
+	// (GET /audit/trail)
+	GetAuditTrail(ctx echo.Context, params GetAuditTrailParams) error
+	// Authenticate user and issue JWT
+	// (POST /auth/login)
+	AuthLogin(ctx echo.Context) error
+	// Revoke the tokens of this session
+	// (POST /auth/logout)
+	AuthLogout(ctx echo.Context) error
+	// Exchange a refresh token for new tokens
+	// (POST /auth/refresh)
+	AuthRefresh(ctx echo.Context) error
+	// View the cash box
+	// (GET /cashbox)
+	GetCashBox(ctx echo.Context) error
+	// Empty the cash box
+	// (POST /cashbox/empty)
+	EmptyCashBox(ctx echo.Context) error
+	// Fill the cash box
+	// (POST /cashbox/fill)
+	FillCashBox(ctx echo.Context) error
+	// Clear a login lockout
+	// (DELETE /lockouts)
+	ClearLoginLockout(ctx echo.Context, params ClearLoginLockoutParams) error
+	// List login lockouts
+	// (GET /lockouts)
+	GetLoginLockouts(ctx echo.Context) error
+	// List the closed periods
+	// (GET /periods)
+	GetDayCloses(ctx echo.Context) error
+	// Close the current period
+	// (POST /periods/close)
+	CloseDay(ctx echo.Context) error
+	// Get a closed period
+	// (GET /periods/{periodId})
+	GetDayClose(ctx echo.Context, periodId int64) error
+	// Export the planogram
+	// (GET /planogram)
+	GetPlanogram(ctx echo.Context, params GetPlanogramParams) error
+	// Import a planogram
+	// (PUT /planogram)
+	PutPlanogram(ctx echo.Context, params PutPlanogramParams) error
+	// Purchase Soda from vending machine
+	// (POST /purchase)
+	PostPurchase(ctx echo.Context) error
+	// Report sales by soda and period
+	// (GET /reports/sales)
+	GetSalesReport(ctx echo.Context, params GetSalesReportParams) error
+	// Restock a soda
+	// (POST /restock)
+	RestockSoda(ctx echo.Context, params RestockSodaParams) error
+	// Get the soda catalog
+	// (GET /sodas)
+	GetSodas(ctx echo.Context) error
+	// List the transaction ledger
+	// (GET /transactions)
+	GetTransactions(ctx echo.Context, params GetTransactionsParams) error
+	// Update the price of a soda
+	// (PUT /updatePrice)
+	UpdatePrice(ctx echo.Context, params UpdatePriceParams) error
+	// List users
+	// (GET /users)
+	GetUsers(ctx echo.Context) error
+	// Create a user
+	// (POST /users)
+	CreateUser(ctx echo.Context) error
+	// Disable a user
+	// (POST /users/{username}/disable)
+	DisableUser(ctx echo.Context, username string) error
+	// Enable a user
+	// (POST /users/{username}/enable)
+	EnableUser(ctx echo.Context, username string) error
+	// Reset the password of a user
+	// (PUT /users/{username}/password)
+	ResetUserPassword(ctx echo.Context, username string) error
+	// Change the role of a user
+	// (PUT /users/{username}/role)
+	SetUserRole(ctx echo.Context, username string) error
+	// Delete Slot And Return Sodas
+	// (DELETE /vending)
+	DeleteVending(ctx echo.Context, params DeleteVendingParams) error
+	// Get vending machine slots
+	// (GET /vending)
+	GetVending(ctx echo.Context, params GetVendingParams) error
+	// Add New Soda and Vending Slot
+	// (POST /vending)
+	PostNew(ctx echo.Context) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetJwks converts echo context to params.
+func (w *ServerInterfaceWrapper) GetJwks(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetJwks(ctx)
+	return err
+}
+
+// GetApiKeys converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApiKeys(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"apikeys:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetApiKeys(ctx)
+	return err
+}
+
+// CreateApiKey converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateApiKey(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"apikeys:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateApiKey(ctx)
+	return err
+}
+
+// RevokeApiKey converts echo context to params.
+func (w *ServerInterfaceWrapper) RevokeApiKey(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "keyId" -------------
+	var keyId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "keyId", ctx.Param("keyId"), &keyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keyId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"apikeys:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RevokeApiKey(ctx, keyId)
+	return err
+}
+
+// GetDexAudit converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDexAudit(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"audit:read"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"audit:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDexAudit(ctx)
+	return err
+}
+
+// GetAuditTrail converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAuditTrail(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"audittrail:read"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"audittrail:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditTrailParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAuditTrail(ctx, params)
+	return err
+}
+
+// AuthLogin converts echo context to params.
+func (w *ServerInterfaceWrapper) AuthLogin(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AuthLogin(ctx)
+	return err
+}
+
+// AuthLogout converts echo context to params.
+func (w *ServerInterfaceWrapper) AuthLogout(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AuthLogout(ctx)
+	return err
+}
+
+// AuthRefresh converts echo context to params.
+func (w *ServerInterfaceWrapper) AuthRefresh(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AuthRefresh(ctx)
+	return err
+}
+
+// GetCashBox converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCashBox(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"cashbox:read"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"cashbox:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCashBox(ctx)
+	return err
+}
+
+// EmptyCashBox converts echo context to params.
+func (w *ServerInterfaceWrapper) EmptyCashBox(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"cashbox:write"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"cashbox:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EmptyCashBox(ctx)
+	return err
+}
+
+// FillCashBox converts echo context to params.
+func (w *ServerInterfaceWrapper) FillCashBox(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"cashbox:write"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"cashbox:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.FillCashBox(ctx)
+	return err
+}
+
+// ClearLoginLockout converts echo context to params.
+func (w *ServerInterfaceWrapper) ClearLoginLockout(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"users:write"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"users:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ClearLoginLockoutParams
+	// ------------- Optional query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// ------------- Optional query parameter "ip" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ip", ctx.QueryParams(), &params.Ip)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ip: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ClearLoginLockout(ctx, params)
+	return err
+}
+
+// GetLoginLockouts converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLoginLockouts(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"users:read"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"users:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetLoginLockouts(ctx)
+	return err
+}
+
+// GetDayCloses converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDayCloses(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"reports:read"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"reports:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDayCloses(ctx)
+	return err
+}
+
+// CloseDay converts echo context to params.
+func (w *ServerInterfaceWrapper) CloseDay(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"periods:write"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"periods:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CloseDay(ctx)
+	return err
+}
+
+// GetDayClose converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDayClose(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "periodId" -------------
+	var periodId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "periodId", ctx.Param("periodId"), &periodId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter periodId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"reports:read"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"reports:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDayClose(ctx, periodId)
+	return err
+}
+
+// GetPlanogram converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPlanogram(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"planogram:read"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"planogram:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPlanogramParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPlanogram(ctx, params)
+	return err
+}
+
+// PutPlanogram converts echo context to params.
+func (w *ServerInterfaceWrapper) PutPlanogram(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"slots:write"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"slots:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutPlanogramParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutPlanogram(ctx, params)
+	return err
+}
+
+// PostPurchase converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPurchase(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"purchase:write"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"purchase:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPurchase(ctx)
+	return err
+}
+
+// GetSalesReport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSalesReport(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"reports:read"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"reports:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSalesReportParams
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "bucket" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "bucket", ctx.QueryParams(), &params.Bucket)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bucket: %s", err))
+	}
+
+	// ------------- Optional query parameter "sodaId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sodaId", ctx.QueryParams(), &params.SodaId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sodaId: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSalesReport(ctx, params)
+	return err
+}
+
+// RestockSoda converts echo context to params.
+func (w *ServerInterfaceWrapper) RestockSoda(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"restock:write"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"restock:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RestockSodaParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
 		}
-		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest MessageResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
 		}
-		response.JSON404 = &dest
 
+		params.IfMatch = &IfMatch
 	}
 
-	return response, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RestockSoda(ctx, params)
+	return err
 }
 
-// ParseUpdatePriceResponse parses an HTTP response from a UpdatePriceWithResponse call
-func ParseUpdatePriceResponse(rsp *http.Response) (*UpdatePriceResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// GetSodas converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSodas(ctx echo.Context) error {
+	var err error
 
-	response := &UpdatePriceResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(BearerAuthScopes, []string{"vending:read"})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UpdatePriceResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	ctx.Set(ApiKeyAuthScopes, []string{"vending:read"})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest MessageResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSodas(ctx)
+	return err
+}
 
-	}
+// GetTransactions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTransactions(ctx echo.Context) error {
+	var err error
 
-	return response, nil
-}
+	ctx.Set(BearerAuthScopes, []string{"transactions:read"})
 
-// ParseDeleteVendingResponse parses an HTTP response from a DeleteVendingWithResponse call
-func ParseDeleteVendingResponse(rsp *http.Response) (*DeleteVendingResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	ctx.Set(ApiKeyAuthScopes, []string{"transactions:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTransactionsParams
+	// ------------- Optional query parameter "operation" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "operation", ctx.QueryParams(), &params.Operation)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter operation: %s", err))
 	}
 
-	response := &DeleteVendingResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ------------- Optional query parameter "slotId" -------------
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MessageResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "slotId", ctx.QueryParams(), &params.SlotId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter slotId: %s", err))
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest MessageResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	// ------------- Optional query parameter "sodaId" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "sodaId", ctx.QueryParams(), &params.SodaId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sodaId: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "actor" -------------
 
-// ParseGetVendingResponse parses an HTTP response from a GetVendingWithResponse call
-func ParseGetVendingResponse(rsp *http.Response) (*GetVendingResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "actor", ctx.QueryParams(), &params.Actor)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter actor: %s", err))
 	}
 
-	response := &GetVendingResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ------------- Optional query parameter "since" -------------
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest VendingMachineResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest MessageResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	// ------------- Optional query parameter "until" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "limit" -------------
 
-// ParsePostNewResponse parses an HTTP response from a PostNewWithResponse call
-func ParsePostNewResponse(rsp *http.Response) (*PostNewResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	response := &PostNewResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest MessageResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTransactions(ctx, params)
+	return err
+}
+
+// UpdatePrice converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdatePrice(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"price:write"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"price:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdatePriceParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
 		}
-		response.JSON201 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest MessageResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
 		}
-		response.JSON409 = &dest
 
+		params.IfMatch = &IfMatch
 	}
 
-	return response, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdatePrice(ctx, params)
+	return err
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Authenticate user and issue JWT
-	// (POST /auth/login)
-	AuthLogin(ctx echo.Context) error
-	// Purchase Soda from vending machine
-	// (POST /purchase)
-	PostPurchase(ctx echo.Context) error
-	// Restock a soda
-	// (POST /restock)
-	RestockSoda(ctx echo.Context) error
-	// Update the price of a soda
-	// (PUT /updatePrice)
-	UpdatePrice(ctx echo.Context) error
-	// Delete Slot And Return Sodas
-	// (DELETE /vending)
-	DeleteVending(ctx echo.Context) error
-	// Get vending machine slots
-	// (GET /vending)
-	GetVending(ctx echo.Context) error
-	// Add New Soda and Vending Slot
-	// (POST /vending)
-	PostNew(ctx echo.Context) error
+// GetUsers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsers(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"users:read"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"users:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsers(ctx)
+	return err
 }
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+// CreateUser converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateUser(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"users:write"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"users:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateUser(ctx)
+	return err
 }
 
-// AuthLogin converts echo context to params.
-func (w *ServerInterfaceWrapper) AuthLogin(ctx echo.Context) error {
+// DisableUser converts echo context to params.
+func (w *ServerInterfaceWrapper) DisableUser(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "username" -------------
+	var username string
 
-	ctx.Set(BearerAuthScopes, []string{})
+	err = runtime.BindStyledParameterWithOptions("simple", "username", ctx.Param("username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"users:write"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"users:write"})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.AuthLogin(ctx)
+	err = w.Handler.DisableUser(ctx, username)
 	return err
 }
 
-// PostPurchase converts echo context to params.
-func (w *ServerInterfaceWrapper) PostPurchase(ctx echo.Context) error {
+// EnableUser converts echo context to params.
+func (w *ServerInterfaceWrapper) EnableUser(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "username" -------------
+	var username string
 
-	ctx.Set(BearerAuthScopes, []string{})
+	err = runtime.BindStyledParameterWithOptions("simple", "username", ctx.Param("username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"users:write"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"users:write"})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostPurchase(ctx)
+	err = w.Handler.EnableUser(ctx, username)
 	return err
 }
 
-// RestockSoda converts echo context to params.
-func (w *ServerInterfaceWrapper) RestockSoda(ctx echo.Context) error {
+// ResetUserPassword converts echo context to params.
+func (w *ServerInterfaceWrapper) ResetUserPassword(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "username" -------------
+	var username string
 
-	ctx.Set(BearerAuthScopes, []string{})
+	err = runtime.BindStyledParameterWithOptions("simple", "username", ctx.Param("username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"users:write"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"users:write"})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RestockSoda(ctx)
+	err = w.Handler.ResetUserPassword(ctx, username)
 	return err
 }
 
-// UpdatePrice converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdatePrice(ctx echo.Context) error {
+// SetUserRole converts echo context to params.
+func (w *ServerInterfaceWrapper) SetUserRole(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "username" -------------
+	var username string
 
-	ctx.Set(BearerAuthScopes, []string{})
+	err = runtime.BindStyledParameterWithOptions("simple", "username", ctx.Param("username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"users:write"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"users:write"})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdatePrice(ctx)
+	err = w.Handler.SetUserRole(ctx, username)
 	return err
 }
 
@@ -1407,10 +7739,32 @@ func (w *ServerInterfaceWrapper) UpdatePrice(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) DeleteVending(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(BearerAuthScopes, []string{"slots:write"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"slots:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteVendingParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteVending(ctx)
+	err = w.Handler.DeleteVending(ctx, params)
 	return err
 }
 
@@ -1418,10 +7772,98 @@ func (w *ServerInterfaceWrapper) DeleteVending(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) GetVending(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(BearerAuthScopes, []string{"vending:read"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"vending:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVendingParams
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Optional query parameter "minPrice" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minPrice", ctx.QueryParams(), &params.MinPrice)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter minPrice: %s", err))
+	}
+
+	// ------------- Optional query parameter "maxPrice" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxPrice", ctx.QueryParams(), &params.MaxPrice)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter maxPrice: %s", err))
+	}
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", ctx.QueryParams(), &params.Currency)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency: %s", err))
+	}
+
+	// ------------- Optional query parameter "minCalories" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minCalories", ctx.QueryParams(), &params.MinCalories)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter minCalories: %s", err))
+	}
+
+	// ------------- Optional query parameter "maxCalories" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxCalories", ctx.QueryParams(), &params.MaxCalories)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter maxCalories: %s", err))
+	}
+
+	// ------------- Optional query parameter "minOunces" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minOunces", ctx.QueryParams(), &params.MinOunces)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter minOunces: %s", err))
+	}
+
+	// ------------- Optional query parameter "maxOunces" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxOunces", ctx.QueryParams(), &params.MaxOunces)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter maxOunces: %s", err))
+	}
+
+	// ------------- Optional query parameter "stock" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "stock", ctx.QueryParams(), &params.Stock)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stock: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetVending(ctx)
+	err = w.Handler.GetVending(ctx, params)
 	return err
 }
 
@@ -1429,7 +7871,9 @@ func (w *ServerInterfaceWrapper) GetVending(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) PostNew(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(BearerAuthScopes, []string{"slots:write"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"slots:write"})
 
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostNew(ctx)
@@ -1464,10 +7908,37 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/.well-known/jwks.json", wrapper.GetJwks)
+	router.GET(baseURL+"/apikeys", wrapper.GetApiKeys)
+	router.POST(baseURL+"/apikeys", wrapper.CreateApiKey)
+	router.DELETE(baseURL+"/apikeys/:keyId", wrapper.RevokeApiKey)
+	router.GET(baseURL+"/audit/dex", wrapper.GetDexAudit)
+	router.GET(baseURL+"/audit/trail", wrapper.GetAuditTrail)
 	router.POST(baseURL+"/auth/login", wrapper.AuthLogin)
+	router.POST(baseURL+"/auth/logout", wrapper.AuthLogout)
+	router.POST(baseURL+"/auth/refresh", wrapper.AuthRefresh)
+	router.GET(baseURL+"/cashbox", wrapper.GetCashBox)
+	router.POST(baseURL+"/cashbox/empty", wrapper.EmptyCashBox)
+	router.POST(baseURL+"/cashbox/fill", wrapper.FillCashBox)
+	router.DELETE(baseURL+"/lockouts", wrapper.ClearLoginLockout)
+	router.GET(baseURL+"/lockouts", wrapper.GetLoginLockouts)
+	router.GET(baseURL+"/periods", wrapper.GetDayCloses)
+	router.POST(baseURL+"/periods/close", wrapper.CloseDay)
+	router.GET(baseURL+"/periods/:periodId", wrapper.GetDayClose)
+	router.GET(baseURL+"/planogram", wrapper.GetPlanogram)
+	router.PUT(baseURL+"/planogram", wrapper.PutPlanogram)
 	router.POST(baseURL+"/purchase", wrapper.PostPurchase)
+	router.GET(baseURL+"/reports/sales", wrapper.GetSalesReport)
 	router.POST(baseURL+"/restock", wrapper.RestockSoda)
+	router.GET(baseURL+"/sodas", wrapper.GetSodas)
+	router.GET(baseURL+"/transactions", wrapper.GetTransactions)
 	router.PUT(baseURL+"/updatePrice", wrapper.UpdatePrice)
+	router.GET(baseURL+"/users", wrapper.GetUsers)
+	router.POST(baseURL+"/users", wrapper.CreateUser)
+	router.POST(baseURL+"/users/:username/disable", wrapper.DisableUser)
+	router.POST(baseURL+"/users/:username/enable", wrapper.EnableUser)
+	router.PUT(baseURL+"/users/:username/password", wrapper.ResetUserPassword)
+	router.PUT(baseURL+"/users/:username/role", wrapper.SetUserRole)
 	router.DELETE(baseURL+"/vending", wrapper.DeleteVending)
 	router.GET(baseURL+"/vending", wrapper.GetVending)
 	router.POST(baseURL+"/vending", wrapper.PostNew)
@@ -1477,78 +7948,264 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8xbW3Mct3L+K8gkVX4ZLUmJF5FPkcs+jlyxoljyOQ+OH7BAzwxIDDDEZXeHp/TfU93A",
-	"XPYm8aJK5Y3cnWkA3V9/fcP+sxC27awBE3xx88/CwX0EH360UgF98C6G5vfxwx4/EtYEMAH/5F2nleBB",
-	"WXNy663Bz7xooOX4V+dsBy5kSR33fm2dxL9D30FxU/jglKmLsti88sF2WtUNiVWyuCkuN/XVdfegesfv",
-	"HoovX8oienCGt/BYCV2jzfqB16/XZ8t18QVF4PmUA1nc/DmJK6e9/VUOku3yFkRIb0nwwqkOj1ncFFkd",
-	"zFbsjyyCcSPZxyyEBctqCIyzYO/AsMrZloUGmO99gHbBii9l8QHWfwcjlak/aRu+j4a9tvTOvzmoipvi",
-	"X08m256kd/zJbNFiVyH0/mM08AHWbJUEMXyJrZXWjEvJODOwZt5KPPzWoT+PfzPl2TIqHZgyjLM171lo",
-	"OP2HL1QxRAesjTqoTgMJ80xww6wQseunb+Zb8EmtH6MTDffwyUr+Qm0+BWn2TDRvVXVb1dfnF6TXjvdt",
-	"XrSyruWhuCkqbXkoRv2a2C7BHZF478/1qaoeToW6W+5jd8RtWuWQ0b6Uxe/ggxV33wddT9EHSHeqrsLy",
-	"bf3mYkX6uI/cBBX6mQRlAtRHFXC1ObftFdc+3N02RxUwij2igT86yQN8dErA/+Hxz6JaPbh+Le5PuyUd",
-	"38CaNvFsPFy5q9XtpluvbHctj6pjXOaIOr475TxFK7fN6W3l7t05XF2aI0d4DPt8CtxI7iQxh62YtvYO",
-	"aSB2jBMXnCBlLDK9+c4aP8Wyz0jJv+dPX3BwovbHntx1VfsAl8vLcNfbdKRvnhI3Cybk/TAfhQDvq6gX",
-	"7HcI0RnPOPv1H59zkCEGbaMPbAksepADnaIc69RDEtMAl+AYx5d/BO7ADUHKOubj0iMqTGDvPr5nORfw",
-	"ibvTY2AE73zUPIDHZRxTEsgDKQR24FrlvbLGlwyMj474GQQyOqcTDHFhIO+Wi0YZ+MGzKhqBm+RaoZYX",
-	"jGzFVlwriQsoz7RqVQBZsgQcfN/BK76tqthZw2DTKdcvMCb87Jx1aPIXmBtQxmPNfeG5uFvJN7aqKvVI",
-	"c390dqUkeCYhcKXJfoko8Eh8aWNgtAmPNrDRBHAgmUwaRoV2zqJ+8V9bMW7mNlyw9wH1J8Gr2gClKNx7",
-	"5QOTsAKNR/VkQTDyFdrVI36Sbat+WELw6CFLp82UrOJCaRV4wGfuoxJ3SUxVgQhqBSw4G5cafGMtPoNg",
-	"Up4Nfsl8cFFQwFdG6IgaGIQzYWXKrDhrYsvNKwdc8qUG1oL3vIaE+qTIJXjao+EkzVb0X96lrSogRSnj",
-	"0VR4umBZZ71XKM+Btzqiqj2zjnGR/jQAMilLWOdABJKpvI+wYD/2TGjgTvdM2LaNhrBk6rx534FQlRK+",
-	"pJdGENKpwTTciLzjdx/f/4DOxJeoyeRIDejOs5YrEzhlSb61NjS4bXBpe6zSdk0A/y1p4wmsBhvedjpB",
-	"+29cadQYSkEx9OGK60iCsqaLm+K9IU9kwgHBgmuPmEPUykS2nxJHsd+Gdw7K+a8OXEI1pqcaAsgZu2n0",
-	"WRR2zBPbSfhjfLFu2+jO7m4buan9I30R6a4GA06JEWkjYBVSZ6VhQ8BBW0WjVuA817pnWdkZU7sQJ14O",
-	"jbOxbtCh0fp/Vy5ErhlmrCzHZ/ZbIkVyYUKfWUHPAmzo0TkzZDYVWoEJu86FSXN2q0HFw4GQnwmniW98",
-	"ydbcGWVqX5IHmJ7Z0IBjDjSsuAnbq6LfoXcQnS9h5gEp8nA8NUdLVNatMVgTqre9OMk7xE0ZV8nB6FVh",
-	"jVAeWAUgl1zcDQdHDQlrfGzBlYwrmbycSVjGulamLvPG8fNEo/iaAx918EgTdsBjOnkdkwx8igIcBd8x",
-	"MPoAnV/slhrfIaEQDTf18/PD+9u783t/ubSgrm7JGzEJ+lY1iHsvHu0TXT4xW3M/c9mSDES1HmK44Z4t",
-	"AQyTyndgPMhdWI5Mn7E3kPX4QhJEzG96ljTDHGU9IFPykgw4vBkcNz7R4oL9jHkHJL/RGlm7t9FNMpO8",
-	"fynmBdKLzaehCnYF7tH1TWze3p31FxdXy9BeDjXCfz+1Slptbu9vV7fxXt7G1CSxWj5Zyv062Ndvlpf1",
-	"Q8vjI0nyE7gVBms0xpizcHFn7FqDrLEspUxhBhQ0GqqbUpTB69A/5RA60ZZc3kYf8H0MgBLGRoKV/AcM",
-	"3CswwbqerVVocpK7k0wOlMJVi5sKu98zLltlFFJUsM6XmW/yDlqSzMD7FOYmzrFmII/hGDnpKjOmR+Kg",
-	"slPONqsxzfIjpmFDZExyEpsKG7VkxlICz6Wk5C6hmHdcYGJA2W+iql2XolwbzbF1MAoAYyqme9Zyg8Fs",
-	"3FbJOs1T8p/bLtPZkluPKYjtgmq5zm604krnfIXIcFZnvzDTfnGlzC8beb7ayKuOi9vBJV4o8qEzZ1fq",
-	"4m1nrt8mctU2fHhC7Xu6lK3n9zWYpg/P8DBhTaWG6LvrVh0eLmNuciyyKh/z0GS4r/vLoRC8gyhyDdW2",
-	"IBWudsA1JlpWbqg/qN4gv8YdJUf2oHVyISXgaIhIKX07JvR0Cuo5csI1SLbsc/2DWkjkXuZPYKVs9Omr",
-	"KUwZWOueeQjDF2N5wFMk6bgj+lqBWylYD2vj0/TUyFB524P3kSMfcMEVOFX1M2bYdi0uRHT46bCAA2Gd",
-	"9GRBqjgGfyVfy0lizhG/Q+yi/ilBNUDrn9RBHmHMneP9EfC/0aK6Nt36Hpqz+5Ru2MD1o8NTKzZn/EHc",
-	"1W+uO/PY9smEpVwkasrSqbrcNDx6qk53TbzflZhx5ZEqkoJWIsShXV1m+HPvrVAUCraa1eVo6llWnQCa",
-	"QkIKF4NfjhUEeeZYcgMD7vtZX0U4FZTgmkkeeMnA8CW5WCroKfZsgzNY1vI7yLvAkANCUfuGOai5ox0P",
-	"aZ8v96JD8rlZxN7zY8867oISUVOlHD0gYyGwp9iYohJlDCgUv0z1jB97e8Gy+wgu+ZCILvlntoc/Yr1U",
-	"lWbcIpo+5ZR4d57TOfC0HN8Kb6lEGc8/D/ItBI5KRhpukKKRo0o2E4zFhKqVQYMieATX1ilUIp5xZXVs",
-	"Ue/MRiNg0FvaK2otlYo2p1Yj9fl06In1do5cMq61XU9G37OwaKzCBYtytwDJG3y0W/rV6cX9eX/2Rqwf",
-	"Xhd7LrgbGDHDPRgxMUKTqj6hph4bUdsLb9ZX1eXtUizT6kmTzw7yb93qItRXG3V27e4zy6ig8T3CzR7p",
-	"bLXT92H1E1TKgM896a9kqyVGdwwEKQKhmUumgmfC+lCylm9UG1s2jDoSgAYfmNHFHoaEi4LyV+uSjw3p",
-	"6eR6gxPnrmEmr7Fzy5kH3mqkl2HTY35xAEHWP3/g1bx5EG8lXJytNt6TPVu+eXIlcy1UZc439rqpVZdQ",
-	"IUTsFMhPjy6HnzGsWtdvTt9eX51dXPj7q23szDGyC6HDwtT1plnK26s7I67y6I866Cr0n3CjSdmpe/8u",
-	"hgb/W9J/fxsU/+s/PheZ+XC59O20fBNClwQjLQyZAxe0B2i50jSuAeP6y3+v8f+FsG0xOHDxK0cG+g/8",
-	"viiL6PBxetpAWFt35+nxgz2Eb7a7uqERzplXLU0aJAOzUs4aChFbaEb8jj1RU6f0lLNVXoXY/FCy62PX",
-	"WRf8rPszUjl1fbZnCuUQBCiCJyeZVYGzHIEagpjjDU+mGDkQPJ5wnmjjYaIHZPOpEV8erVO3O/Sz4Aib",
-	"TlsHeYC+NUdJ5cKgkT3umWLa0YAsog+2BTdvs/gF+wWQfrhDA5HebXRDxzI3uFPnZbf6numc3pO94a0S",
-	"AxGVs50gLp3NLaY8Rzpgn8X/mGLmct/AWFEWmAomUJ4tThenFIM6MLxTmK/SR2XR8dCQr53gaifa1ori",
-	"Wpd5bhfdVAnIzirU27S/PCnzbKV4zgvj/N7IcPlkwf7otiZ9eyBUIQ0f8uivZOtGiWZ77Dcf7aUBmjJf",
-	"m+wpP472UhHz7GldAzSPbHhOsjuF+ZrCvIhXAVze7f7ATnlmAFfjrp/ad5i1E3zmAwfuIG8QK7hgkYdQ",
-	"h8YGXNHmwWdKH1956iFbiT5f7WiTVantzdn56VnuhVPmmrqMW20pZYb5z3wv1CZHPdlosmESDkd8v5d5",
-	"kPufBJ1ydr2qPxaLtm5gnexev9odab8+PT0uKD93sj/3/lIW56dn335zd7JEsSi2Lcc8bT6iHrwS6RgV",
-	"wVIECrz2xc2fxbbqi79QzsmQVx93qXeYy/opkx0b0MmLRGM9mMSyy367iTd0GUYvS6/kCzOMt2i0BNrh",
-	"M+WHdh6an45SbbW5SSzo1HVI7RQ/73K/z22CLA82Ik1Ipt2kjI5qmNTUniFu6M0M2l+w94YJngauyvhY",
-	"VUogrIcFEnZfH8Ju52zbha0xxtSBme9x0sOcvIbAm/beNb2nqhI2HTgFyOu2mkfEMWkdY0lSU4qcealp",
-	"Pp2U60CAWuHDSReHfOej9WGYszzHffaugz3Lfw5OesiFXr/UhQbRKVbRTcEdqp15Edox+05OPo67zs9Y",
-	"+4P/RucbUeGg02CUbxJQqZ5IHcZ555Dm3emTfsfFqLyZ+9lYqiTQyXKn6MDyNjfIkT2py6D70X9S8ztt",
-	"ZMuHtA3oQ7kdnqTmRjqGEBtyQrXXys74riDdDdgrjdSs/JLUJsr3DtrhAuIc0GN1NOZFngflqzyWxxdH",
-	"FKPXZL8V/SGE5wlUrjCfDPADN/yeBfHdQRih+/yl6M5SMz/M48GEwikexGmGQLiO306yUnzYx/Sc6ahF",
-	"lQBNJLV1Y2Un9aSpdYAa0+Ylx2TCGiah5UaWRN6Zq9KoGFnWZiuj8Vc5E/pxLxbteYiB9dB8ni7JZq/Y",
-	"ihhp+8r4wE3QfclU2+Vah2s9QH1s0S3Y57GBLdKIbISpz/PveaPcp7ghbNuCyXddxr50cNSwx8x7BvBD",
-	"KJ6Nf56D4t1bms+C8O4I6vtAOEk9hKRvwTkzb8KwhgBH85uhze+gtSuuD7AvIaicd0+l8lgbKROnAIyY",
-	"dGBdzY162KroFuyn7VE7LYUsS3K3h5eVMnTJZKrBIiUbDsb7PrPe8nTTa9aKmlWQmXunsdTX2New2L0K",
-	"9tU4I4Ex6dkNZNPZDuDxJ1J4Lv2eg8gjF2WfBcw9gH0XYKYjMtwhe2dkvhRKeYT/GjTLooZwqAkenAKa",
-	"N9K9LAcNGI9m1Sr91gHpZmeIMeEmdzBzXjzOP1LvoxwSgrH9vXO1KN8DyVDZuvio5B6/U4tluKI4DCxe",
-	"tfwujc6GscV8VrE/ucsMPuawiz0M/QLh/wmAjgz8vg+OfoF930oDwa+C6EjBJtFc029AqIiiTrZL+6Fl",
-	"5iiaDSz2wzhsuiFgrrhTEOi+9zj22aOWfJ18ulg0XfhKsf/R0xoTg1M5tO+N6JRRdC8kEd6sL6/t0IIc",
-	"AWcSx86FUANyGuL5nB0HZ2UUw4A/JQj0ybwhf7gXMw3CHLQ0Ue464HrYQE5pD/8GB7MltMD4s5oxRnhM",
-	"WIaf8ixpWCUVTZ+wREy/uXmPNSsyeh5H5L7aNKofr4aNPxNapoG+nNaQ4FIhOP1SCl/FpxbsYE34AdbP",
-	"ccrjP7ra98uzZxL79Yu7KlKyD7BOFSGqb+hgDiOEo0nHbFBQ3Py5PSL4868vf3353wAAAP//KkJ/lu43",
-	"AAA=",
+	"H4sIAAAAAAAC/+y96ZYbt7Uw+iq4vFkr9r3VVLcGy2r9uLctyUnneNBRy3bOiX2ywCqQhLoIUACq2bSj",
+	"x/le5Huyb+0BKFSxOPQQx8rww2mxqjBsbOx5+GVU2sXSGmWCH53+MporWSmHf756K2fw/5XypdPLoK0Z",
+	"nY6+V85ra4SdijBXwtc24B9O+aU1Xgl6faL8eFSMfDlXCwmjhPVSjU5HPjhtZqMPHz4Uo6V0cqECT3c+",
+	"/VqGcr45I6yjM530YunUlbaNr9fCqdA4oyoxWeMrZ6/Px+LtXIlyLs1MCe2FNfVayOWy1qoSOhvJB13X",
+	"Yi69CHPtxRXtrRA2zJVbaa/E45OH4rVTpTWVhvWIL6WuYRSfJh6L77wS/48IliZy6n2jnRJhLkM7lbrW",
+	"PiBMNGyK4DwqRkYuAC7n0yPa/h6YweDKhy9spRWC7awJ8zfpxzX8VFoTlAnwJ266lLDyB+88gPOXbPyl",
+	"s0vlAo+0lN6vrKs2Zy5G10c+2GWtZ3McVlej09Fn17Onz5Y/67WTlz+PYHGNV472c9gIy3ltVj/L2cPV",
+	"yWTV7k87VY1O/9IOV7Rr+6mII9vJO1UG+qqLMAwOwJnveAghTSVe8yBwUjMVhBTBXiojps4u6KDWPqjF",
+	"WIw+FKMXtfXqpVzfEailbUxQ1QvpEbN/59R0dDr6vx+0t+4BfeoffG2NWsPUxgY1dPxd6OQjHwIVvBLS",
+	"zwV/KLTBTS9kOddGCUbWEvaN4JJGWPxY1gKWNEawOCWDOnt9/h/qrqBR10vtlD/DD6fWLWQYnY4qGdRR",
+	"0HjqPQDEy/LL5gNf2iWNqoNa+MF3Ftqc08OTNLR0Tq43QMtIx4MeAtwf4l2/VGuhvZhaV+C/aQyhg5g5",
+	"aYIvxGquy7mQSCAA1EzZvlDSKQe3WThbK1/gGcQDqNdiNVcGxmGwZacBSH7Hs5DVQhsivUunShkAEME1",
+	"qr9RIHWwPqGND0pWY0Fr8IAuOAot1Fh+zYuZvlJm3B7nxNpaSTP6UHRITsKA9CMe2VfKzMJ8dPr5ADrA",
+	"DPtu1Rt4Zydtui+yAxcMvhXBihKBgiigHUKiEGXjg10oJxpTK89woWOm17TRQctaxFnxiF8tlmENl/wL",
+	"e33HQ66UsQtt8OXuXdkFwJfZV6MPCQ7tzdkLmBdWG4/7nOi69gCfIC+VsE2I2I+EaWKvC2GdUFfKrcNc",
+	"m1nEJSBPTola+6AILF/qur4fqJSNc8qUOMRShqAcrPl//nJ29N8//fLow++G6NDfCZI5Fnan+Ol2YJYV",
+	"Mrscwgi9r+zMNneVF5yaOuXnb4GHbkptb1EmxDeYzWrvG1WJlQ5zXJEsS7gG+LCAZTp1ZS+VkF6sVF2P",
+	"NwH/4cBb2J03G7m2Zja8AATLN2r1vTKVNrOL2ob7kapA+NuHGNmkG3iA3x9y/N+olbiigUjiXIFsCxgg",
+	"hVEr4W0lIzJEQedt+ltoLyaNroPQRkixkmuSX1lImDahcUosmjroZa1wMC9KaYQty2a5bp/kS/AkSr2u",
+	"pbEzJxc3BuUuoKVREWT5ONdHa7mobzfSBljPxDI+Bsz808W33wCN+q+zr79CnHnduHIuvbqwlbwjqmjj",
+	"lUPOO3SZyt71jm/DmS7luohHFelZn7SOxQ9ATJnrLKWuxEKuxUQJu9ABBoKxF40PmfqzAJ2E2VOwQdZj",
+	"cRbEwvogTo7hf2KhjQWWpgPhw0SllRFvk/z+o4ei0j5oUwbRIW5iqhOepcWOivugqa3E2LsroBJYJ0oZ",
+	"ZG1n4vxlUjD5lkya9SYBGtZi7Ek5/1xP301nzx4/GZFiq6uDpf2lXC8YWQ4RvvDgkvAFiMEDAAi/u3gJ",
+	"SCrFtLYywAaSVIW/tDsyzWKi3JYdvfeP62M9/fm41JcT3BHc5vMBxMwAh4YABBwqVDm64QuohnUx7kAS",
+	"/6EYvcl4zT2zrd3CYOftn27Lf9Q1GSOQYrxRXoWoi96jzn5jAbq31RsLu8BVOrLqG+WDLS/vh3HexJCg",
+	"Knesn4bJ57NHT65wY+8baYIO602U/aNdiYU0a+ZhwYraSqBVQdRKAmEbE+D0olnkuqI2Qc223pmn14/t",
+	"4qmsfbh8N9+0ZbBCkZb10zCeX6gACstdUfxgvaiP7fDjTY4fPsCj/24Jivtrp0v1K577SaOvfnbrVfn+",
+	"eEmEyqgVLuJgcgovd+kpojX+3JJSoY1YyHeJ02VE7/c+sdxbE9yn7unVu+vl6soun1XEQuImDuAhQ6i2",
+	"Bb/uXby9yWm9mx+/m7r37rF6+pkZfTh43f1zuwjSVNJVKKXaqaitvQSRs1kKiUfC5wgMR/uWO52/HDOw",
+	"yFRN9lM0Zb3hn+5NOqVht12ds9fnYCnCm0Nv+lutoGfEWWoY6WB9NK5xjyYahz2ULvDmyEJ11lQ6vHVS",
+	"1/ewQaOuw4vGees2yTowVLiwJT6PJl4UPNR1EEs5U2NxNvHKBGFJIqml5wdDOr5TpXXVDaAJO32DH+0F",
+	"aRz7EJCe4RIjxZEwiwgAUIZvIOHkHsDbMcj27ZvKbOjMrSmyONB+y4LRqwMm6spQt51pi3HiFctjqJ08",
+	"kE2YP4jzTa1DDoDzemFNqbZtvBqL8wBKj7FBwCDW6Z8VaU+CHTV+ELfCdptJ1yhxmAbiltPFz+qzyWfh",
+	"cm0Jh/ajVRPmygTGDeEbnHfa1GPxBh1bwPv+9MNb3jEaA1A5nKCVM7kPznjfNAy5tYhxkkU7+lisE76Z",
+	"eICKCUgjEoSQWjNgTSmXvqnRoozGVF0plJhQl1wqt9Dea2t8IZTxjUNTgyobl0EO1xXtEOze+L0X08aU",
+	"ZE3XgPFjgcghrmStK5hAe1HrhUbFlS4rfO/UkeyCqllaxgAi4GyGvHcWwuNu9eXQHH5Dz28dA9UdmNsQ",
+	"azmcoVyq9TB6X6o12vq8MgmF/nx09vr86D/UmtFnWC3cZEkjmucm8mpkvOLtXPsoHKDXFghJ7sFJ3l3Y",
+	"zku5Rn/gvZ9xHHjbmpWpjuz0qJLgVl5ahwZzSU46vA7adld4H3IEDXsDu3baxB62Fwc+2F2Zb9MXwtaV",
+	"8kFMtfOBdq2ume0Objqo6/BgWUvd2+6AX31z8pev/vzguxcXzHGnmhWdV85ZB/PtAPDS2UmtFv/vDQ2a",
+	"9NVW9+JKmSBWzppZAQT2zZcvxNPPj58Knk1UKkhdk9j1px/+4+IeMOGyL07KisIgZP06e5GUq96R7sGF",
+	"y0NFyjOyuf6gJgJIxIUK0YWhzVe2vLRNuA+kr3mog7E+X8De3abRD0X9CXyhKhGdkWT5LWsNSCCryinv",
+	"2Qn8tfJezm5Cm9S1XCxr2vcFMX7Bo8BPV7Ju8I9F/G307VI5HEMAJGoVVJWJDPV6TPvYBtxFO/gh8sxs",
+	"sWjcyeW7eXU98wfKMwC0mTLK6VLwdG1MkiZFXl3rSU3BDY3REO2DnnUG0aTOvvDBNSX6PFDYCXNnm9nc",
+	"NqRSfK9daGQtwOgvWKcWX3MgBfCYYIE/X6m1ABoEr2pDgivAMLrk8DSZF6WZS2mENmXdVCqCOG4Iogek",
+	"M9rMPDpKwYoVpc1aXUkTutMA1TBKVSgUTRSc3aIxGk0i5OfxoEnM5mFq3Qo0agDNvFlIc+SUrBAkNB6K",
+	"utqLSnk9M+x1cPZKV7APJR3hpzWl9kpMlaomsryMOwWQlNb4ZqFcwWsEkMlJhKhTvqlJmLER1zzscdZo",
+	"hG6I3nVrckHSB7X0Y4FU2aOTmPikqihMrEMao52nEF4pkSj5uOOkunce/ys5qtAVEF+IQmFPCgbJ6qYR",
+	"fmnMGLpCqpNH3zy46tnogpesqkh6XtgrVaGHBV+u9sQC9vxo90DOad5DrYD09sumtQTe2nXy7vLxe//Z",
+	"xCr99B0eOI/djxjY7+ALbQzjSnK4iNCmQF1qyeDy5BBCn3byCIIqcG8etASbgz1ahzqLorsNdldpv1QG",
+	"SC06j4aUZnh33xoAew6PE4hAxBW07CzF5NDy5tKLiVKmXWOfZDO59onO8DbbTeFAFFS3joeaAldRWyYC",
+	"GL8MThovUV0di1eg6CriKXWtyiDWtnHtmDTe/zV8t4egxa89wHc+fMg9N3eXo9Q02Cvlsnu+23HSzD+/",
+	"PFk/efJ0EhafRRv+f2bum4NGubp+9/7d1bvmffWuoWBUW1c3HuX9KtiHjyafzX5eyOZAweNCuSvl6RDp",
+	"/OFIy0tjV7WqZuiaRaWtRTDhCNxAmRO3A75YIZSZ28nqXePDAnX8haxUCt6wlfy9F9pcKROsW+Pl12aQ",
+	"3jPXlnpBtq7ec4rY0yAFBOt8wSydV7DAkYXyXhkMTEts3UYbXdrG0lnYXMF3IXHsZcXCRlxsra5U7dNd",
+	"UNfwmcBxSGApbVNXwli0NCFDEVVD2C+XstRhTWYaIqX9q4hGIeV7G0OhSk2nqgz6StVrcACCgJiWVSCb",
+	"Q8rKoS7t3ogcLKQ2QWqKiV3Imq/fldS1nOhah/X4LhfwQtagu4Pocu8CSDY20UZQi0t/dQul2MNQLGKh",
+	"2AQE9wVFUdwD8QCYHq6BEbHf5FgYqDJw5w/mC7gMATfYbDEngiykUPK2DnE1zNWa/YChXseoKHZHwaLe",
+	"Wvu1NOuzENRiSRrrr2lBeGsteb2nRKBq0F79aesgkbQwpBZ1bVdAL6ZBObAGu/XRGf7tMRHC9zA9e2OT",
+	"6V/QJwDHldRwqafWqY15BwXE9uBgQ29bnug/NmdSxs8Px+9sw3sNDJ0Jbu5Wyj4XwLWU63v19yDsfmjf",
+	"xS9/W2lcfjavHl9dV0+XsnwXpYIbroMSjV7fz3p+XpqTp/rJ50vz7HP282fjHx4zdqO3gQZ9cwM//fGk",
+	"Wnj5fqbMfB1uIQWV1kx1NEL0RR86WJILWuEHOa8UfqlKPdUlMdfdMs2QJaLH9UkrWixUpWG2AfGlFbl1",
+	"ctlR4DTIXkJGYcuruiYxR5dqq/ifwtzyYEJKWUPZo01SIyiQ4F7wL4QI9KhVQYxa1WvhVYgP7HSq4PCE",
+	"pFu7lA7J0JVyV1qt4tzwNr6VpEhedpSQUNgaEJOulNPTdSa9dcUfWZaNk6GdgH3ZeIJhnslUd5KHIKfl",
+	"3gUhGHRX5gbRPK/cffAXGPBwWk9L20Pkacib5KJkqqhTJZ1KGwbEFsuPjZnCjTocsp3g+h6Ah+nfo7qc",
+	"PjPL1Xs1P3k/+rBDohz+flFen8ify8vZo2dLc6g7viUneDErVaOBWkjwNc9l40Fz2bjlm17uTKVJlEIb",
+	"DwuEhyzOst4SI/ljopL03pYaNbZOHH+RbntmXyYaRZobaXWRNCfjORLnqSxBPwKaoaRfZ3760umgS1mL",
+	"SgZZCGXkBKksYS6M3qNPAYTYS8WrAM1QlRrDAYRTM+lwxck0VmwocRzW1yrWG6Tci6V0QZdNLV29hpUA",
+	"0wLa1qqwpDwu0BNjKnxIpnyfQtGCFe8b5dZZRH5I5+FvZp09hGAm30sW07Z5E89MdIAXyBuj8R1d3sGr",
+	"egrBHxSVQSYH4qCBDetA6NvID5ITheRXJ+BI0ZdqfSqmljWICY6LgXdF30DLEQo3yP7kT75YD4UNxZy7",
+	"Ku5okHQcEuIE0IBLDVY/5KBlqZYQrQ7+R58Ax1DB8BHRmKBrTjOqDo9S0jutpHROaHNHrczT3QUEzV5h",
+	"Na20tSztX8l+3NOxGgPIUlo3TE+HcyT6Ka3oB8O8Vt9AAuumuFOpK10OT8GQ2Qt3MMTeGIqHJP/u5Ku6",
+	"ion4abAiQ9Ac84D56lDDWHzLBhzfeSjg5iUEKnSlazVDS31db1ysPMZPnAcPFuh5DFaZq2tx8cezo4dP",
+	"Potwp++FMqWtyJyMHnO8u8A+IIMUxyjwNoLnncVLzj9mfIHfCJk8e9BMldB9NddB+aWMsinIq3/M1wV/",
+	"dxfEw+pQ8BqiiIgBHMIatUkWZLQi7Io0GLDYIHvh6YN0mN6PW6HQqBqM+iRixDxq9DoocmUjnRoPHSXt",
+	"4X4WlF3LrSuKRGz7iuZcSmADxZMyNexeSo/PqzZkbDDNshjFwx2ch9Wk3R4efgkjVZKjQxtEwD8fMZM5",
+	"Oq+2h3wVI6/eD0iT1uuQuScZ1/jq4KUpqMQB0G4s7XCSkHgml90YUm3CZ49HGykeMLltXKnOl5sreNEJ",
+	"wkiAFCWQwu3eq4ZOcJB1kXmfByKjHL/faqecJ2vdIJuFTxL0tWsjzAeWQui4g+LTdshzexrdu+cvCyFT",
+	"PAoQgTgJTkw3jeNcSIZFfIbYgMFMr2IE9NwHuVgeKgL0U2PV+1E+Sgvk7PhyfM3vSAJDhu18uXIan1Hy",
+	"gbsYQzQP9uf2/S9zW5NtFLy7yR5Qw8p8N00yRr0Vv9H09UxJunnKStpEMZD3zkcRYT10DFbXQ4y2tLom",
+	"A5Szq9aighY6+J3s/BpjuPEoBsDLPif4e0dOGEintm4WZt97/Y3TR0U7T75j2NbAdlO85ekvB4WMfvLf",
+	"R/TXp4PRoxtbxsc3Eszxiy+2hPxi1M4KBHSaF2XYNPfmYLeomKOul6q86UdAqjMT+/mWvGtaqigtquOZ",
+	"4pOb3JOwoT0SyuOerNPu9wDWo7vpnPFFp2T1ranXvXjL7MMthYOQ7Jk90ndrf6T9EvGGA0Oes4rv0X6y",
+	"vbPMziLs3m3vydq4UqYZQOw39CAZVtENyTyfJikEVglZAPbAT52kvIPoW0KMPmFL9qZ+GhpYPiDSiXz0",
+	"MCm8CvCqlCO+nBLPipvFUg+aq+DfFIi4B29bOQwtaZsIGx/fCC+vpNPSlAPH84LurFho03gRbyOlJPAp",
+	"tXUCWiElHtFzYdRMooEL8axkvQJzPczsoOUNqXQJ7YuWqGXUaogEbIFwRIIWRXtEp0u3Mlhl9DzR7R00",
+	"/YLLhQxpFF10I8cKoJelIDw+S3FGv0bdpqIkmZCRMpiIvSPG0sDDbADspvB0yCdbdEJ9ep4zTJIlQ6am",
+	"YI6syANVJ8mUHjbTdcTEbB44xt0rSQNsWwrFkQTbzj9Zx2n98KxtFNtgJNqOR99sq1IG2O8vbF1tCU7o",
+	"132JImu7+6J7LPmQORSysxnAP8SxIRzMBbwBcaoVnEi0tY4lWztF4otx48XWC58ylNK9P6VPxMMnFKH9",
+	"vpEO2ChVshhASbhi8MdCXrOEBTVIij2SWYpn3yAi3XH2DNQ7IBqV730HzDkYB8D8pVZ1hcHHQ0CewtOe",
+	"Fk03iSM30OCIg28CCD8eJh9oji7EUoY5HBwn6qUSnHAfSK9LDDxbx8RW62jMRdsSDhPtgBjjQp5R4r/j",
+	"URFzDCCoLkbhDTD8LDFgQBfVnvJd2pJNuKru8IyVMTexLeOwV38kaLWLyM4wO6OBE/xKrm0T3tjV0AE6",
+	"uyIgOok+mE6RxQiysyKa1j1qKX4I2XV9uHKGusKAvOBokVkdkJN9YIFPCp4+A0m76SGI5MkwA0CJZgOR",
+	"fCXJfrJCKyTGI8VgIArkjykwJFp34pY2ocUvf2eCrndIuVtCnQ6XUGEZ4D7fnOPLfIGpyKbXMYEYJbGY",
+	"0GEdcB8VhlnPpTZ4i5VpFtHvy+ZpvRz9NLAs2v23zbCEj4FqGjfMMLVNKIST+CDMpQFpQa5JZJUCgiTs",
+	"dDpcszFR02FNj4iINgIA64DmtzSle/L70zwRDkWitAn2Rfe48+3nCJvj5ADOsveb8HqL7jdfe3RQ1vjS",
+	"Dr9dvwDL6gaJZOli7S0bAMNmO+zuYGiLqNIM+QHVtSxDVJfsVCzgzWKjNmyHhyOxSqz75Mkx0bD4E/Bs",
+	"QLHfnYyfHJOTYPOdP73+L3jnf/+vkyfHm3Cj9QwsmNa5XbLg4VvfVIne3SHFIbH440ErTmZO6+lUF9+K",
+	"xw9PnrZ7KW2lurzou4uXsKeDzHC9s+WtZyvIDxrPceCAX1Ptr69VmNtquNaR7KY5LKWuTvlQtlWU61YG",
+	"LqhSmp/XfGkjWSpZ8eFH+YK76xogWG0q0wC34InjrQt2RiQsiQFkuJO+jQDbwUjptUWscH7QnUzLO0sf",
+	"D3HXOtGOnYaFzjXdSA2ln3PgJdgMnfjAyjZBiM88x6+lfMNkDiUzRZJAOCxii320thKzujj7i8pdQNw+",
+	"2/JSWH68jFbXQ+KMD7csynSWfkASQ4lQbW2mglzTqFDyi55da4OBmgMEgLZ888jKvMrYbrpyWE4UOWjB",
+	"WN0PoSBDcSs/ntw9RWpQ28QRhnAxQ7chrOTI80FWA2J00cnL3Ja73t7wbcHwAoM5BSxgjP/lAiFTzfGX",
+	"IDMASPmj0x/Nj+aIEk1PJ7U0l+xeCzI0Hkm48HKNXgDyycItgM0DFuFpKAnzLudOejWGweKC/INWFzvt",
+	"6GuVVR6tHql+Joa2PidYeCxkDJLvho6jZDlnJac7U+QKRwvtcdBTjgXh2o9oDZWGsoHjyyTapXjT3pDa",
+	"+GY61aVWJhxNG1P57phpE2j63jqMt3V1BNBFW1JtA3pSoskpZdBJA7k/09D7GuWQI3J8HVlTr097pgIi",
+	"N+geaxMixz+aDQJDKLRFk2xrJ0QU0z6eVpeF51ARDJXfnYyPjynRctIE8cLWUgA9Y1ln6CbSQQ9LlXi+",
+	"/nAd/yCOlWmsA4xKGx+GTbev0SDQX4pKNxMyWZxTlbCmC6gHUazIaWzj9JFTUwXoNxyrg/duQFJ5+/Z1",
+	"51KmJVFkXmfux8cPh2gs06wNO/3cOtC8FgvpEpPqkQku1o8e/FiBPG7dlN3ZR+cbKDK0U/phwxz55lwk",
+	"AEXatY63pbeqPsC339wbnEE/cQSeRtil8ynidcpZAU0/RP4pueyLprykuIIoIc5t42AsDLVdKXWZj9f5",
+	"aACAb2y9zTBEcQhYTbayBbVWIM6S9VzoRk6MxQuuv+/FBBQpEnigXC2KOc85QMY6DrVjayoyriQf1XIt",
+	"YtQkR8bOpalq1aVaVKvDevq5kuvnFMnKQ3P2YSxp0BWqeZUpWAFNUPh1B3i2Hrxfea7fRpT2JB3Qzsqg",
+	"+bmwn/RwX3BUfXt3EJaFHjL2QQCEAO4dZ1nv2U38Znn+pF0NhyccvgkMZdi5DVxf4ourua2VcBS9kW3o",
+	"vnfRN2DCyeDOini2fABpCxnKZIMP3eHe3MM+KNw/m/tjjg783SrgCBP4iQ8TRD90CuN6ikGYDehsV8rJ",
+	"mbphVhTOeBGkG1CJ8Oee2zNmHVADIFzgrXzVB63uV3Ea0Rx9t1D0WHagOowZW+y74DKKkXcDMkQ0ky2z",
+	"4DzSMlku3mUTj3onqziZFhkz2/VQ3FMKtxlwB9pV9mCPmRuHyYGRb3UIFKxj9QMUlk4BMiWlu02xyOt1",
+	"5AnyCxVkJYNMWh3ZTrOBAWh6po3wlNFRyto6HZsDXcHaUS22jSlVTGYgzEsh28GydpVZTjAToQ0c3Ehu",
+	"RrN4m4mxkXZRzq0u1ZDTghd4cK6Mvzp+8v7x+uRRufr54WgjL+aXw2LmXwzW8c+sgbaWY3H+khwLpfTq",
+	"SBuvDJzylXpOtoPYBoFDKTHF0OkrLknSRrtP1mTbPiqlBxhpSgNxallLzJHBKGnWZSvp58qPb9TMis78",
+	"Ao780JTNxRNvVk+nn72blBMCI6FEh+XdJFP1c3f1JMyeXuuTZ+495zDFCwIXYOBi5OnKGyf0rVFCmdAK",
+	"4AMZx4LMvDFwwKLyCYaAdbRgtfmicI6gTgrUPDMbZ0xHTOpqQXp2tH225IaDLWUnGbMbC5UlYbLuu8mp",
+	"ymDdMLdMgW9teC/PGWS379xgrO9wdFyqa3QQ07l1KNm+2A6KqlRZ3Y4tgR5bQzraWPUDc+BTDbrRLVts",
+	"tFbynabfzsu/Uop2NCSmIgqbIIuvfJEyEn6VmJW7BmljIFY7SMEXphuKzevOuHBOTHbTmm9zVIpaVGab",
+	"YCQd8ZH8lW8Q6FQV6rm1CmrL1N9ma9yAzHd+6HqQr3sj7ZYCYKUB/7DQA6Ebd+jAFz28qUTenBVhHJN7",
+	"NGy99Jlrd39O3pYxWqBU2oPMM8CjX/ITlinYsNeCY3M5N2rvt6zuuvRbdQh0pIenbWd4hPgxgLt5QvIm",
+	"lNRUG+VjaN/20k4FFoCW2mgsBUDyDnkKfCgERzaJSDVIYoyZqFnS7obQWLqmxGJP1pGhIhqn2gTYmEoL",
+	"T6KbKKvHLYVXcoE9DuOi02W/V8fQ28jjt3fqKMSlWuKPPqglyWSJk99KMpo/+rn8vFJPTq6uvUfU0Af4",
+	"dRrfYK1RdLdFLanXgbV17gjrxBePxuKCooeHxdZB+WAhr29c9exZqafm8bV9Np/pJe4IyxdpVV0c7E8q",
+	"RstMPdz5fq5f3cnjdtDmVrNHx58/e3ry5Il//xQ3x12eN8/sa2tssEaXdFIGyCFK+FebPa8LMWkWS1Kh",
+	"yFoc5UcL6mqsu0aGxLbOH3we+1mnNiRZGVS89HhTqZUamiewZgm6d0RsEx3j96JmhgUC5baW16mGFW0j",
+	"JWe5fi2bQ+XDTBvIqVmf2A2fiH52PZ9U755emvLphOsGq7JxOqwv4Li5LwuWeIfuArvy2FO+JF7s199e",
+	"vBUPKD3Ok9mJPiNJnvKTWb3o8h84L2rQ2+vam+dk4hyU6U3BVJypDLc1NqgQ37bVa3Eeyh6HD2rtQ+tm",
+	"4CkkFU5pu/8+j1tLLJKtxeln63DVtglbu4mnivrtkXDJ/A/FqJ0LbcL4ry8jBvzph7ejgQrcP7wlbYV6",
+	"ZWCMG6UmL5VbiLKWesFOzQ0DPAkr/DdWWcks8TOVahKeAtIRa2Hh7XTldFARpMkwD9+wUEdvUHUKFf+B",
+	"dvn0JPqvcfgCTfQTe937F7+cZ/TwG+S1jv/iSvT0Pq4Vc7Xx6VicZSZ+WCRsNn5Jf/M8jKH8KP6rNyim",
+	"ssaRk2suZrJKLjwQW6sKp95R0gW8IR4fnxDHt0ZxhCW9WcvyEqULOKKeTs0ijo9DPEpF2VAwQ0RpEWoe",
+	"wpJuL5iFYu0YSSmuaoG+2NG7uTJu/dn/P4N/j0u7aHH0T9KpSvwRno+KUeNqxGPj1kaFlXWXHl8frGyz",
+	"t/w214PCjeoFthOphDJX2lkMYegKNxJbUQTl4Oijh1iKK54FrXlDRah8s0TkyCtWRx6Ocni3cUgRERvG",
+	"YZkpq6CZFW6BBaFAG98kBI8GPthhXgALNtN4NExVINLBcnyxtcYnjA5JgiSHZxVL1PWyjgnqvWYppO9H",
+	"iGyIoq1Nc2uVlNT9Or9kY/EHFYQP0iXMtY2LFdSVmUtTKqp225szhzl+V62NXOgyyqVFthLAS2c5toOb",
+	"xQyczxhCRV4hL0fvPTJw41doKcU5toataEO8diBg5VRQtIoAz+8ef28B7wJfLfoxKkUqLsvm4Ql1x+61",
+	"hcid+BiiL6nmOYJRmop4Ir/h25CDLOCABuE4FTvtRqSMMr6/5w6OMkFrdDI+Hh/HVB651FBkCX/CYMk5",
+	"MvwHY+j6fIRVNx+8W136cSw6NZis/rqZ1NrPOepnCf8qiT/Cvz2Uo3NxW8rHdk50tbG1Ua+phPgET/fJ",
+	"ydNPC4GVViQ3wsXBUHDolUfjMVEW8E3qKER2/FTSBCuDAhIJvq5cq5tNfZc61UAQL6P+BN85G2IZf9hP",
+	"4FzxieUIKVtXHfskZ/tnZR5Gp6M/qPCn1SXlrWVN7x4eH2+TuNN7Dzo9RHIpbXT6l5+KEQdUxKOIwCcI",
+	"yO52Mdt+5jHOtXPnRj/BwFFk23rYXyXRopWCsl4wRSuJGeWZGilWECNOLLyqsUShA3hdKZcE84I7AOF5",
+	"nL/0g2A84y54t4Fkv8Xfh2L0+Phk/3dtrxn84tENv3hywy+6R/xLR1DEnktJaBn99KGDAXA+6Wjyw25Z",
+	"Dx02qokDB0z9qnxeVOJw6Z0DQCJRpfhQlkH3ietv2wJHiARJWUPXmPZYC+N5TlMulVoCAYlFeGLhGx02",
+	"8Ya2dRa7Vbluy83hg4mvaOUf8PeIPvjVhw30OwCNhpuBIUod3xgJP1a0Rem6j7cEmQzrduFuRqge/HKp",
+	"1ufVB0JkNCAPpazbyw5KZ8W8krQefUBGWNJCfZBrChDFmOsYD4vtyVK6LOJxy6ayogTKBKdJdUWvE7As",
+	"H3RdgzUhOFnGcHmo9HZlL6MDcya1SZ09jA1zzrvu4jPtKcPn21HCX5sQPj5+/NvFQYLpgThYjFLGpseJ",
+	"tlkds/o/aCEAMavVvRB7R7lRm+w826vB/4TYD3rpg0pdb2XUf1AG8AU1r80GatHg+er7s6OXby+4tRCa",
+	"oYDeRhEf7F1HFgIclfB2GlbScdXfbqh3VAU+efnni0KcvzxBPnH+8vGn+AfX1plzz3wYnfOb2rKoaYjv",
+	"z04+Ldogvk9enD0sxIuzR/AfHi/muYhPXp49/JRjoeKA9C2lM/PY0SDYTJSHAU+efJrqRZLJkNrmpASM",
+	"T16fndAr3bV+8vrs4adj8SL++8cRZRVqo4OWNffe/HEkyl5w1aaPnTbSHwhTEzEf8cdR3znv+ymMWX0Z",
+	"DHEci+9h1yRY7c3Q6ueCF2ydqFSJXRWWVpvQWshbpwXnW+EssRUOnPXzthhIJ/hd84piRls0nOARjcWF",
+	"mlEZBe5D+eKN+OpLnO4Pnz/JUOfFmxdHJ58NBS14xYFjF29FsxTBwpeDomPsUngrirnR4vDj5MLJTAZE",
+	"rGvd7T3tEMdX11jBCIlJRkj2smkkVGhBO0CnkMslIjWazNvai6SYd0o19npQUs0ZrKOPQcnApE+FrCo0",
+	"QOTFZymGOBqbUGTIiuvqBewSfkjmSjBf67qOX2D9xCjgtneHi0HEKOOitWm1JXSjWM4ewFpJVDIp4Tr2",
+	"JSRBOP4rWWQmvX4R3TqwwVqs74fPjvjjsSATCr0lqHfhql/jrqCrQ/IQ5wN3zRfnL+nfWcgPVKPElJkQ",
+	"i9NlAhIVXOxXsqR9xZggpAdzqY2qTsmwAedXSuciS8mrWKYoIGu45iUGvqwMvcUFoaBUHkr/rTgm4+bb",
+	"KnhxuVlntJRtOXFKXqZ2X2BY/2EOzHJhncqqq0NIXtHJiUgrl6Ktv43l8LsVt2NNprbc9qCCmzq1j/ZI",
+	"GV+zb7lNFIyrDNHbVYiT42PRGOSXSK6TJIKFKFpRBHv5dJp/dIpy7CvJ8ctgo98EjP5Rwu4L7s4YtGkU",
+	"oQkrb0PrIxjubF/3060k4c3G+P8qGlnXu7GVIXRe2TQ39Crl7mcJ0XEFWxm2QnB/hIqEkMxSxc3IvbjS",
+	"ku1EqaIFeqyk9yvrqrH4btlppr7hAkjGQuquHlXCTmf1vHs69SjXZlfzdJ3a0nNrh1s3RN/S4J8vCXf2",
+	"mDUOw5qB2xXi5AlW/QIQof9hKps6MK+xi+hMkqK2ZqbcUY0Btd3m/kgmu1341XXURXv9+KMY53k3JN4T",
+	"9EmLbfvlwbDoOyidqqiFGVsmET41lvl2XOeYkpqXllvbU6mKI4/9TSHPX5xPe4eJrBHYKnjbWpdBa1XM",
+	"+rlpU1rnVBk6a8EWrgBxqm4AeEGQk6LKo6XETGFcObjksmb5OePqulzho9/7GCYmdMdRi36ObrUSX9uV",
+	"qIC1TRuHUnSsxFIk7tFB+OlgRQ/hFTCOoOr1WJwxzkzVSsTKHaz3xEmsUaKyzaTmTaCRY0uDqKxaS9Hy",
+	"d+pp1VmedWk5nXonuOYWW8cituLi8vVpWgou7np+Hj98xgeTt8Miu31bS7+cK/Ll5ViJBdlagkByFypb",
+	"tOkEGzvtbCSljGHUoJ4GvERUXhfX9PLVV6/evhIPkgz3o9ng60BQsQ7KbayP8PGb7KMPt+NzYY5E7LfP",
+	"5h4/fLb/i6E+brucI2cZF2Gvo6norgsKttjtGWG2xWUmhvlWNDWGPv3OBWq4P02YW6d/Zqxumwx16bE2",
+	"bdEvPY1WR1xyW0NPLmg7bEdUVe762QhGYDs8+sKIqYy34SqVsrkxstKXt8fTfhf1XwVLPwwYAjPPIlIE",
+	"7YVX3sea1ftwhY9yO7KcR/EDuGqOLbky1eEmMWq0H8gTeRUM1MGgqHVtMHnAo8jaq8wlg7oTKmRA7FAR",
+	"j8KQhfRrcRZlkarI3Tho8Vlpj8GpGA/Tw+SdcTEY05CSNLgLMXFdsnnlcTCbuPqGIX0LZOVPkS7+C5DW",
+	"HeTxFWMDKs350XXlvn2oz4Fce4w9c7sigaFf+chOWTLpVFzvpHSjSbAtzc72HyagmvNbBxXrtnD5zY+Y",
+	"v/24jX95zN2Qttd73sGP77VadQ5in57Hgz1Ai9kOVU9eKr+JB81GsVJKYCV/XAc9PGa0Itqgt4ySCA1W",
+	"oXbxi7F4K6nNcxPIoJNKsnQxa4hUPSM6ml4kiTeZAjNdYxPvXsFbOebdkELl39+eQg2i729S9Dt+9pu4",
+	"ItFDuPWODLoQ8bBudUvAxrz9kpxV1eYdCbYzU7J6chuFrJEFaD6t8yZj7OS/CVb4lQ5l1qQoDYou6uc5",
+	"fw/JRrLLnQRX8vHxs87dGLpE0ba+8w59qev6Dlco+/zfN+g3foPgrG50gaLSvSsC5EvrZrmW39pbkuTJ",
+	"JZenQvZNKWS8hmi/ArV/Nitol4wA1qVCreIsVj1muRa793OJK5Ka4QI/Pn6cYzxzLKXRGDOX1WaR3V44",
+	"EziPemVVd3oKvuMtspN305c0tW5mQ1CmGExvGjLIZ8l3203yxZ6mTXvXs212vbx/V8A/RPX8NeJodt/Y",
+	"LCVi6L52H3fDtgAPhWRbWp1wcUfYzB4XcEQqz07SHFnU7WpUF9xfL7dAxhZOGzpCfqluF2PaGeHX1RcO",
+	"OeZtsn/n6aaTp3PCfh9R5vScA1z+G02SMh2w0x6p6/AfDu/gtgq3O7f09cet4+V5UkPn3Hs+7M7rQP7Q",
+	"035Qxl5YW0KL4bHvGLLyOmNBuhCtYejoJ+4Yrfj7umltLJuzkg9qGpUiKdoQq+Sp5tiqL7vBYmSsxXWA",
+	"EE1DUzY3x4HihmKOT7feFUUPYFq08Lbu/Dv1Dkk24VgBZKCPC8oNbXjLcyqe6i3X0OJVUsemTiumVhRv",
+	"yxOkkuMED1MiDUVXHqNFp58QLRon2owli2uP3Xdg0HKwN9FYvOggG/kF2R3os6QNPlwYmbIGom4D/sBY",
+	"bxXeAXfnklruxCg4JQ26tDiellpjJ49jT095LmRaa2yctL2ga9wzNU55fHw8JK1Zr17K2wWe87e3V1wi",
+	"Xfu35rKPdHaSSodoZ/+FniwUy0x2qduh1PMX+oOD2QfZ5hvE0C2Mc6ClIDUXgGC6lEKd2sdtdJfbyVJH",
+	"94d5//xR5ndhwX9QoX+K9xKGvtFqciAYPSLgznj0/f3fMEJ9mbcVGERmiijluPCD2nukouyeW1/VbWGw",
+	"vPY9S49W18WWlgVFVmmfmXbWwoD8X1A8H+t+MN8lJpRVIKDs7zZC2iF/6XSF5+3ANHmdNGK/lS0bzMrl",
+	"EhOq0rHXN8WhRu5bpCAb2D9WrZC+rUDRKT0ROVxy7XU54FBowh9UyBsd7ESol3HNhAZxnwrPshBYGJ6D",
+	"DddyUYu2uLeqtmnyNFRHm4/Vo2C8UTGCoQa67dxOyU97/VcL9+tWYBjkb/03hkLA8b5m+LKLNjWDTAzK",
+	"MyqfX5Dh+54ud9s4pG2GkbcaaS83GbTT8orUg9868V9nX38Vg6LhMzFRFBgXSzyhlYAKTu7u/lBkFYIK",
+	"DoeGb/u1nmJLfpjt9z7RqOfZqok85DukVbEdHhbm0euevZG+ayeiXMhWB2l7UlIgdA0Hus7SKYgKUrrE",
+	"wBJpV7WVlc+cAgC05AnoEMch4pdOAZ9ywDVrZemR9ik3vGqDxtdJTE/Nz6uG0voVMwDHZ+GLDDSIFo3B",
+	"1PWMQRBMqFMK5XX3tjsYK3M8xpx6H+lzKuWO2FC2lU5lnQLTY58XgAlWO5CVLpnJxS+2OxjxtHkteEmq",
+	"WNZZBrvQpYhlS0wVdVGYjUFbUHPNIlMhscYAsrqk1rmq5Qqx3Ofb2OdDVd2jaQ3UsUMNcJ8hJvK6OZyJ",
+	"xLpLPSTxSefeSP/FNLoB1EE/VmKWepo6X4BYm6CRIv0jU0Qtv2hRWTw+eSheo9JbETPnqMyeV2qwxE/k",
+	"w/vN0TdU/RI8b6/7fUS87ubK3+OTh/9QfpqVNxpipt3HHU56vuBkqoP4KIrTsYzmdhcxMCrf1omOX8QO",
+	"z8CgDNGLyZrr8uS9YX7P1TI+sS5RqvOXn2aBNbEdDbcWFamXIw7Kleq88ECZZM3EjBL+K+2Xyvi8gvO0",
+	"qWvlQ8akKDt6Lj3R6+fMwc5TDbaYbYhFB2GP0DMCB5TY1UdPdYmfMKVoW/IsnS2V99H9pqdZIDCXeFE1",
+	"UWTcTL5kNDDl7XiIj/gcclTqMcs1zWmnNt0GKuIcG2uje7DT5IYnoED2h0OB7EtnF8tUXwHOGsAgq3dN",
+	"bBbTO6Pztgi30GRsRAeINpkxkyZ8tjlhXu/KyAXMG52UzOiMdamLmUUU0Rhkj4HzWNvchAGjWVxPZE2m",
+	"GOKKx2PBNYDZG0SwBf6Yd030qW0ilYdo61e24Gi/xgqT0coICEd14VjxakFN3BzfzjGJCkYD7sU2aGPx",
+	"grukU1+iNUYctbU6ObmsF71xSpbNmRXa9AI5il5l7C0XqDcg3DQOjWuLQMF71igvEEHiWrKr22/vhIpk",
+	"NnWYq5aS4BFNG59O6OFD8eOIjoLfB4b84yjmcSRbq62rsXjN43TLitc6W1k3risW26RkbxRIlbzqdXhp",
+	"TLBNOY+SJYl6GP9fpHriqrzkdM/SUSGsOaaaUoKP12ZWJxGrTXPke+uDdXKGqopyGB9bWpNMfGlPQKPI",
+	"Lu1VXVOIGWsqgPVZTnakUHmKUyyO1jOHgJHcaexQZKdxsk6d2XTURMCouhmjK1M9zHsl5C6VvmqtA4Ny",
+	"nPXhdV6z+aZCC38LkvMd5JZslF9fdHn4G7RQ3kI8eviPFY+6xSMHzQ39N3rFrOgpFTZDstezD2RCE7BC",
+	"FpXYuvoAqxFstT6ezWZOzdobl+5xX0caKN2AxR0oyZza5qQOQ0iNQc1uXAF54MATodvWWLzOvFvAN96+",
+	"ILuBUpec5S2sEV9bU8l1x9kYh432eiC8uLVhF2TfqThz1vvkA0wGFeo/Q6SKmzNIzKvOPIeL3f2VMN8E",
+	"R9We10EG5rwJsI2CAqwIQbqyeWEIbEjIlhaHhtexOPPixcX3+fZaswXq/rlRJvkgfSxgwYDSnsytaLrg",
+	"jIooL3QrUw86Pro9mnaqs52GRgkQ3IMKCbSHKCpxAeACrPApx80elJnN3aUGbPA7q/9vqN2m2rZKdZ1W",
+	"+Y1dHbSoYO9hSdQKv+sc94zeWF/E2WbJAiJcp0MWlvpvtYs7vMna5hK/pWJkyfSZOm9xUTLSlADHty0o",
+	"9YK6QZTcFju7U5t29tJf3ZOZvfRX92ZlzztZ/YvZ2e/i/iOIMZJNNsj/PjNBbLGx1UrwykhM8t2wtHdK",
+	"01L5iGWtjPbzDaty0rQB58UXa/4lVUXxVCs/MZtkpEZVlflSWxRWe8E9IzAB28G66nVSXdGK17Hg+jRL",
+	"x2BNfqiS042NDVyQd9qEBi2qMUoG84BjTweyo28Y7lkdmqhePA4lI/vWZLtRAiRbG0QyxVLvEu3oYPl8",
+	"jt48IbvWTlnhQ6yllDn25lHf4WX0deSTh2jMsAtljRKq9ioZOdNR9F2ApHpMlUS4bDSa0Fkzi0rD+rmM",
+	"CwTVMDNvdY3UayKVFfYyaD9liQk+TLwVFBo2dpTrIeXjDW2S+1r1mO7Q7WxfeXA+RaCNbmVq5YnvnGOd",
+	"xvlnCyD+GO2zndL0w4S4+0KPEuNDll/3EV54Z1+4aybQp3g5kh6yRqXnL6NfDd1N7J7z0ZaAb3TkaiZO",
+	"2vQNr7F2JSXuDsq4uOhbcXdbSW7193FHy+YtD4ZQpPd8I1QnGTP5KIdV0jzW9IZl0KLKGaNa2vaVqS1y",
+	"3jWPmi5QmEqkv1TkzJpuBPVQybToHc/WmypKYeCYjpYzVRWtCToVFdNsBE/NU8hIhXtKqteVra+y6NbE",
+	"crOeLgPVw7JKXDk0qa//37Ma19v87PZogqgrUHeRfI1RWej0XhoS0Tud2A7SXoa78n0obriy2B9waFHc",
+	"Eu5G6sue6f5eWtOWaamsDJeRxlIZW+aMHfHuPmWyIklMD0Mkpvnxnm3bs6ZG8XdVq/ctKpXY2bMerBVy",
+	"D+vZrFPXWVdWrO7JnWvVPfknLlWX06J/Na16oyXQELseemk4w2XTrLtPxCMlNfUeHYxt69WtI5f8pm6d",
+	"O2xhPFaskVlOZalrHahaWa+XisBB1EyDcUBSURZRqQU2FCmtj244qogAzmLLehfs9IqLy32x4f7vKeyp",
+	"yS5rrbmzNflamdOvfVALVuA7LvG2Q6A0oV5jbVVu6wOVOlkrT3Z3DoltXbdGrRDWsZmg77trNcb5sWcs",
+	"RfNmgXBsIGBj9HMsbNL2bkqe7GHH84ViYOTBSgiotW1IX9+mqhMwhjX1NEonFGks8GYvJa6UPihTay5q",
+	"SpdLeT4Gcy0WylAB2KS4i+AAt1D6yjTxIXX7uwyjf1V1O5v49rp2NkhLo/5JdO1/sOacdXEb9Nt1Hnfo",
+	"Kx3KEGXbS169cocoR/jeZlffTIWOKcQQPcL1KOfY/H2g48ygyP8dLuVWOAlfftwa8Z2yhBuG3O3bzrQa",
+	"ZdujeSO8m481a+PRJgxKMSndehnwzDlbUFVeyFiY4XMgok6WQMaQ68Q279QEcaPqmnbUuFmcJcziOisc",
+	"DVQIPTOWjKComXMm4LNtbWi4H/Etm9DA17dvQQNf/zsT8O9YEYEb2SCuHETzHvwS0erDAy57h/LlQeU8",
+	"OkUAtxbu6KZ55U2zD287su3mcjfxdHWzVjhUa8hYLjmcWrxyczauY8nKqA9yza00DyhRybOmm3QbQv2v",
+	"lpZ4e6RmaN8Wq5X52JA6ukn75Z83kJsZFNW73qz9Zv6NpL8akr4yd8HRKFP8RrF0byJdXH9b0YoKhqem",
+	"ozHxe82llbDz2Nuo7cevd8lKQ+5aEpajnH27CqxehTjAHbTBj0Su+VhvFx7TFky78WUDefojumh/wOhK",
+	"GYuGUyUQ0gnOAyfOpXKMrEpQdH/sTEVqBDqaqGX7xl26oJv0xta3Cru+UAE+/ff9+c3qBW0+Razefdjl",
+	"YQ/0rvKGnPoV/ZmY8CjrfrQW5uikxn0po5mNv9gYH9oiwCiV9uzraHMNKJfUupk0+udOg/GxeMndt1OU",
+	"ImVc8pSdbJSpBnN03hK8wYwnp2Kv+KoQKDDCF2o6VWC5jgFoNXRVzxqa38lOG/3iN7TUdjy5O8OnjGiW",
+	"R8EeVdTatErRT2Ej/q4F5oCd9iWeO7f2/nUttTwpRKTcOTjqn7a64secikq4hSFH4sxUgkoMiRgadNNC",
+	"jm9UcFoRu4QtOTVXxsMFRnc0kKS6Ttgfu+4lCgFUB7vCUbhTETNPnS6VL2KsiFbcQ485sarVlTSB+/Kz",
+	"KwldsqK9B1BpOHAWA8+LNmOBmQ7UOwoJtLPNDK2Ni9PUMkkvdChyH3G3eGsKTcGBKSYFh9ReeLjubdBJ",
+	"sMnK2MadYFcFLLGQ6AGZEB8XYoIBqrz4mN6OM5AbKFVnAADHAB58KYbB6BC9YCGGo+YNRwjZOPoUpl0p",
+	"eRmLMmRhMpT60eY2yuRtRN0CzerS8NyVVbEjljYUNIDhSLra8IfCSsiorw12H4UFHi2oWrtTM4kRwZ0m",
+	"jqmFI/pRMUGUPJ4pQW7Qrr+Vgm6LnqDjpLQSSneVCxWR1HP8hLoOh9fMvXm93P5qOGU2qWi4CPLNFsNd",
+	"X1PRvy1rWmgTfYA7yjylkIrjQ0Iqtq96Ye9n0fL63hf9IqtlHoGC6BknKyDftxugIi7y/Q1VCoylUTga",
+	"E/Xw1vW8M8wljtHZ5FKGoBy8/T9/OTv6759+efThdzcLCtpAa8zC6iAUNeeQtXUQMrAdcV7wK0M4fQP0",
+	"2FzLwt5sKfL6/peiB6FiG1PuhMm3+MIwYmIwQ3taFBB1q2Ut7E1WJa//LqvS5q8chAopekiS8/rnFNFS",
+	"29XOt4DDGZs15QB2N01pYdrB/ftPDhNFOu9tXf0V6qht2S7ONpjpFBc8KkZpWfAiD7iZ/lSMro9m9mjv",
+	"7foBzcK2lSkYQpP1qdBVIai+PKdaRmwu+NhgT+/TBpdOTfV1VEeOKDyO8jBgXJwh0p2YwtL2PMfiUXlT",
+	"nNh/O/OQs843FrrqUrNt8KQsyEEadPT/faKrv8GLf8Pd/S1u7m+0t7/FjX36u9uFChIcU4xgFHFQBIpC",
+	"ZEce+5ia3WZlCeUCLoy7c1DhP1DD47G+JiH23+akv3f2Qd+KgFflNuEX2F+GSm+njEMqd+doWzhNrrYV",
+	"FN1IUng/vlFdL/MC0Iqi+y3Ug9Fm5jeMKGyi9VGD45C3tk5dLLdHVDRbO7ADPdMGA0DWhTBNcJpjHjPF",
+	"g7PbjQ5a1mxLagmuBP803cS8OTwnz6VB0NqbwjPZ76JNcLZqKJDUTjl2En/xWXbccN/fBBHh1EJqg5kf",
+	"MvWa5+w7LmwiFynVxFC1pXU35ec0r08OYlTskn7+soXg2YmwTnzxKFYEmXK5KDmYmMQJ9hzSef4yqxyT",
+	"ZjEWayYiDYbzqVK0ezw9KpCYF4vqf1cph+2I0ViuAw2EeZqpmh52naUqhtp0FjlYng8GiaX5noM9j6Ry",
+	"HC9hmVOknqOxHrsakeqejd6pEtfvZ3RWcTnBGEHbXylVC8IfvOWgoFTpj2ZEc2RWyZHqDnUkHrZCpFcm",
+	"KqyUMuI4Kiftq/lij3uLpT6icYnGBiE54IIvdD8rtj092T0I02aVxkOAt/hUqTexU7jfYxGsHQ+WmflG",
+	"rW7j6vhGrQ7mWSf/VFbJZx+pjfGsqsQ3akWFXABR+PxQht3p/9i5op8+/PTh/wwAos7pITYXAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,2727 @@
+// Package v2 provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by unknown module path version unknown version DO NOT EDIT.
+package v2
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
+)
+
+const (
+	ApiKeyAuthScopes = "ApiKeyAuth.Scopes"
+	BearerAuthScopes = "BearerAuth.Scopes"
+)
+
+// Denomination A number of coins or bills of one value, in the minor unit of the cash box currency: value 25 is a quarter in USD.
+type Denomination struct {
+	Count int   `json:"count"`
+	Value int64 `json:"value"`
+}
+
+// FieldError A field of the request that failed validation.
+type FieldError struct {
+	// Field The query, path or header parameter by name, or the field of the body by its JSON path such as slot.price.amount.
+	Field string `json:"field"`
+
+	// Message What is wrong with the field.
+	Message string `json:"message"`
+}
+
+// Money An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+type Money struct {
+	// Amount Amount in the minor unit of the currency, such as cents.
+	Amount int64 `json:"amount"`
+
+	// Currency ISO 4217 currency code.
+	Currency string `json:"currency"`
+}
+
+// Problem An error, reported as RFC 7807 problem details with the application/problem+json media type. type identifies the kind of problem:
+//
+// - about:blank: the status code says it all, and title is its reason phrase.
+// - /problems/validation: the request does not match the spec; errors lists what is wrong with each field.
+// - /problems/currency-mismatch: the payment is in another currency than the price.
+// - /problems/insufficient-funds: the payment does not cover the price.
+// - /problems/sold-out: no slot holding the soda has any left.
+// - /problems/exact-change-only: the cash box cannot give the change.
+type Problem struct {
+	// Detail What went wrong with this request.
+	Detail string `json:"detail"`
+
+	// Errors The fields of the request that failed validation.
+	Errors *[]FieldError `json:"errors,omitempty"`
+
+	// Instance Path of the request the problem occurred on.
+	Instance *string `json:"instance,omitempty"`
+
+	// Status HTTP status code of the response.
+	Status int `json:"status"`
+
+	// Title Short summary of the kind of problem, the same for every occurrence.
+	Title string `json:"title"`
+
+	// Type URI reference identifying the kind of problem.
+	Type string `json:"type"`
+}
+
+// Purchase How a purchase is paid: paid for a cashless payment, or inserted for the coins and bills put in the machine, in the currency of the cash box. When both are sent they must agree.
+type Purchase struct {
+	Inserted *[]Denomination `json:"inserted,omitempty"`
+
+	// Paid An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	Paid *Money `json:"paid,omitempty"`
+}
+
+// Restock defines model for Restock.
+type Restock struct {
+	// Quantity Sodas loaded into the slot.
+	Quantity int `json:"quantity"`
+}
+
+// Slot A slot of the machine, holding a soda of the catalog at a price.
+type Slot struct {
+	// Id ID of the slot, usually its position in the machine such as A1 or B3.
+	Id string `json:"id"`
+
+	// MaxQuantity Sodas the slot can hold.
+	MaxQuantity int `json:"maxQuantity"`
+
+	// Price An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	Price Money `json:"price"`
+
+	// Quantity Sodas in the slot.
+	Quantity int `json:"quantity"`
+
+	// Soda A soda of the catalog. When the ID is omitted it is derived from the name by lower-casing it and replacing spaces with dashes.
+	Soda Soda `json:"soda"`
+
+	// Version Monotonically increasing version of the slot, bumped by every change to it, also returned as the ETag.
+	Version int64 `json:"version"`
+}
+
+// SlotBody What a slot holds, at which price, and how much of it.
+type SlotBody struct {
+	MaxQuantity int `json:"maxQuantity"`
+
+	// Price An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	Price    Money `json:"price"`
+	Quantity int   `json:"quantity"`
+
+	// Soda A soda of the catalog. When the ID is omitted it is derived from the name by lower-casing it and replacing spaces with dashes.
+	Soda Soda `json:"soda"`
+}
+
+// SlotPatch defines model for SlotPatch.
+type SlotPatch struct {
+	// Price An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	Price Money `json:"price"`
+}
+
+// Soda A soda of the catalog. When the ID is omitted it is derived from the name by lower-casing it and replacing spaces with dashes.
+type Soda struct {
+	Calories    *int    `json:"calories,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// Id Catalog ID of the soda, such as cola, case-insensitive.
+	Id          *string  `json:"id,omitempty"`
+	Name        *string  `json:"name,omitempty"`
+	OriginStory *string  `json:"originStory,omitempty"`
+	Ounces      *float32 `json:"ounces,omitempty"`
+}
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// SlotId defines model for SlotId.
+type SlotId = string
+
+// ErrorResponse An error, reported as RFC 7807 problem details with the application/problem+json media type. type identifies the kind of problem:
+//
+// - about:blank: the status code says it all, and title is its reason phrase.
+// - /problems/validation: the request does not match the spec; errors lists what is wrong with each field.
+// - /problems/currency-mismatch: the payment is in another currency than the price.
+// - /problems/insufficient-funds: the payment does not cover the price.
+// - /problems/sold-out: no slot holding the soda has any left.
+// - /problems/exact-change-only: the cash box cannot give the change.
+type ErrorResponse = Problem
+
+// PurchaseResponse defines model for PurchaseResponse.
+type PurchaseResponse struct {
+	// Change An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	Change Money `json:"change"`
+
+	// ChangeDenominations The coins and bills the change was given in, for purchases paid with inserted cash.
+	ChangeDenominations *[]Denomination `json:"changeDenominations,omitempty"`
+
+	// Paid An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	Paid Money `json:"paid"`
+
+	// Price An exact amount of money, counted in the minor unit of its currency: 150 with currency USD is $1.50, and 150 with currency JPY is ¥150.
+	Price  Money  `json:"price"`
+	SlotId string `json:"slotId"`
+
+	// Soda A soda of the catalog. When the ID is omitted it is derived from the name by lower-casing it and replacing spaces with dashes.
+	Soda Soda `json:"soda"`
+}
+
+// RestockResponse defines model for RestockResponse.
+type RestockResponse struct {
+	// Leftover Sodas that did not fit in the slot.
+	Leftover    int `json:"leftover"`
+	OldQuantity int `json:"oldQuantity"`
+
+	// Slot A slot of the machine, holding a soda of the catalog at a price.
+	Slot Slot `json:"slot"`
+}
+
+// SlotResponse A slot of the machine, holding a soda of the catalog at a price.
+type SlotResponse = Slot
+
+// SlotsResponse defines model for SlotsResponse.
+type SlotsResponse struct {
+	// NextCursor Pass as cursor to get the next page. Absent on the last page.
+	NextCursor *string `json:"nextCursor,omitempty"`
+	Slots      []Slot  `json:"slots"`
+}
+
+// SodaResponse A soda of the catalog. When the ID is omitted it is derived from the name by lower-casing it and replacing spaces with dashes.
+type SodaResponse = Soda
+
+// SodasResponse defines model for SodasResponse.
+type SodasResponse struct {
+	Sodas []Soda `json:"sodas"`
+}
+
+// PurchaseBody How a purchase is paid: paid for a cashless payment, or inserted for the coins and bills put in the machine, in the currency of the cash box. When both are sent they must agree.
+type PurchaseBody = Purchase
+
+// RestockBody defines model for RestockBody.
+type RestockBody = Restock
+
+// SlotPatchBody defines model for SlotPatchBody.
+type SlotPatchBody = SlotPatch
+
+// ListSlotsParams defines parameters for ListSlots.
+type ListSlotsParams struct {
+	// Name Only list slots whose soda name contains this text, case-insensitive.
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	// MinPrice Only list slots priced at least this amount, in the minor unit of currency.
+	MinPrice *int64 `form:"minPrice,omitempty" json:"minPrice,omitempty"`
+
+	// MaxPrice Only list slots priced at most this amount, in the minor unit of currency.
+	MaxPrice *int64 `form:"maxPrice,omitempty" json:"maxPrice,omitempty"`
+
+	// Currency Currency of minPrice and maxPrice, USD unless given. Slots priced in another currency are not listed when either is given.
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
+
+	// MinCalories Only list slots whose soda has at least this many calories.
+	MinCalories *int `form:"minCalories,omitempty" json:"minCalories,omitempty"`
+
+	// MaxCalories Only list slots whose soda has at most this many calories.
+	MaxCalories *int `form:"maxCalories,omitempty" json:"maxCalories,omitempty"`
+
+	// MinOunces Only list slots whose soda is at least this many ounces.
+	MinOunces *float32 `form:"minOunces,omitempty" json:"minOunces,omitempty"`
+
+	// MaxOunces Only list slots whose soda is at most this many ounces.
+	MaxOunces *float32 `form:"maxOunces,omitempty" json:"maxOunces,omitempty"`
+
+	// Stock Only list slots in_stock, holding at least one soda, low_stock, holding at least one but no more than a fifth of their maxQuantity, or sold_out.
+	Stock *string `form:"stock,omitempty" json:"stock,omitempty"`
+
+	// Sort What to order the slots by: id, name, price, calories, ounces or quantity, prefixed with - to reverse the order. Slots without the value come first, and ties are ordered by slot ID. id unless given.
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit Maximum number of slots to return. Every matching slot is returned unless given.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor The nextCursor of the previous page, to continue after it with the same sort.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// DeleteSlotParams defines parameters for DeleteSlot.
+type DeleteSlotParams struct {
+	// IfMatch ETag of the slot as previously returned by the API. The change is only applied if the slot still has this version, otherwise 412 Precondition Failed is returned. Use * to only require that the slot exists.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PatchSlotParams defines parameters for PatchSlot.
+type PatchSlotParams struct {
+	// IfMatch ETag of the slot as previously returned by the API. The change is only applied if the slot still has this version, otherwise 412 Precondition Failed is returned. Use * to only require that the slot exists.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PutSlotParams defines parameters for PutSlot.
+type PutSlotParams struct {
+	// IfMatch ETag of the slot as previously returned by the API. The change is only applied if the slot still has this version, otherwise 412 Precondition Failed is returned. Use * to only require that the slot exists.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// RestockSlotParams defines parameters for RestockSlot.
+type RestockSlotParams struct {
+	// IfMatch ETag of the slot as previously returned by the API. The change is only applied if the slot still has this version, otherwise 412 Precondition Failed is returned. Use * to only require that the slot exists.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PatchSlotJSONRequestBody defines body for PatchSlot for application/json ContentType.
+type PatchSlotJSONRequestBody = SlotPatch
+
+// PutSlotJSONRequestBody defines body for PutSlot for application/json ContentType.
+type PutSlotJSONRequestBody = SlotBody
+
+// PurchaseFromSlotJSONRequestBody defines body for PurchaseFromSlot for application/json ContentType.
+type PurchaseFromSlotJSONRequestBody = Purchase
+
+// RestockSlotJSONRequestBody defines body for RestockSlot for application/json ContentType.
+type RestockSlotJSONRequestBody = Restock
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// ListSlots request
+	ListSlots(ctx context.Context, params *ListSlotsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSlot request
+	DeleteSlot(ctx context.Context, slotId SlotId, params *DeleteSlotParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSlot request
+	GetSlot(ctx context.Context, slotId SlotId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchSlotWithBody request with any body
+	PatchSlotWithBody(ctx context.Context, slotId SlotId, params *PatchSlotParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchSlot(ctx context.Context, slotId SlotId, params *PatchSlotParams, body PatchSlotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutSlotWithBody request with any body
+	PutSlotWithBody(ctx context.Context, slotId SlotId, params *PutSlotParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutSlot(ctx context.Context, slotId SlotId, params *PutSlotParams, body PutSlotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PurchaseFromSlotWithBody request with any body
+	PurchaseFromSlotWithBody(ctx context.Context, slotId SlotId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PurchaseFromSlot(ctx context.Context, slotId SlotId, body PurchaseFromSlotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestockSlotWithBody request with any body
+	RestockSlotWithBody(ctx context.Context, slotId SlotId, params *RestockSlotParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RestockSlot(ctx context.Context, slotId SlotId, params *RestockSlotParams, body RestockSlotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListSodas request
+	ListSodas(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSoda request
+	GetSoda(ctx context.Context, sodaId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) ListSlots(ctx context.Context, params *ListSlotsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSlotsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteSlot(ctx context.Context, slotId SlotId, params *DeleteSlotParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSlotRequest(c.Server, slotId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSlot(ctx context.Context, slotId SlotId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSlotRequest(c.Server, slotId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchSlotWithBody(ctx context.Context, slotId SlotId, params *PatchSlotParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchSlotRequestWithBody(c.Server, slotId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchSlot(ctx context.Context, slotId SlotId, params *PatchSlotParams, body PatchSlotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchSlotRequest(c.Server, slotId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutSlotWithBody(ctx context.Context, slotId SlotId, params *PutSlotParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutSlotRequestWithBody(c.Server, slotId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutSlot(ctx context.Context, slotId SlotId, params *PutSlotParams, body PutSlotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutSlotRequest(c.Server, slotId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PurchaseFromSlotWithBody(ctx context.Context, slotId SlotId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPurchaseFromSlotRequestWithBody(c.Server, slotId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PurchaseFromSlot(ctx context.Context, slotId SlotId, body PurchaseFromSlotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPurchaseFromSlotRequest(c.Server, slotId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestockSlotWithBody(ctx context.Context, slotId SlotId, params *RestockSlotParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestockSlotRequestWithBody(c.Server, slotId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestockSlot(ctx context.Context, slotId SlotId, params *RestockSlotParams, body RestockSlotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestockSlotRequest(c.Server, slotId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListSodas(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSodasRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSoda(ctx context.Context, sodaId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSodaRequest(c.Server, sodaId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewListSlotsRequest generates requests for ListSlots
+func NewListSlotsRequest(server string, params *ListSlotsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/slots")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Name != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinPrice != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "minPrice", runtime.ParamLocationQuery, *params.MinPrice); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxPrice != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxPrice", runtime.ParamLocationQuery, *params.MaxPrice); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Currency != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "currency", runtime.ParamLocationQuery, *params.Currency); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinCalories != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "minCalories", runtime.ParamLocationQuery, *params.MinCalories); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxCalories != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxCalories", runtime.ParamLocationQuery, *params.MaxCalories); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinOunces != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "minOunces", runtime.ParamLocationQuery, *params.MinOunces); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxOunces != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxOunces", runtime.ParamLocationQuery, *params.MaxOunces); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Stock != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "stock", runtime.ParamLocationQuery, *params.Stock); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteSlotRequest generates requests for DeleteSlot
+func NewDeleteSlotRequest(server string, slotId SlotId, params *DeleteSlotParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "slotId", runtime.ParamLocationPath, slotId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/slots/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetSlotRequest generates requests for GetSlot
+func NewGetSlotRequest(server string, slotId SlotId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "slotId", runtime.ParamLocationPath, slotId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/slots/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchSlotRequest calls the generic PatchSlot builder with application/json body
+func NewPatchSlotRequest(server string, slotId SlotId, params *PatchSlotParams, body PatchSlotJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchSlotRequestWithBody(server, slotId, params, "application/json", bodyReader)
+}
+
+// NewPatchSlotRequestWithBody generates requests for PatchSlot with any type of body
+func NewPatchSlotRequestWithBody(server string, slotId SlotId, params *PatchSlotParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "slotId", runtime.ParamLocationPath, slotId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/slots/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPutSlotRequest calls the generic PutSlot builder with application/json body
+func NewPutSlotRequest(server string, slotId SlotId, params *PutSlotParams, body PutSlotJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutSlotRequestWithBody(server, slotId, params, "application/json", bodyReader)
+}
+
+// NewPutSlotRequestWithBody generates requests for PutSlot with any type of body
+func NewPutSlotRequestWithBody(server string, slotId SlotId, params *PutSlotParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "slotId", runtime.ParamLocationPath, slotId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/slots/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPurchaseFromSlotRequest calls the generic PurchaseFromSlot builder with application/json body
+func NewPurchaseFromSlotRequest(server string, slotId SlotId, body PurchaseFromSlotJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPurchaseFromSlotRequestWithBody(server, slotId, "application/json", bodyReader)
+}
+
+// NewPurchaseFromSlotRequestWithBody generates requests for PurchaseFromSlot with any type of body
+func NewPurchaseFromSlotRequestWithBody(server string, slotId SlotId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "slotId", runtime.ParamLocationPath, slotId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/slots/%s/purchases", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRestockSlotRequest calls the generic RestockSlot builder with application/json body
+func NewRestockSlotRequest(server string, slotId SlotId, params *RestockSlotParams, body RestockSlotJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRestockSlotRequestWithBody(server, slotId, params, "application/json", bodyReader)
+}
+
+// NewRestockSlotRequestWithBody generates requests for RestockSlot with any type of body
+func NewRestockSlotRequestWithBody(server string, slotId SlotId, params *RestockSlotParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "slotId", runtime.ParamLocationPath, slotId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/slots/%s/restocks", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewListSodasRequest generates requests for ListSodas
+func NewListSodasRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/sodas")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSodaRequest generates requests for GetSoda
+func NewGetSodaRequest(server string, sodaId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sodaId", runtime.ParamLocationPath, sodaId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/sodas/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListSlotsWithResponse request
+	ListSlotsWithResponse(ctx context.Context, params *ListSlotsParams, reqEditors ...RequestEditorFn) (*ListSlotsResponse, error)
+
+	// DeleteSlotWithResponse request
+	DeleteSlotWithResponse(ctx context.Context, slotId SlotId, params *DeleteSlotParams, reqEditors ...RequestEditorFn) (*DeleteSlotResponse, error)
+
+	// GetSlotWithResponse request
+	GetSlotWithResponse(ctx context.Context, slotId SlotId, reqEditors ...RequestEditorFn) (*GetSlotResponse, error)
+
+	// PatchSlotWithBodyWithResponse request with any body
+	PatchSlotWithBodyWithResponse(ctx context.Context, slotId SlotId, params *PatchSlotParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchSlotResponse, error)
+
+	PatchSlotWithResponse(ctx context.Context, slotId SlotId, params *PatchSlotParams, body PatchSlotJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchSlotResponse, error)
+
+	// PutSlotWithBodyWithResponse request with any body
+	PutSlotWithBodyWithResponse(ctx context.Context, slotId SlotId, params *PutSlotParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutSlotResponse, error)
+
+	PutSlotWithResponse(ctx context.Context, slotId SlotId, params *PutSlotParams, body PutSlotJSONRequestBody, reqEditors ...RequestEditorFn) (*PutSlotResponse, error)
+
+	// PurchaseFromSlotWithBodyWithResponse request with any body
+	PurchaseFromSlotWithBodyWithResponse(ctx context.Context, slotId SlotId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PurchaseFromSlotResponse, error)
+
+	PurchaseFromSlotWithResponse(ctx context.Context, slotId SlotId, body PurchaseFromSlotJSONRequestBody, reqEditors ...RequestEditorFn) (*PurchaseFromSlotResponse, error)
+
+	// RestockSlotWithBodyWithResponse request with any body
+	RestockSlotWithBodyWithResponse(ctx context.Context, slotId SlotId, params *RestockSlotParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RestockSlotResponse, error)
+
+	RestockSlotWithResponse(ctx context.Context, slotId SlotId, params *RestockSlotParams, body RestockSlotJSONRequestBody, reqEditors ...RequestEditorFn) (*RestockSlotResponse, error)
+
+	// ListSodasWithResponse request
+	ListSodasWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSodasResponse, error)
+
+	// GetSodaWithResponse request
+	GetSodaWithResponse(ctx context.Context, sodaId string, reqEditors ...RequestEditorFn) (*GetSodaResponse, error)
+}
+
+type ListSlotsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SlotsResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON503 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSlotsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSlotsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSlotResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON412 *ErrorResponse
+	ApplicationproblemJSON503 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSlotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSlotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSlotResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SlotResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON503 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSlotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSlotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchSlotResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SlotResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON412 *ErrorResponse
+	ApplicationproblemJSON503 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchSlotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchSlotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutSlotResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SlotResponse
+	JSON201                   *SlotResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON409 *ErrorResponse
+	ApplicationproblemJSON412 *ErrorResponse
+	ApplicationproblemJSON503 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutSlotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutSlotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PurchaseFromSlotResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *PurchaseResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON402 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON409 *ErrorResponse
+	ApplicationproblemJSON422 *ErrorResponse
+	ApplicationproblemJSON503 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PurchaseFromSlotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PurchaseFromSlotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestockSlotResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *RestockResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON409 *ErrorResponse
+	ApplicationproblemJSON412 *ErrorResponse
+	ApplicationproblemJSON503 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RestockSlotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestockSlotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSodasResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SodasResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON503 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSodasResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSodasResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSodaResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SodaResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON503 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSodaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSodaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListSlotsWithResponse request returning *ListSlotsResponse
+func (c *ClientWithResponses) ListSlotsWithResponse(ctx context.Context, params *ListSlotsParams, reqEditors ...RequestEditorFn) (*ListSlotsResponse, error) {
+	rsp, err := c.ListSlots(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSlotsResponse(rsp)
+}
+
+// DeleteSlotWithResponse request returning *DeleteSlotResponse
+func (c *ClientWithResponses) DeleteSlotWithResponse(ctx context.Context, slotId SlotId, params *DeleteSlotParams, reqEditors ...RequestEditorFn) (*DeleteSlotResponse, error) {
+	rsp, err := c.DeleteSlot(ctx, slotId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSlotResponse(rsp)
+}
+
+// GetSlotWithResponse request returning *GetSlotResponse
+func (c *ClientWithResponses) GetSlotWithResponse(ctx context.Context, slotId SlotId, reqEditors ...RequestEditorFn) (*GetSlotResponse, error) {
+	rsp, err := c.GetSlot(ctx, slotId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSlotResponse(rsp)
+}
+
+// PatchSlotWithBodyWithResponse request with arbitrary body returning *PatchSlotResponse
+func (c *ClientWithResponses) PatchSlotWithBodyWithResponse(ctx context.Context, slotId SlotId, params *PatchSlotParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchSlotResponse, error) {
+	rsp, err := c.PatchSlotWithBody(ctx, slotId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchSlotResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchSlotWithResponse(ctx context.Context, slotId SlotId, params *PatchSlotParams, body PatchSlotJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchSlotResponse, error) {
+	rsp, err := c.PatchSlot(ctx, slotId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchSlotResponse(rsp)
+}
+
+// PutSlotWithBodyWithResponse request with arbitrary body returning *PutSlotResponse
+func (c *ClientWithResponses) PutSlotWithBodyWithResponse(ctx context.Context, slotId SlotId, params *PutSlotParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutSlotResponse, error) {
+	rsp, err := c.PutSlotWithBody(ctx, slotId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutSlotResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutSlotWithResponse(ctx context.Context, slotId SlotId, params *PutSlotParams, body PutSlotJSONRequestBody, reqEditors ...RequestEditorFn) (*PutSlotResponse, error) {
+	rsp, err := c.PutSlot(ctx, slotId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutSlotResponse(rsp)
+}
+
+// PurchaseFromSlotWithBodyWithResponse request with arbitrary body returning *PurchaseFromSlotResponse
+func (c *ClientWithResponses) PurchaseFromSlotWithBodyWithResponse(ctx context.Context, slotId SlotId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PurchaseFromSlotResponse, error) {
+	rsp, err := c.PurchaseFromSlotWithBody(ctx, slotId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePurchaseFromSlotResponse(rsp)
+}
+
+func (c *ClientWithResponses) PurchaseFromSlotWithResponse(ctx context.Context, slotId SlotId, body PurchaseFromSlotJSONRequestBody, reqEditors ...RequestEditorFn) (*PurchaseFromSlotResponse, error) {
+	rsp, err := c.PurchaseFromSlot(ctx, slotId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePurchaseFromSlotResponse(rsp)
+}
+
+// RestockSlotWithBodyWithResponse request with arbitrary body returning *RestockSlotResponse
+func (c *ClientWithResponses) RestockSlotWithBodyWithResponse(ctx context.Context, slotId SlotId, params *RestockSlotParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RestockSlotResponse, error) {
+	rsp, err := c.RestockSlotWithBody(ctx, slotId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestockSlotResponse(rsp)
+}
+
+func (c *ClientWithResponses) RestockSlotWithResponse(ctx context.Context, slotId SlotId, params *RestockSlotParams, body RestockSlotJSONRequestBody, reqEditors ...RequestEditorFn) (*RestockSlotResponse, error) {
+	rsp, err := c.RestockSlot(ctx, slotId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestockSlotResponse(rsp)
+}
+
+// ListSodasWithResponse request returning *ListSodasResponse
+func (c *ClientWithResponses) ListSodasWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSodasResponse, error) {
+	rsp, err := c.ListSodas(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSodasResponse(rsp)
+}
+
+// GetSodaWithResponse request returning *GetSodaResponse
+func (c *ClientWithResponses) GetSodaWithResponse(ctx context.Context, sodaId string, reqEditors ...RequestEditorFn) (*GetSodaResponse, error) {
+	rsp, err := c.GetSoda(ctx, sodaId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSodaResponse(rsp)
+}
+
+// ParseListSlotsResponse parses an HTTP response from a ListSlotsWithResponse call
+func ParseListSlotsResponse(rsp *http.Response) (*ListSlotsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSlotsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SlotsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSlotResponse parses an HTTP response from a DeleteSlotWithResponse call
+func ParseDeleteSlotResponse(rsp *http.Response) (*DeleteSlotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSlotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSlotResponse parses an HTTP response from a GetSlotWithResponse call
+func ParseGetSlotResponse(rsp *http.Response) (*GetSlotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSlotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SlotResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchSlotResponse parses an HTTP response from a PatchSlotWithResponse call
+func ParsePatchSlotResponse(rsp *http.Response) (*PatchSlotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchSlotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SlotResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutSlotResponse parses an HTTP response from a PutSlotWithResponse call
+func ParsePutSlotResponse(rsp *http.Response) (*PutSlotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutSlotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SlotResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SlotResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePurchaseFromSlotResponse parses an HTTP response from a PurchaseFromSlotWithResponse call
+func ParsePurchaseFromSlotResponse(rsp *http.Response) (*PurchaseFromSlotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PurchaseFromSlotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest PurchaseResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 402:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON402 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRestockSlotResponse parses an HTTP response from a RestockSlotWithResponse call
+func ParseRestockSlotResponse(rsp *http.Response) (*RestockSlotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestockSlotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest RestockResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListSodasResponse parses an HTTP response from a ListSodasWithResponse call
+func ParseListSodasResponse(rsp *http.Response) (*ListSodasResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSodasResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SodasResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSodaResponse parses an HTTP response from a GetSodaWithResponse call
+func ParseGetSodaResponse(rsp *http.Response) (*GetSodaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSodaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SodaResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List the slots
+	// (GET /v2/slots)
+	ListSlots(ctx echo.Context, params ListSlotsParams) error
+	// Delete a slot
+	// (DELETE /v2/slots/{slotId})
+	DeleteSlot(ctx echo.Context, slotId SlotId, params DeleteSlotParams) error
+	// Get a slot
+	// (GET /v2/slots/{slotId})
+	GetSlot(ctx echo.Context, slotId SlotId) error
+	// Change the price of a slot
+	// (PATCH /v2/slots/{slotId})
+	PatchSlot(ctx echo.Context, slotId SlotId, params PatchSlotParams) error
+	// Create or replace a slot
+	// (PUT /v2/slots/{slotId})
+	PutSlot(ctx echo.Context, slotId SlotId, params PutSlotParams) error
+	// Buy a soda from a slot
+	// (POST /v2/slots/{slotId}/purchases)
+	PurchaseFromSlot(ctx echo.Context, slotId SlotId) error
+	// Restock a slot
+	// (POST /v2/slots/{slotId}/restocks)
+	RestockSlot(ctx echo.Context, slotId SlotId, params RestockSlotParams) error
+	// List the sodas
+	// (GET /v2/sodas)
+	ListSodas(ctx echo.Context) error
+	// Get a soda
+	// (GET /v2/sodas/{sodaId})
+	GetSoda(ctx echo.Context, sodaId string) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// ListSlots converts echo context to params.
+func (w *ServerInterfaceWrapper) ListSlots(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"vending:read"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"vending:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSlotsParams
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Optional query parameter "minPrice" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minPrice", ctx.QueryParams(), &params.MinPrice)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter minPrice: %s", err))
+	}
+
+	// ------------- Optional query parameter "maxPrice" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxPrice", ctx.QueryParams(), &params.MaxPrice)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter maxPrice: %s", err))
+	}
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", ctx.QueryParams(), &params.Currency)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency: %s", err))
+	}
+
+	// ------------- Optional query parameter "minCalories" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minCalories", ctx.QueryParams(), &params.MinCalories)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter minCalories: %s", err))
+	}
+
+	// ------------- Optional query parameter "maxCalories" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxCalories", ctx.QueryParams(), &params.MaxCalories)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter maxCalories: %s", err))
+	}
+
+	// ------------- Optional query parameter "minOunces" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minOunces", ctx.QueryParams(), &params.MinOunces)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter minOunces: %s", err))
+	}
+
+	// ------------- Optional query parameter "maxOunces" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxOunces", ctx.QueryParams(), &params.MaxOunces)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter maxOunces: %s", err))
+	}
+
+	// ------------- Optional query parameter "stock" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "stock", ctx.QueryParams(), &params.Stock)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stock: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListSlots(ctx, params)
+	return err
+}
+
+// DeleteSlot converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteSlot(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "slotId" -------------
+	var slotId SlotId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slotId", ctx.Param("slotId"), &slotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter slotId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"slots:write"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"slots:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteSlotParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteSlot(ctx, slotId, params)
+	return err
+}
+
+// GetSlot converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSlot(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "slotId" -------------
+	var slotId SlotId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slotId", ctx.Param("slotId"), &slotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter slotId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"vending:read"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"vending:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSlot(ctx, slotId)
+	return err
+}
+
+// PatchSlot converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchSlot(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "slotId" -------------
+	var slotId SlotId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slotId", ctx.Param("slotId"), &slotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter slotId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"price:write"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"price:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchSlotParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchSlot(ctx, slotId, params)
+	return err
+}
+
+// PutSlot converts echo context to params.
+func (w *ServerInterfaceWrapper) PutSlot(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "slotId" -------------
+	var slotId SlotId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slotId", ctx.Param("slotId"), &slotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter slotId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"slots:write"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"slots:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutSlotParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutSlot(ctx, slotId, params)
+	return err
+}
+
+// PurchaseFromSlot converts echo context to params.
+func (w *ServerInterfaceWrapper) PurchaseFromSlot(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "slotId" -------------
+	var slotId SlotId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slotId", ctx.Param("slotId"), &slotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter slotId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"purchase:write"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"purchase:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PurchaseFromSlot(ctx, slotId)
+	return err
+}
+
+// RestockSlot converts echo context to params.
+func (w *ServerInterfaceWrapper) RestockSlot(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "slotId" -------------
+	var slotId SlotId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slotId", ctx.Param("slotId"), &slotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter slotId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"restock:write"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"restock:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RestockSlotParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RestockSlot(ctx, slotId, params)
+	return err
+}
+
+// ListSodas converts echo context to params.
+func (w *ServerInterfaceWrapper) ListSodas(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"vending:read"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"vending:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListSodas(ctx)
+	return err
+}
+
+// GetSoda converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSoda(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sodaId" -------------
+	var sodaId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sodaId", ctx.Param("sodaId"), &sodaId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sodaId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"vending:read"})
+
+	ctx.Set(ApiKeyAuthScopes, []string{"vending:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSoda(ctx, sodaId)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/v2/slots", wrapper.ListSlots)
+	router.DELETE(baseURL+"/v2/slots/:slotId", wrapper.DeleteSlot)
+	router.GET(baseURL+"/v2/slots/:slotId", wrapper.GetSlot)
+	router.PATCH(baseURL+"/v2/slots/:slotId", wrapper.PatchSlot)
+	router.PUT(baseURL+"/v2/slots/:slotId", wrapper.PutSlot)
+	router.POST(baseURL+"/v2/slots/:slotId/purchases", wrapper.PurchaseFromSlot)
+	router.POST(baseURL+"/v2/slots/:slotId/restocks", wrapper.RestockSlot)
+	router.GET(baseURL+"/v2/sodas", wrapper.ListSodas)
+	router.GET(baseURL+"/v2/sodas/:sodaId", wrapper.GetSoda)
+
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+xc63YbOXJ+FZzO/MilRVIaeSfD/ZHI8npX3nWstTTjJB5nD9hdZGPUDbQBtCgeW4+T",
+	"F8mT5VQB6DtFUtZsZrLzxxbZuBTqhqqvqvkpSlRRKgnSmmj+KcqAp6Dpz99d8xX+n4JJtCitUDKaR9+D",
+	"NkJJppbMZsBMriz9ocGUShpgbvgCzCSKI5NkUHBcxW5KiOaRsVrIVXR/fx9HJde8AOu3u1i+5jbJhjsi",
+	"HZ3tuGGlhluhKpNvmAZbaQkpW2xoyNnlxYRdZ8CSjMsVMGGYkvmG8bLMBaRMtFYyVuQ5y7hhNhOG3bqz",
+	"xUzZDPRaGGCnxyfsUkOiZCqQHvaSixxXMfXGE/adAfaPzCq3kYaPldDAbMZtsxXcCWOJJwIP5fgcxZHk",
+	"BfLlYnnkjv8Qz+LoKlf2Ih3y6OJFm0Mxq0zF83zDhDWsVMaRLiSNKHiSCQnMVEmGvDw7Zkqz51/HLOEG",
+	"joQ0IHHGLdTUltxmDa3GERFH/qRpNLe6gh3SxsFg7HOVCiCBX1Y6ybiB5yrd4OdESQvS4p8kq4Qj1dMf",
+	"DZ7wU2vxrzQso3n0d9NGdafuqZmGRZs9GwLv4+gtGKuSmyfd0q+5ZUeU2JNuVy+4fb9L1KQn35RWHd2V",
+	"vnHm7zyH1kq/9d88QEOp1SKH4p8OFLGb5SjpmsE7NLk1SMvWWslVjOr99uU5++afZ98wvxtLwXKRm0l0",
+	"H9c6uAexQyJLrUrQ1quzcze7iH+tJGxwZzf8BUhVCEk7mKFZkxtTQhrGZcoWIs8NmbCbzNbcsJW4BTTt",
+	"mC2VZqU/j2ElFylbC5sxNGhtIUXzzsikLRRmF6FtypBeb9Fca0704/p7H7bUItmfNaZ2cj03EkdGpbt1",
+	"Fcd0tfR947NohUCRP0aQRvShPqda/AiJHdMxlAkuwozKU5JLSyJpRU5z5BYdo9gPm9IY2sr7kidQxxyW",
+	"Vt2CHioV8se42ykVKZPKsqWw4XZARk2img9CWliBRt6rPP1zxaUVdtOSTWsATt3HkYwKJ+puEDcH2Fso",
+	"FBssLegQkCArY2cE695xv0hKeIhHiWgPzgyOdlaL5IvoNU+gUxLu7HmljRrRqktuDLrbhJ4zq9gKXPiD",
+	"s1jJVzBhZwsD0jLlVC3nxj+I4hFTR6Jxn73cleNe302N6Jk5SJ9MCKt80LRFDL1bCPgNu+W5SLlVOqyw",
+	"zlQOLBfGCrnaERujzFTKn17HnGsc0zH0aJ7ShFueqxVdkDjjKVQH1z9AmkTnLmnSkge57OZk94H/REvn",
+	"shvI84zJqlgASdLdxkr7y1gtmZKAwq4grgNsIZVmlRS24ajJ2ELdoXVokMlm7qawk2dMGMbZx4prCxpX",
+	"+O7qxSSKe+xLVOV4XvA7UVRFND+ezWazOCqE9J/HXDZtgtOWShfcuoe/OY3i3jo7Fuox3q0ae6JQAMLm",
+	"OKHDxoFc4uilgDyl4HCMyUt8GjjmswV3TS1dzuVNSig5ZBBNHg+gPlagNzHDHAYF58yX1ckn5o2Y2MT4",
+	"ELfu0LFQ6YYtXCL16urNv7llQupEnplCiQkvkBtIGNzxoiR+fGxus4F7K8AYvoIhyRTDCuMiWHd51VR1",
+	"l/daWVTGsgUwblkO6FKPh/v1JOi41RDRkmFLRiMSdDHaUHiSwR1PLHNcQOYVODJmpCOQjpsGMrWxiONn",
+	"M3fc8BWaAnLiq+PJs1lModZwzKvL/8Ax//Pfx89mQ7Vw9IwQ7OjcbrB++bgWdQLSpe9DUwqWMxszwbDS",
+	"SM5+9Yadnhx/05wlUSl0Rfzd1QsKUq0FjXP+6/3Z0X9++PT1/Vc7ZeyP3qKgJWUnxxEBh+RqVMSoFTHT",
+	"UCrKJx5Irhq93ZbvsQJSwRkSMKF/mUhBWrEU4HKcGyHJCv2k+Q/yB3nE+EJVdr7IubyZ0zBjua0MsY4Z",
+	"vjFMWMbz3KkLHRfVAzVNA8d9y0xzAxNcLBBkpo1rmXfcT6rAUMhYYPLrNiwh+a3jhaHr3LD10GSBJ5m3",
+	"2e5OQRpHhTC0qNuw5JsCJK0iJOOSEKhGM2zGnao6Z9NdUkhTLZciESDt0bKSqemuWR8iwXh66zKYzxwh",
+	"d5lULpjOVJ4KuWI23J+IlHG5YRib92aT/R+5NOgIcbB57+bjEknAdLWVME1+kAObdSq0xTE2yX1QMWGC",
+	"tLqm0+YK81z56ngym7nUeFFZdq5yzhJlrPcxY37aCXr8ZiH5mv2vrL1in5YDHkm7hTSWywTGAnCbDUmB",
+	"2jJVQtqUMiW7jJoG0KDt3CotjjQsAdUPRsNzsrshGX+4vr7sGGVNkoshO3ufzk7GfKb3UoPENVPaMlMV",
+	"BdebsG7PTcT0peEFEB4Ct6A34egy6e4eXQxUZOyk7os+Md+9vWA1g4Lv2gRr6VHVZ/h2yz1ABj1/T08D",
+	"72r5xMGcWs4/+Pgx9x9UYShXtWa8xpfQT6EZzelfYjUnW8/BmOB4KKKq0aelD6/6eFZZ2R4yXQfStfvr",
+	"hdET9i4DyRbKZoxrYJRU2gw2LhbiKw0wjAUCJXtnIU8Igd23uN9Y24D9AUoepE8fW8DLGJqTK55SlGVV",
+	"B8U5IKqvt2hpSqBnhNQrD/aMgRW9pDmuLxI+lmcybhn3N9JQaD9RsWMUcij43Z93MDrsivcZHWscKzsM",
+	"8Nwl3Z3Q3P6waBz5Itdwr9dKKqukSBwzZaKBG5Ta7bDkF7NFVZSu6OZ8rAdArWLCxoznRjWVOc83REtG",
+	"I+gdmilGcNtWatWWWnO8lhZfOXhxVIVDlWQk1OBNEGRiVNJ1JpLMKaoLLzO1ZgUqFuUyQ+Xt6dPDufrj",
+	"VebhBOTxkPleHO/xmfi5hdeXob7b5dIhB++R6Kb2SLj0ddQhDZ4T+2Bf7obBby4oB1WFsJTHUoiegha3",
+	"eKdpVdAgBBDQFnK1Bn2UOLvBTESmTEOZ8wS/MCVPwOdGKTeZq5H3kB6eK+3/HgqzQ/pIfWTMXZ57N9ty",
+	"myrlrcxW5Xy89jtY3hWAR/ZVWqyEvLJKb8afVzLxQE0w/mWueMssHZjRuSmvnAL24b04MpBUWtjNFSqH",
+	"W/asFH+EzVlls9HU9ezygt3AhqFPQzGSCC7fXF2zKS/FDWwoig+O7jhmK436HXKfRJVAmSVW3NprbK3n",
+	"//vR2eXF0R/bSTYnEpEbz4Fr0IHYBX16Gfjy6t11NMRnX727dto25ZXNprlaCdkjuU65Pbkh8FZ5HYRX",
+	"xmGMdFOCLliSc1FM2FmdMeAiqkLnR6kLs+oGpOt2QPYH1p3Ojp0LVJKQbQN+ZM6TG8O4o4H2ROWmECo0",
+	"RpiwxNc1Co7scWxo2JVZWzowV8ilCtAzTyjqgIJSxOjHDKTe/OZfV/h5kqiikcArjsnOH/B5FEeVzklK",
+	"Um8k2LXSN4aG38db+ltOAs++F9pWPGeojex7kBTIvPZhBepVoVLITSfa4MgvoyqdgM/Fx4oJcW2MpkbD",
+	"nK3GdV3RV7FcwNxUd9XSX08T9ju6fsN2BCenqQZj3O1MouY2i5lRTKpa0BIgRUERwGmV82AOyLBMA08J",
+	"5U4hBwsm9o7M1/hRj5o8y2Qc1c1f8mH9BfV5TNgFOUwDGv0llYKsamttJVPQbHp74vbgSQKlNc3qpFbu",
+	"+N6IzW9ZrlYr8rAyJp02cf0w7mQKAa0yNUNzSFegmUYNoiixpmWCCJPjJiX9xEpp1qCD1m9Fu4TcinPN",
+	"mQO5GCaMO9LEGMei84v70BY+cZuF/ipcYt1vd2gLAIXOKHh0iAFywDUJ+BGmQSpaOIVbxMNbatkFsqLG",
+	"Oe8yi1YoNo9OJrPJjO6BEiQvRTSPvqavCNvMyINPb0+mdc1vBSPJxZ+EsWarNTEDufNQvhGMcP8G5zcx",
+	"UzolYS42tALeiJWkjNU4RLPu+5owxDuL0m7q5SnFXYrcgq4BMgLwwDhN8eMREpywd6gvnOWiwHC4KZy2",
+	"G8cwoMyBFUqHE9F6pKpkM9LurKhe+7iaSGDrbuUxpNyd0qOTugvXEboEiQE8wXp0plS5sKT22xep5z2V",
+	"kaNu3977vpDeYAscbuWP5C4HCrHIxZATF9J33Fm4sw/0nZEIG5dO/z3YILeLGgoY06ZUQkQ4pHxL+S5g",
+	"ENtoKoS89BFyQ9dBNYJDqC7U0xDN756c6PMWWBOYQqocNoupnuMNjtqWJuyqfb4x4JtrIOgauUEWA5KB",
+	"oDEirLLlkGGNziH3LaUcoNYEincUqkBjCoH8A4pz7oeM6fQB6jGkpVCHkcLvnp4UMcoVlwg8wJM3NGBc",
+	"MbemDAeTVahDqOJ3PwlVQv7FtyjV+FhgmJIhS8vV+sFRWMeQyl0iVCXCS2pZlwKEZq1snRBZrPP8RVV2",
+	"23ED5NccFSR6gfdRIDiKo5osHOgXjD70DSmO7o5W6mindRHeYpW7oFs3/GIzZyKNfX3eIy9Bm2MvNjzT",
+	"x/qApYaluAsR2xGuqvGC8xEr7RD8Tkh28IHrx0gUFg6ENjYUEMGQDxqGDhMm0q4328ZPpe02H3T0L38v",
+	"0s848DOd7nM43Gd3ts/hYP+wl5d67bo6Wv0qjo82gHEhXaBAgyAJPEw7JtnnRBTVdI7UaSfZBTuPldJa",
+	"EZIP60KLPwU6MR4hUdIKWYFv8hO2lfJSIqK0feAyMEo/GDx86HUxn8xm21Cpety021p3H0en+8zqNkjT",
+	"rONHzfr6EbOePWJWC3ShmK+NYLyPbl34P8e0MfpwH3fhmMHzD3Hki3g+sGwsPoojy1em1ayHW9epwfST",
+	"6+O9d8kB5qbDNOEFfW9aWLVT7nYlm8CcDJMadt1OChOlUz8TnRsT9TsiNPYKfBY5eCdFSBZe4UBVzbgv",
+	"cxORDvzo4CfHJ50XUVw4njIjfJGyG327I3kcuxd+j8mxGTINr9WMqPfpeFWbCEKgyzE4nfwy1Pp0dvqY",
+	"WccnPwsTIvWer7WwMGZB3ccdA3K64eGgEfuJx5Ppt2QTjZX4dwWs8XFHWcfvPvrAP/FxwEt6RZ2uwv4e",
+	"rNfWx7nUX5YS/bw86u/BPqQNh7kP/8IZ7lCOv5537pGEuq2o7RcR/zPelyb1QK2qVeZ7ssbgTadsVFe0",
+	"FKVAbqi80pRShH2MN3YkfJkvpvLSE7ji8DbcZrvqtF6Ym3bf67p/GsP61af/lEZM1rDdp3cfd0z43BfT",
+	"2yb1kElXIw7+nMpU7TDIgdloPSdYvlHa2xOYvt+PvcmiGSa85AmlVu35Mxc4hZQ6V+oGk4eybt1xhYx5",
+	"aPmwvkWS5+iyNuxGqrWkJooFNH1UdcXi4gXjuZK+zk4pOmE+HgMPqxFESj0/TX9mXUJpvcQ7+7b7wu5Z",
+	"lyipbI9utoGmRIIpxAHexr8ETJx1qaW/MTeqospK7DsJtL+CtzkgRUBzriTGpv794bFwlYfdCo8YdwJP",
+	"Tym+rEarh0IIT1PRHiBhzZQcc3iV/b9xd0/n6U5mx4dP+iW4x2//JkNe59paDmy7cxzNHuuOV9d/8uiA",
+	"SJkRv/u82pgav2s6RJwLpm5Jj1/X7ZIUhdATpRtIo98nWTdS9pslnTNZqab7r266xtlEgRtjO7+K4CH4",
+	"M/ciq6ocLNl4pdm3MeN1E7l7X3RLJznDRt7W4DEk/3Tm3+FIwuXGbZda3yJe8BtgpycnVNtwhCfcUBmA",
+	"gkH0+77xru+onFRfalXU2ceBfqfzowRD37OHbQ9eKf+ru5KTn3t89ji3dfIzieq8fB8I7PojOs7rebUJ",
+	"QRGZ5sB1Nc5pm/sKWdNP4L3+pHhqAkTW6SZm7zoeYCms790QVLh2wHOAq1241bwnFF7m9vFcgzi4XqPB",
+	"dBdqkhN6TJ7nGfRliZ5vfv7rxz7tHyl5lAvq/4rA/+dc7xcdAnk93e5K+gM6nsSLeXfwE9693tVVEzrR",
+	"2olQq/B18QKDDaBrnXKTbo80Tiu2dI4QBY+K5Dvvn/9aKanl1BY3fe6Ke/oJ//OVkt0A8MhbGXUgevFi",
+	"HN913bmPE+mv+O4X47uO/X0d2NGdta0XfN9f/iKdOuiXvz48zAk6mz/Epz1/hCP2jQ0d8Nh9RdmImbQo",
+	"xvnRsOb8vKImTGJba3gTe42Xqbu/ItHhi4nuP9z/7wCbOxOcT08AAA==",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
@@ -0,0 +1,6 @@
+package v2
+
+import "embed"
+
+//go:embed api.yml
+var Content embed.FS
@@ -0,0 +1,247 @@
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lestrrat-go/jwx/jwa"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/lestrrat-go/jwx/jws"
+	"github.com/lestrrat-go/jwx/jwt"
+)
+
+const (
+	// Issuer and Audience are the iss and aud of the tokens the API issues.
+	Issuer   = "colaco-api"
+	Audience = "colaco-api"
+)
+
+const PermissionsClaim = "perm"
+
+// TokenUseClaim tells access tokens, which authorize requests, from refresh
+// tokens, which can only be exchanged for new tokens at /auth/refresh.
+const TokenUseClaim = "token_use"
+
+const (
+	AccessTokenUse  = "access"
+	RefreshTokenUse = "refresh"
+)
+
+const (
+	// DefaultAccessTokenTTL is how long access tokens are valid unless
+	// configured with WithAccessTokenTTL.
+	DefaultAccessTokenTTL = 15 * time.Minute
+	// DefaultRefreshTokenTTL is how long refresh tokens are valid unless
+	// configured with WithRefreshTokenTTL.
+	DefaultRefreshTokenTTL = 24 * time.Hour
+	// clockSkew is how far the clocks of the issuer and the validator may
+	// differ when checking exp, nbf and iat.
+	clockSkew = 30 * time.Second
+)
+
+// ErrNoSigningKey is returned by NewKeyAuthenticator without keys.
+var ErrNoSigningKey = errors.New("no signing key")
+
+// Authenticator issues the tokens of the API and validates them. It signs
+// with the first of its keys and accepts tokens signed with any of them, each
+// known by its kid, so a new key can be rolled out to every server before it
+// starts signing and an old one kept until the tokens it signed expired.
+type Authenticator struct {
+	signingKey *ecdsa.PrivateKey
+	signingKID string
+	// KeySet holds the public keys tokens are validated with, as published
+	// at /.well-known/jwks.json.
+	KeySet jwk.Set
+	// AccessTokenTTL and RefreshTokenTTL are how long the tokens it issues
+	// are valid.
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+}
+
+var _ JWSValidator = (*Authenticator)(nil)
+
+// WithAccessTokenTTL sets how long access tokens are valid.
+func WithAccessTokenTTL(ttl time.Duration) func(*Authenticator) {
+	return func(a *Authenticator) {
+		a.AccessTokenTTL = ttl
+	}
+}
+
+// WithRefreshTokenTTL sets how long refresh tokens are valid.
+func WithRefreshTokenTTL(ttl time.Duration) func(*Authenticator) {
+	return func(a *Authenticator) {
+		a.RefreshTokenTTL = ttl
+	}
+}
+
+// NewKeyAuthenticator creates an authenticator that signs tokens with the
+// first of keys and validates tokens signed with any of them. The kid of
+// every key is its RFC 7638 thumbprint, so it is the same on every server
+// that loads the key.
+func NewKeyAuthenticator(keys []*ecdsa.PrivateKey, options ...func(*Authenticator)) (*Authenticator, error) {
+	if len(keys) == 0 {
+		return nil, ErrNoSigningKey
+	}
+	set := jwk.NewSet()
+	var kids []string
+	for _, key := range keys {
+		pubKey, err := publicJWK(key)
+		if err != nil {
+			return nil, err
+		}
+		if _, dup := set.LookupKeyID(pubKey.KeyID()); dup {
+			return nil, fmt.Errorf("key %s is given twice", pubKey.KeyID())
+		}
+		set.Add(pubKey)
+		kids = append(kids, pubKey.KeyID())
+	}
+
+	a := &Authenticator{
+		signingKey:      keys[0],
+		signingKID:      kids[0],
+		KeySet:          set,
+		AccessTokenTTL:  DefaultAccessTokenTTL,
+		RefreshTokenTTL: DefaultRefreshTokenTTL,
+	}
+	for _, option := range options {
+		option(a)
+	}
+	return a, nil
+}
+
+// publicJWK returns the public half of key as a JWK for signatures, with its
+// thumbprint as kid.
+func publicJWK(key *ecdsa.PrivateKey) (jwk.Key, error) {
+	alg, err := algorithm(key)
+	if err != nil {
+		return nil, err
+	}
+	pubKey := jwk.NewECDSAPublicKey()
+	if err := pubKey.FromRaw(&key.PublicKey); err != nil {
+		return nil, fmt.Errorf("parsing jwk key: %w", err)
+	}
+	if err := jwk.AssignKeyID(pubKey); err != nil {
+		return nil, fmt.Errorf("setting key ID: %w", err)
+	}
+	if err := pubKey.Set(jwk.AlgorithmKey, alg); err != nil {
+		return nil, fmt.Errorf("setting key algorithm: %w", err)
+	}
+	if err := pubKey.Set(jwk.KeyUsageKey, jwk.ForSignature); err != nil {
+		return nil, fmt.Errorf("setting key use: %w", err)
+	}
+	return pubKey, nil
+}
+
+// algorithm returns the JWS algorithm for the curve of key.
+func algorithm(key *ecdsa.PrivateKey) (jwa.SignatureAlgorithm, error) {
+	switch key.Curve.Params().BitSize {
+	case 256:
+		return jwa.ES256, nil
+	case 384:
+		return jwa.ES384, nil
+	case 521:
+		return jwa.ES512, nil
+	}
+	return "", fmt.Errorf("unsupported curve %s", key.Curve.Params().Name)
+}
+
+// SigningKeyID returns the kid of the key new tokens are signed with.
+func (a *Authenticator) SigningKeyID() string {
+	return a.signingKID
+}
+
+// ValidateJWS ensures that the critical JWT claims needed to ensure that we
+// trust the JWT are present and with the correct values: the signature by
+// one of our keys, the issuer, the audience, and an expiry and ID so that no
+// token is valid forever or cannot be revoked. Expired tokens and tokens that
+// are not valid yet are rejected.
+func (a *Authenticator) ValidateJWS(jwsString string) (jwt.Token, error) {
+	return jwt.Parse([]byte(jwsString), jwt.WithKeySet(a.KeySet), jwt.WithValidate(true),
+		jwt.WithAudience(Audience), jwt.WithIssuer(Issuer),
+		jwt.WithRequiredClaim(jwt.ExpirationKey), jwt.WithRequiredClaim(jwt.JwtIDKey),
+		jwt.WithAcceptableSkew(clockSkew))
+}
+
+// SignToken takes a JWT and signs it with our signing key, returning a JWS.
+func (a *Authenticator) SignToken(t jwt.Token) ([]byte, error) {
+	alg, err := algorithm(a.signingKey)
+	if err != nil {
+		return nil, err
+	}
+	hdr := jws.NewHeaders()
+	if err := hdr.Set(jws.AlgorithmKey, alg); err != nil {
+		return nil, fmt.Errorf("setting algorithm: %w", err)
+	}
+	if err := hdr.Set(jws.TypeKey, "JWT"); err != nil {
+		return nil, fmt.Errorf("setting type: %w", err)
+	}
+	if err := hdr.Set(jws.KeyIDKey, a.signingKID); err != nil {
+		return nil, fmt.Errorf("setting Key ID: %w", err)
+	}
+	return jwt.Sign(t, alg, a.signingKey, jwt.WithHeaders(hdr))
+}
+
+// CreateJWSWithClaims is a helper function to create JWT's with the specified
+// claims.
+func (a *Authenticator) CreateJWSWithClaims(claims []string) ([]byte, error) {
+	return a.CreateJWSForSubject("", claims)
+}
+
+// CreateJWSForSubject creates an access token like CreateJWSWithClaims that
+// names the user it was issued to in its subject, unless subject is empty. It
+// expires after AccessTokenTTL.
+func (a *Authenticator) CreateJWSForSubject(subject string, claims []string) ([]byte, error) {
+	t, err := a.newToken(subject, AccessTokenUse, a.AccessTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+	err = t.Set(PermissionsClaim, claims)
+	if err != nil {
+		return nil, fmt.Errorf("setting permissions: %w", err)
+	}
+	return a.SignToken(t)
+}
+
+// CreateRefreshJWS creates a refresh token for subject that expires after
+// RefreshTokenTTL. It carries no permissions; they are looked up again when
+// it is exchanged for an access token.
+func (a *Authenticator) CreateRefreshJWS(subject string) ([]byte, error) {
+	t, err := a.newToken(subject, RefreshTokenUse, a.RefreshTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+	return a.SignToken(t)
+}
+
+// newToken returns an unsigned token of the given use for subject, issued now
+// with a random ID and valid for ttl.
+func (a *Authenticator) newToken(subject, use string, ttl time.Duration) (jwt.Token, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, fmt.Errorf("generating token ID: %w", err)
+	}
+	now := time.Now()
+	claims := map[string]interface{}{
+		jwt.IssuerKey:     Issuer,
+		jwt.AudienceKey:   Audience,
+		jwt.IssuedAtKey:   now,
+		jwt.NotBeforeKey:  now,
+		jwt.ExpirationKey: now.Add(ttl),
+		jwt.JwtIDKey:      hex.EncodeToString(id),
+		TokenUseClaim:     use,
+	}
+	if subject != "" {
+		claims[jwt.SubjectKey] = subject
+	}
+	t := jwt.New()
+	for name, value := range claims {
+		if err := t.Set(name, value); err != nil {
+			return nil, fmt.Errorf("setting %s: %w", name, err)
+		}
+	}
+	return t, nil
+}
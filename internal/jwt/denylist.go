@@ -0,0 +1,58 @@
+package jwt
+
+import (
+	"sync"
+	"time"
+)
+
+// Denylist keeps the IDs (jti) of revoked tokens until the tokens expire, after
+// which the validator rejects them anyway.
+type Denylist interface {
+	// Revoke denies the token with the ID jti until expires. It reports
+	// whether the token was not revoked already, so that a token can be used
+	// exactly once by revoking it.
+	Revoke(jti string, expires time.Time) bool
+	// IsRevoked reports whether the token with the ID jti was revoked.
+	IsRevoked(jti string) bool
+}
+
+// MemoryDenylist is a Denylist kept in memory. Tokens revoked before a restart
+// are valid again afterwards, until they expire, so it relies on tokens being
+// short-lived.
+type MemoryDenylist struct {
+	revoked map[string]time.Time
+	m       sync.Mutex
+}
+
+var _ Denylist = (*MemoryDenylist)(nil)
+
+// NewMemoryDenylist returns an empty MemoryDenylist.
+func NewMemoryDenylist() *MemoryDenylist {
+	return &MemoryDenylist{revoked: make(map[string]time.Time)}
+}
+
+// Revoke implements Denylist. IDs whose tokens have expired are forgotten
+// along the way.
+func (d *MemoryDenylist) Revoke(jti string, expires time.Time) bool {
+	d.m.Lock()
+	defer d.m.Unlock()
+	now := time.Now()
+	for id, exp := range d.revoked {
+		if now.After(exp.Add(clockSkew)) {
+			delete(d.revoked, id)
+		}
+	}
+	if _, ok := d.revoked[jti]; ok {
+		return false
+	}
+	d.revoked[jti] = expires
+	return true
+}
+
+// IsRevoked implements Denylist.
+func (d *MemoryDenylist) IsRevoked(jti string) bool {
+	d.m.Lock()
+	defer d.m.Unlock()
+	_, ok := d.revoked[jti]
+	return ok
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/labstack/echo/v4"
 	"github.com/lestrrat-go/jwx/jwt"
 	middleware "github.com/oapi-codegen/echo-middleware"
 )
@@ -18,12 +19,37 @@ type JWSValidator interface {
 	ValidateJWS(jws string) (jwt.Token, error)
 }
 
+// AnyValidator accepts the tokens any of its validators accept, so that the
+// tokens of the API and of an external identity provider can be used side by
+// side. It tries them in order and returns the errors of all of them when
+// none accepts a token.
+type AnyValidator []JWSValidator
+
+// ValidateJWS implements JWSValidator.
+func (a AnyValidator) ValidateJWS(jws string) (jwt.Token, error) {
+	if len(a) == 0 {
+		return nil, errors.New("no token validators configured")
+	}
+	var errs []error
+	for _, v := range a {
+		token, err := v.ValidateJWS(jws)
+		if err == nil {
+			return token, nil
+		}
+		errs = append(errs, err)
+	}
+	return nil, errors.Join(errs...)
+}
+
 const JWTClaimsContextKey = "jwt_claims"
 
 var (
 	ErrNoAuthHeader      = errors.New("Authorization header is missing")
 	ErrInvalidAuthHeader = errors.New("Authorization header is malformed")
 	ErrClaimsInvalid     = errors.New("Provided claims do not match expected scopes")
+	ErrTokenRevoked      = errors.New("token has been revoked")
+	ErrNotAccessToken    = errors.New("refresh tokens cannot authorize requests")
+	ErrNoAPIKey          = errors.New("X-API-Key header is missing")
 )
 
 // GetJWSFromRequest retrieves the JWS from the Authorization header of an HTTP request.
@@ -47,38 +73,68 @@ func GetJWSFromRequest(req *http.Request) (string, error) {
 	return strings.TrimPrefix(authHdr, prefix), nil
 }
 
+// APIKeyValidator validates the API keys of the ApiKeyAuth security scheme.
+type APIKeyValidator interface {
+	// ValidateAPIKey returns who requests made with key are recorded as
+	// being made by, and the scopes it grants.
+	ValidateAPIKey(ctx context.Context, key string) (subject string, scopes []string, err error)
+}
+
+// The security schemes of api.yml.
+const (
+	BearerAuthScheme = "BearerAuth"
+	APIKeyAuthScheme = "ApiKeyAuth"
+)
+
+// APIKeyHeader is the header of the ApiKeyAuth security scheme.
+const APIKeyHeader = "X-API-Key"
+
 // NewAuthenticator creates a function that can be used as an authentication
-// function in openapi3filter.Options. It takes a JWSValidator as input and
-// returns an authentication function that calls Authenticate. The Authenticate
-// function validates the security scheme name, gets the JWS from the request,
-// validates the JWS, checks the token claims against the expected claims, sets
-// the JWT claims on the request context, and returns an error if any of these
-// steps fail.
-func NewAuthenticator(v JWSValidator) openapi3filter.AuthenticationFunc {
+// function in openapi3filter.Options. It takes a JWSValidator and an
+// APIKeyValidator as input and returns an authentication function that calls
+// Authenticate. The Authenticate function validates the security scheme name,
+// gets the JWS or API key from the request, validates it, checks the token
+// claims against the expected claims, sets the JWT claims on the request
+// context, and returns an error if any of these steps fail. Tokens whose ID is
+// in revoked are rejected; keys and revoked may be nil.
+func NewAuthenticator(v JWSValidator, keys APIKeyValidator, revoked Denylist) openapi3filter.AuthenticationFunc {
 	return func(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
-		return Authenticate(v, ctx, input)
+		return Authenticate(v, keys, revoked, ctx, input)
 	}
 }
 
-// Authenticate uses the specified validator to ensure a JWT is valid, then makes
-// sure that the claims provided by the JWT match the scopes as required in the API.
-func Authenticate(v JWSValidator, ctx context.Context, input *openapi3filter.AuthenticationInput) error {
-	// Our security scheme is named BearerAuth, ensure this is the case
-	if input.SecuritySchemeName != "BearerAuth" {
-		return fmt.Errorf("security scheme %s != 'BearerAuth'", input.SecuritySchemeName)
-	}
-
-	// Now, we need to get the JWS from the request, to match the request expectations
-	// against request contents.
-	jws, err := GetJWSFromRequest(input.RequestValidationInput.Request)
-	if err != nil {
-		return fmt.Errorf("getting jws: %w", err)
+// Authenticate checks the credentials of the security scheme of input, a JWT
+// for BearerAuth or an API key for ApiKeyAuth, then makes sure that the scopes
+// they grant match the scopes as required in the API. Either way the handlers
+// find a token in the context naming who made the request in its subject; for
+// an API key it is made up from the key and never signed.
+//
+// Refresh tokens and tokens whose ID is in revoked, unless it is nil, are
+// rejected. Missing, invalid or revoked credentials are returned as a 401
+// echo.HTTPError, and credentials lacking a required scope as a 403, which the
+// request validator passes on as they are. When a request only carries the
+// credentials of the other scheme, a plain error is returned instead, so that
+// the validator reports why those were rejected.
+func Authenticate(v JWSValidator, keys APIKeyValidator, revoked Denylist, ctx context.Context, input *openapi3filter.AuthenticationInput) error {
+	var token jwt.Token
+	var err error
+	req := input.RequestValidationInput.Request
+	switch input.SecuritySchemeName {
+	case BearerAuthScheme:
+		if req.Header.Get("Authorization") == "" && req.Header.Get(APIKeyHeader) != "" {
+			return ErrNoAuthHeader
+		}
+		token, err = authenticateBearer(v, revoked, req)
+	case APIKeyAuthScheme:
+		if req.Header.Get(APIKeyHeader) == "" && req.Header.Get("Authorization") != "" {
+			return ErrNoAPIKey
+		}
+		token, err = authenticateAPIKey(ctx, keys, req)
+	default:
+		return fmt.Errorf("security scheme %s is neither %s nor %s", input.SecuritySchemeName, BearerAuthScheme, APIKeyAuthScheme)
 	}
-
-	// if the JWS is valid, we have a JWT, which will contain a bunch of claims.
-	token, err := v.ValidateJWS(jws)
 	if err != nil {
-		return fmt.Errorf("validating JWS: %w", err)
+		return err
 	}
 
 	// We've got a valid token now, and we can look into its claims to see whether
@@ -86,7 +142,7 @@ func Authenticate(v JWSValidator, ctx context.Context, input *openapi3filter.Aut
 	err = CheckTokenClaims(input.Scopes, token)
 
 	if err != nil {
-		return fmt.Errorf("token claims don't match: %w", err)
+		return httpError(http.StatusForbidden, fmt.Errorf("token claims don't match: %w", err))
 	}
 
 	// Set the property on the echo context so the handler is able to
@@ -97,6 +153,75 @@ func Authenticate(v JWSValidator, ctx context.Context, input *openapi3filter.Aut
 	return nil
 }
 
+// authenticateBearer returns the access token of the Authorization header of
+// req unless it is missing, invalid, a refresh token or revoked.
+func authenticateBearer(v JWSValidator, revoked Denylist, req *http.Request) (jwt.Token, error) {
+	// Now, we need to get the JWS from the request, to match the request expectations
+	// against request contents.
+	jws, err := GetJWSFromRequest(req)
+	if err != nil {
+		return nil, httpError(http.StatusUnauthorized, fmt.Errorf("getting jws: %w", err))
+	}
+
+	// if the JWS is valid, we have a JWT, which will contain a bunch of claims.
+	token, err := v.ValidateJWS(jws)
+	if err != nil {
+		return nil, httpError(http.StatusUnauthorized, fmt.Errorf("validating JWS: %w", err))
+	}
+	if TokenUse(token) != AccessTokenUse {
+		return nil, httpError(http.StatusUnauthorized, ErrNotAccessToken)
+	}
+	if revoked != nil && revoked.IsRevoked(token.JwtID()) {
+		return nil, httpError(http.StatusUnauthorized, ErrTokenRevoked)
+	}
+	return token, nil
+}
+
+// authenticateAPIKey validates the API key of req and returns an unsigned
+// token with the subject and scopes of the key.
+func authenticateAPIKey(ctx context.Context, keys APIKeyValidator, req *http.Request) (jwt.Token, error) {
+	key := req.Header.Get(APIKeyHeader)
+	if key == "" {
+		return nil, httpError(http.StatusUnauthorized, ErrNoAPIKey)
+	}
+	if keys == nil {
+		return nil, httpError(http.StatusUnauthorized, errors.New("API keys are not accepted"))
+	}
+	subject, scopes, err := keys.ValidateAPIKey(ctx, key)
+	if err != nil {
+		return nil, httpError(http.StatusUnauthorized, fmt.Errorf("validating API key: %w", err))
+	}
+	perms := make([]interface{}, len(scopes))
+	for i, scope := range scopes {
+		perms[i] = scope
+	}
+	token := jwt.New()
+	if err := token.Set(jwt.SubjectKey, subject); err != nil {
+		return nil, fmt.Errorf("setting subject: %w", err)
+	}
+	if err := token.Set(PermissionsClaim, perms); err != nil {
+		return nil, fmt.Errorf("setting permissions: %w", err)
+	}
+	return token, nil
+}
+
+// httpError returns err as an echo.HTTPError with the status code, so that it
+// can still be matched with errors.Is.
+func httpError(code int, err error) *echo.HTTPError {
+	return &echo.HTTPError{Code: code, Message: err.Error(), Internal: err}
+}
+
+// TokenUse returns the use of t, access or refresh. Tokens that do not say
+// are access tokens.
+func TokenUse(t jwt.Token) string {
+	use, ok := t.Get(TokenUseClaim)
+	if !ok {
+		return AccessTokenUse
+	}
+	s, _ := use.(string)
+	return s
+}
+
 // GetClaimsFromToken returns a list of claims from the token. We store these
 // as a list under the "perms" claim, short for permissions, to keep the token
 // shorter.
@@ -133,7 +258,7 @@ func GetClaimsFromToken(t jwt.Token) ([]string, error) {
 // extraction fails, it returns an error detailing the failure to get claims. Then, it
 // checks whether each of the expected claims is present in the token's claims. If any of
 // the expected claims are missing, it returns an ErrClaimsInvalid error indicating that
-// the token does not have the required claims.
+// the token does not have the required claims, naming the ones it lacks.
 func CheckTokenClaims(expectedClaims []string, t jwt.Token) error {
 	claims, err := GetClaimsFromToken(t)
 	if err != nil {
@@ -145,10 +270,14 @@ func CheckTokenClaims(expectedClaims []string, t jwt.Token) error {
 		claimsMap[c] = true
 	}
 
+	var missing []string
 	for _, e := range expectedClaims {
 		if !claimsMap[e] {
-			return ErrClaimsInvalid
+			missing = append(missing, e)
 		}
 	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: missing %s", ErrClaimsInvalid, strings.Join(missing, ", "))
+	}
 	return nil
 }
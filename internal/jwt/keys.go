@@ -0,0 +1,81 @@
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// ParsePrivateKeys parses every PEM block in data as an ECDSA private key,
+// in SEC 1 ("EC PRIVATE KEY") or PKCS #8 ("PRIVATE KEY") form, such as the
+// ones generated with:
+//
+//	openssl ecparam -name prime256v1 -genkey -noout -out signing-key.pem
+//
+// Other blocks, like the EC PARAMETERS openssl writes without -noout, are
+// skipped.
+func ParsePrivateKeys(data []byte) ([]*ecdsa.PrivateKey, error) {
+	var keys []*ecdsa.PrivateKey
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		switch block.Type {
+		case "EC PRIVATE KEY":
+			key, err := x509.ParseECPrivateKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("parsing EC private key: %w", err)
+			}
+			keys = append(keys, key)
+		case "PRIVATE KEY":
+			key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("parsing PKCS #8 private key: %w", err)
+			}
+			ecKey, ok := key.(*ecdsa.PrivateKey)
+			if !ok {
+				return nil, fmt.Errorf("%T is not an ECDSA private key", key)
+			}
+			keys = append(keys, ecKey)
+		}
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no ECDSA private key in PEM data")
+	}
+	return keys, nil
+}
+
+// LoadPrivateKeyFiles reads the private keys in the PEM files at paths, in
+// order.
+func LoadPrivateKeyFiles(paths ...string) ([]*ecdsa.PrivateKey, error) {
+	var keys []*ecdsa.PrivateKey
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading signing key: %w", err)
+		}
+		fileKeys, err := ParsePrivateKeys(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		keys = append(keys, fileKeys...)
+	}
+	return keys, nil
+}
+
+// GenerateKey returns a new P-256 key, for servers that were not given one.
+// Tokens it signs cannot be validated once the process exits.
+func GenerateKey() (*ecdsa.PrivateKey, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generating signing key: %w", err)
+	}
+	return key, nil
+}
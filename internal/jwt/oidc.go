@@ -0,0 +1,249 @@
+package jwt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/lestrrat-go/jwx/jws"
+	"github.com/lestrrat-go/jwx/jwt"
+)
+
+const (
+	// DefaultOIDCClaim is the claim of external tokens mapped onto scopes
+	// unless configured with WithClaimMapping.
+	DefaultOIDCClaim = "groups"
+	// DefaultJWKSCacheTTL is how long the keys of the identity provider are
+	// used before they are fetched again, unless configured with
+	// WithJWKSCacheTTL.
+	DefaultJWKSCacheTTL = time.Hour
+	// jwksMinRefresh is how long after a fetch a token signed with an
+	// unknown key does not trigger another one, so that made up kids cannot
+	// be used to hammer the identity provider.
+	jwksMinRefresh = 10 * time.Second
+)
+
+// ErrUnknownKey is returned for tokens signed with a key the identity provider
+// does not publish.
+var ErrUnknownKey = errors.New("token is signed with an unknown key")
+
+// OIDCValidator validates the tokens of an external OpenID Connect identity
+// provider, such as a company SSO, so that they can be used instead of the
+// tokens issued by /auth/login. The keys are found through the discovery
+// document of the issuer and cached. The provider knows nothing about our
+// scopes, so the values of a claim such as groups are mapped onto them and
+// the result replaces the perm claim of the token.
+type OIDCValidator struct {
+	// Issuer and Audience are the iss and aud tokens must have.
+	Issuer   string
+	Audience string
+	jwksURI  string
+	client   *http.Client
+	// claim is mapped onto scopes through scopes.
+	claim  string
+	scopes map[string][]string
+	// subjectClaim replaces the subject of tokens, unless it is empty.
+	subjectClaim string
+	cacheTTL     time.Duration
+
+	m       sync.Mutex
+	keys    jwk.Set
+	fetched time.Time
+}
+
+var _ JWSValidator = (*OIDCValidator)(nil)
+
+// WithHTTPClient sets the client the discovery document and the keys are
+// fetched with.
+func WithHTTPClient(client *http.Client) func(*OIDCValidator) {
+	return func(o *OIDCValidator) {
+		o.client = client
+	}
+}
+
+// WithClaimMapping maps the values of claim onto scopes: a token gets the
+// scopes of every value it has, and none for values that are not in scopes.
+// The claim may hold a list of strings or a string of space separated values.
+func WithClaimMapping(claim string, scopes map[string][]string) func(*OIDCValidator) {
+	return func(o *OIDCValidator) {
+		o.claim = claim
+		o.scopes = scopes
+	}
+}
+
+// WithSubjectClaim makes the value of claim, for example preferred_username
+// or email, the subject of tokens, which is who the ledger records as having
+// made a change. Without it the sub of the identity provider is kept, which is
+// often an opaque ID.
+func WithSubjectClaim(claim string) func(*OIDCValidator) {
+	return func(o *OIDCValidator) {
+		o.subjectClaim = claim
+	}
+}
+
+// WithJWKSCacheTTL sets how long the keys of the identity provider are used
+// before they are fetched again.
+func WithJWKSCacheTTL(ttl time.Duration) func(*OIDCValidator) {
+	return func(o *OIDCValidator) {
+		o.cacheTTL = ttl
+	}
+}
+
+// NewOIDCValidator creates a validator for the tokens issued by issuer for
+// audience. It fetches the discovery document of issuer and the keys it
+// points at, so that a misconfigured provider is noticed at startup.
+func NewOIDCValidator(ctx context.Context, issuer, audience string, options ...func(*OIDCValidator)) (*OIDCValidator, error) {
+	if issuer == "" || audience == "" {
+		return nil, errors.New("an OIDC issuer and audience are required")
+	}
+	o := &OIDCValidator{
+		Issuer:   issuer,
+		Audience: audience,
+		client:   &http.Client{Timeout: 10 * time.Second},
+		claim:    DefaultOIDCClaim,
+		cacheTTL: DefaultJWKSCacheTTL,
+	}
+	for _, option := range options {
+		option(o)
+	}
+	if err := o.discover(ctx); err != nil {
+		return nil, err
+	}
+	keys, err := jwk.Fetch(ctx, o.jwksURI, jwk.WithHTTPClient(o.client))
+	if err != nil {
+		return nil, fmt.Errorf("fetching keys from %s: %w", o.jwksURI, err)
+	}
+	o.keys = keys
+	o.fetched = time.Now()
+	return o, nil
+}
+
+// discover reads the jwks_uri from the discovery document of the issuer,
+// which must name the same issuer.
+func (o *OIDCValidator) discover(ctx context.Context) error {
+	url := strings.TrimSuffix(o.Issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("creating discovery request: %w", err)
+	}
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("fetching %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("fetching %s: %s", url, resp.Status)
+	}
+	var doc struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return fmt.Errorf("decoding %s: %w", url, err)
+	}
+	if doc.Issuer != o.Issuer {
+		return fmt.Errorf("discovery document is for issuer %q, not %q", doc.Issuer, o.Issuer)
+	}
+	if doc.JWKSURI == "" {
+		return fmt.Errorf("discovery document of %s has no jwks_uri", o.Issuer)
+	}
+	o.jwksURI = doc.JWKSURI
+	return nil
+}
+
+// keySet returns the cached keys of the identity provider, fetching them
+// again once they are older than the cache TTL or when kid is not among them,
+// which is how a rotated key is picked up. When the fetch fails the cached
+// keys are used, so that an unreachable provider does not lock everyone out
+// until the tokens expire.
+func (o *OIDCValidator) keySet(kid string) jwk.Set {
+	o.m.Lock()
+	defer o.m.Unlock()
+	age := time.Since(o.fetched)
+	_, known := o.keys.LookupKeyID(kid)
+	if age < o.cacheTTL && (known || kid == "" || age < jwksMinRefresh) {
+		return o.keys
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	keys, err := jwk.Fetch(ctx, o.jwksURI, jwk.WithHTTPClient(o.client))
+	o.fetched = time.Now()
+	if err == nil {
+		o.keys = keys
+	}
+	return o.keys
+}
+
+// ValidateJWS checks the signature of the token with the keys of the identity
+// provider, its issuer, audience and expiry, and returns it with the scopes
+// mapped from its claim in the perm claim, replacing any it had.
+func (o *OIDCValidator) ValidateJWS(jwsString string) (jwt.Token, error) {
+	msg, err := jws.Parse([]byte(jwsString))
+	if err != nil {
+		return nil, fmt.Errorf("parsing JWS: %w", err)
+	}
+	if len(msg.Signatures()) != 1 {
+		return nil, errors.New("token must have exactly one signature")
+	}
+	kid := msg.Signatures()[0].ProtectedHeaders().KeyID()
+	keys := o.keySet(kid)
+	if _, ok := keys.LookupKeyID(kid); kid != "" && !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownKey, kid)
+	}
+	t, err := jwt.Parse([]byte(jwsString), jwt.WithKeySet(keys),
+		jwt.UseDefaultKey(true), jwt.InferAlgorithmFromKey(true), jwt.WithValidate(true),
+		jwt.WithAudience(o.Audience), jwt.WithIssuer(o.Issuer),
+		jwt.WithRequiredClaim(jwt.ExpirationKey), jwt.WithAcceptableSkew(clockSkew))
+	if err != nil {
+		return nil, err
+	}
+	if err := t.Set(PermissionsClaim, o.mapScopes(t)); err != nil {
+		return nil, fmt.Errorf("setting permissions: %w", err)
+	}
+	if o.subjectClaim != "" {
+		raw, _ := t.Get(o.subjectClaim)
+		subject, _ := raw.(string)
+		if subject == "" {
+			return nil, fmt.Errorf("token has no %s claim", o.subjectClaim)
+		}
+		if err := t.Set(jwt.SubjectKey, subject); err != nil {
+			return nil, fmt.Errorf("setting subject: %w", err)
+		}
+	}
+	return t, nil
+}
+
+// mapScopes returns the scopes of the values of the mapped claim of t, each
+// once and in the order they were first granted. They are returned the way
+// the perm claim of a parsed token holds them, for GetClaimsFromToken.
+func (o *OIDCValidator) mapScopes(t jwt.Token) []interface{} {
+	var values []string
+	claim, _ := t.Get(o.claim)
+	switch raw := claim.(type) {
+	case string:
+		values = strings.Fields(raw)
+	case []interface{}:
+		for _, v := range raw {
+			if s, ok := v.(string); ok {
+				values = append(values, s)
+			}
+		}
+	}
+	scopes := make([]interface{}, 0)
+	seen := make(map[string]bool)
+	for _, value := range values {
+		for _, scope := range o.scopes[value] {
+			if !seen[scope] {
+				seen[scope] = true
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+	return scopes
+}
@@ -0,0 +1,98 @@
+package server
+
+import (
+	"colaco-api/internal/api/v1"
+	"colaco-api/internal/jwt"
+	"colaco-api/svc"
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// apiKeyValidator validates API keys against the keys of the store, for
+// jwt.Authenticate.
+type apiKeyValidator struct {
+	keys svc.APIKeyStore
+}
+
+var _ jwt.APIKeyValidator = apiKeyValidator{}
+
+// ValidateAPIKey implements jwt.APIKeyValidator. Requests made with a key are
+// recorded as made by svc.APIKeyActor of its ID.
+func (a apiKeyValidator) ValidateAPIKey(ctx context.Context, key string) (string, []string, error) {
+	record, err := svc.CheckAPIKey(ctx, a.keys, key)
+	if err != nil {
+		return "", nil, err
+	}
+	return svc.APIKeyActor(record.Id), record.Scopes, nil
+}
+
+// apiKeyErrorStatus extends storageErrorStatus with the errors of the API
+// keys.
+func apiKeyErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, svc.ErrAPIKeyNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, svc.ErrInvalidAPIKey):
+		return http.StatusBadRequest
+	}
+	return storageErrorStatus(err)
+}
+
+// GetApiKeys lists the API keys, without their hashes.
+func (v *VendingMachine) GetApiKeys(ctx echo.Context) error {
+	records, err := v.APIKeys.GetAPIKeys(ctx.Request().Context())
+	if err != nil {
+		return problem(ctx, apiKeyErrorStatus(err), err.Error())
+	}
+	keys := make([]v1.APIKey, 0, len(records))
+	for _, r := range records {
+		keys = append(keys, r.APIKey)
+	}
+	return ctx.JSON(http.StatusOK, v1.APIKeysResponse{ApiKeys: keys})
+}
+
+// CreateApiKey creates an API key with the given scopes on behalf of the user
+// making the request. The key is in the response and nowhere else; the audit
+// trail records the key without it.
+func (v *VendingMachine) CreateApiKey(ctx echo.Context) error {
+	var body v1.CreateApiKeyJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return bindError(ctx, err)
+	}
+	key, record, err := svc.NewAPIKey(body.Name, body.Scopes, actor(ctx), body.ExpiresAt)
+	if err != nil {
+		return problem(ctx, apiKeyErrorStatus(err), err.Error())
+	}
+	if err := v.APIKeys.CreateAPIKey(ctx.Request().Context(), record); err != nil {
+		return problem(ctx, apiKeyErrorStatus(err), err.Error())
+	}
+	if err := v.audit(ctx, opCreateAPIKey, record.Id, nil, record.APIKey); err != nil {
+		return problem(ctx, http.StatusInternalServerError, err.Error())
+	}
+	return ctx.JSON(http.StatusCreated, v1.CreatedAPIKeyResponse{ApiKey: record.APIKey, Key: key})
+}
+
+// RevokeApiKey revokes the API key, keeping the time it was first revoked,
+// which is recorded in the audit trail.
+func (v *VendingMachine) RevokeApiKey(ctx echo.Context, keyId string) error {
+	var before v1.APIKey
+	record, err := v.APIKeys.UpdateAPIKey(ctx.Request().Context(), keyId, func(key *svc.APIKeyRecord) error {
+		before = key.APIKey
+		if key.RevokedAt == nil {
+			now := time.Now().UTC()
+			key.RevokedAt = &now
+		}
+		return nil
+	})
+	if err != nil {
+		return problem(ctx, apiKeyErrorStatus(err), err.Error())
+	}
+	if err := v.audit(ctx, opRevokeAPIKey, record.Id, before, record.APIKey); err != nil {
+		return problem(ctx, http.StatusInternalServerError, err.Error())
+	}
+	return ctx.JSON(http.StatusOK, record.APIKey)
+}
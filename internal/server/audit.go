@@ -0,0 +1,105 @@
+package server
+
+import (
+	"colaco-api/internal/api/v1"
+	"colaco-api/svc"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	defaultAuditPage = 100
+	maxAuditPage     = 1000
+)
+
+// The operations recorded in the audit trail, named by their operationId in
+// the api.yml of their version.
+const (
+	opPostNew           = "post-new"
+	opRestockSoda       = "restockSoda"
+	opUpdatePrice       = "updatePrice"
+	opDeleteVending     = "delete-vending"
+	opCreateUser        = "create-user"
+	opDisableUser       = "disable-user"
+	opEnableUser        = "enable-user"
+	opResetUserPassword = "reset-user-password"
+	opSetUserRole       = "set-user-role"
+	opCreateAPIKey      = "create-api-key"
+	opRevokeAPIKey      = "revoke-api-key"
+	opClearLoginLockout = "clear-login-lockout"
+	opPutSlot           = "put-slot"
+	opPatchSlot         = "patch-slot"
+	opDeleteSlot        = "delete-slot"
+	opRestockSlot       = "restock-slot"
+	opPutPlanogram      = "put-planogram"
+	opFillCashBox       = "fill-cash-box"
+	opEmptyCashBox      = "empty-cash-box"
+	opCloseDay          = "close-day"
+
+	// opLoginLockout is a lockout started by the login throttle, which has
+	// no operation of its own.
+	opLoginLockout = "login-lockout"
+)
+
+// requestID returns the ID the RequestID middleware gave the request, or the
+// one the client sent when the middleware is not in use.
+func requestID(ctx echo.Context) string {
+	if id := ctx.Response().Header().Get(echo.HeaderXRequestID); id != "" {
+		return id
+	}
+	return ctx.Request().Header.Get(echo.HeaderXRequestID)
+}
+
+// audit appends a record of operation on target, with its state before and
+// after the call, to the audit trail on behalf of the user making the
+// request. Like record, it is called once the change has been made, so it
+// is recorded even if the client went away meanwhile, and failing to record
+// it is logged and returned as errUnrecorded.
+func (v *VendingMachine) audit(ctx echo.Context, operation, target string, before, after any) error {
+	rec := svc.NewAuditRecord(operation, target, before, after)
+	rec.Subject = actor(ctx)
+	rec.SourceIp = ctx.RealIP()
+	rec.RequestId = requestID(ctx)
+	if _, err := v.Audit.AppendAuditRecord(context.WithoutCancel(ctx.Request().Context()), rec); err != nil {
+		log.Printf("recording %s of %q by %s in the audit trail: %v", operation, target, rec.Subject, err)
+		return fmt.Errorf("%w in the audit trail", errUnrecorded)
+	}
+	return nil
+}
+
+// GetAuditTrail lists one page of the audit trail, oldest first. The cursor
+// of the next page is the number of records listed so far, which is the Seq
+// of the last record on this one unless the trail was tampered with, and is
+// only returned while more records follow.
+func (v *VendingMachine) GetAuditTrail(ctx echo.Context, params v1.GetAuditTrailParams) error {
+	limit := defaultAuditPage
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit < 1 || limit > maxAuditPage {
+		return problem(ctx, http.StatusBadRequest, "limit must be between 1 and 1000")
+	}
+	var offset int64
+	if params.Cursor != nil {
+		var err error
+		offset, err = strconv.ParseInt(*params.Cursor, 10, 64)
+		if err != nil || offset < 0 {
+			return problem(ctx, http.StatusBadRequest, "invalid cursor")
+		}
+	}
+	records, err := v.Audit.GetAuditRecords(ctx.Request().Context(), offset, limit+1)
+	if err != nil {
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	}
+	resp := v1.AuditTrailResponse{Records: records}
+	if len(records) > limit {
+		resp.Records = records[:limit]
+		resp.NextCursor = s2ptr(strconv.FormatInt(offset+int64(limit), 10))
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"colaco-api/internal/api/v1"
+	"colaco-api/internal/jwt"
+	"colaco-api/svc"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	jwtx "github.com/lestrrat-go/jwx/jwt"
+)
+
+// issueTokens returns a new access token for user, with the scopes of their
+// role, together with a refresh token.
+func (v *VendingMachine) issueTokens(ctx echo.Context, user svc.UserRecord) error {
+	now := time.Now()
+	access, err := v.auth.CreateJWSForSubject(user.Username, svc.RoleScopes(svc.UserRole(user.User)))
+	if err != nil {
+		return problem(ctx, http.StatusInternalServerError, "Failed to sign token")
+	}
+	refresh, err := v.auth.CreateRefreshJWS(user.Username)
+	if err != nil {
+		return problem(ctx, http.StatusInternalServerError, "Failed to sign token")
+	}
+	expiresAt := now.Add(v.auth.AccessTokenTTL).UTC()
+	refreshExpiresAt := now.Add(v.auth.RefreshTokenTTL).UTC()
+	return ctx.JSON(http.StatusOK, v1.AuthTokenResponse{
+		Token:            s2ptr(string(access)),
+		ExpiresAt:        &expiresAt,
+		RefreshToken:     s2ptr(string(refresh)),
+		RefreshExpiresAt: &refreshExpiresAt,
+	})
+}
+
+// validRefreshToken returns the refresh token in jws unless it is invalid,
+// expired, revoked or an access token.
+func (v *VendingMachine) validRefreshToken(jws string) (jwtx.Token, bool) {
+	token, err := v.auth.ValidateJWS(jws)
+	if err != nil || jwt.TokenUse(token) != jwt.RefreshTokenUse || v.revoked.IsRevoked(token.JwtID()) {
+		return nil, false
+	}
+	return token, true
+}
+
+// AuthRefresh exchanges a refresh token for a new access token and refresh
+// token. The scopes of the access token follow the current role of the user,
+// and a user who was disabled or removed gets 403 or 401. The refresh token is
+// revoked by the exchange, so a second exchange of the same token, which means
+// it was stolen or replayed, gets 401.
+func (v *VendingMachine) AuthRefresh(ctx echo.Context) error {
+	var body v1.AuthRefreshJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return problem(ctx, http.StatusBadRequest, "Invalid request")
+	}
+	token, ok := v.validRefreshToken(body.RefreshToken)
+	if !ok {
+		return problem(ctx, http.StatusUnauthorized, "Invalid refresh token")
+	}
+	user, err := v.Users.GetUser(ctx.Request().Context(), token.Subject())
+	switch {
+	case errors.Is(err, svc.ErrUserNotFound):
+		return problem(ctx, http.StatusUnauthorized, "Invalid refresh token")
+	case err != nil:
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	case user.Disabled:
+		return problem(ctx, http.StatusForbidden, "User is disabled")
+	}
+	if !v.revoked.Revoke(token.JwtID(), token.Expiration()) {
+		return problem(ctx, http.StatusUnauthorized, "Invalid refresh token")
+	}
+	return v.issueTokens(ctx, user)
+}
+
+// AuthLogout revokes the access token the request was authorized with, and
+// the refresh token in the body if it was issued to the same user. Anything
+// else in the body is ignored, so that nobody can revoke the tokens of
+// someone else. Tokens of an external identity provider without an ID cannot
+// be revoked here; they stay valid until they expire.
+func (v *VendingMachine) AuthLogout(ctx echo.Context) error {
+	access, ok := ctx.Get(jwt.JWTClaimsContextKey).(jwtx.Token)
+	if !ok {
+		return problem(ctx, http.StatusUnauthorized, "Not logged in")
+	}
+	var body v1.AuthLogoutJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return problem(ctx, http.StatusBadRequest, "Invalid request")
+	}
+	if access.JwtID() != "" {
+		v.revoked.Revoke(access.JwtID(), access.Expiration())
+	}
+	if body.RefreshToken != nil {
+		if refresh, ok := v.validRefreshToken(*body.RefreshToken); ok && refresh.Subject() == access.Subject() {
+			v.revoked.Revoke(refresh.JwtID(), refresh.Expiration())
+		}
+	}
+	return ctx.JSON(http.StatusOK, genMessageResponse("Logged out"))
+}
+
+// GetJwks publishes the public keys tokens are validated with, so that other
+// services can verify them. Caches may keep the set for a few minutes, which
+// is why a new key must be published before it signs any token.
+func (v *VendingMachine) GetJwks(ctx echo.Context) error {
+	ctx.Response().Header().Set("Cache-Control", "public, max-age=300")
+	return ctx.JSON(http.StatusOK, v.auth.KeySet)
+}
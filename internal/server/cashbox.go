@@ -0,0 +1,141 @@
+package server
+
+import (
+	"colaco-api/internal/api/v1"
+	"colaco-api/svc"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// cashBoxTarget is the target of the cash box operations in the audit trail.
+const cashBoxTarget = "cashbox"
+
+// cashBoxErrorStatus extends storageErrorStatus with the errors of filling
+// and emptying the cash box.
+func cashBoxErrorStatus(err error) int {
+	if errors.Is(err, svc.ErrInsufficientCash) {
+		return http.StatusConflict
+	}
+	return storageErrorStatus(err)
+}
+
+// GetCashBox returns the coins and bills in the cash box.
+func (v *VendingMachine) GetCashBox(ctx echo.Context) error {
+	box, err := v.Store.GetCashBox(ctx.Request().Context())
+	if err != nil {
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	}
+	return ctx.JSON(http.StatusOK, box)
+}
+
+// FillCashBox adds coins and bills to the cash box. A currency may be given
+// to switch an empty cash box to it; switching a cash box that still holds
+// money is a conflict. The cash box before and after is recorded in the
+// audit trail.
+func (v *VendingMachine) FillCashBox(ctx echo.Context) error {
+	var body v1.FillCashBoxJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return bindError(ctx, err)
+	}
+	if err := svc.ValidateDenominations(body.Denominations); err != nil {
+		return problem(ctx, http.StatusBadRequest, err.Error())
+	}
+	currency := strings.ToUpper(deref(body.Currency))
+	if body.Currency != nil && len(currency) != 3 {
+		return problem(ctx, http.StatusBadRequest, fmt.Sprintf("%q is not a currency code", currency))
+	}
+	var before v1.CashBox
+	box, err := v.Store.UpdateCashBox(ctx.Request().Context(), func(box *v1.CashBox) error {
+		before = svc.NormalizeCashBox(*box)
+		if currency != "" && currency != box.Currency {
+			if len(box.Denominations) > 0 {
+				return fmt.Errorf("%w: the cash box holds %s, empty it before switching to %s",
+					svc.ErrConflict, svc.FormatMoney(*box.Total), currency)
+			}
+			box.Currency = currency
+		}
+		return svc.AddCash(box, body.Denominations)
+	})
+	if err != nil {
+		return problem(ctx, cashBoxErrorStatus(err), err.Error())
+	}
+	if err := v.audit(ctx, opFillCashBox, cashBoxTarget, before, box); err != nil {
+		return problem(ctx, http.StatusInternalServerError, err.Error())
+	}
+	return ctx.JSON(http.StatusOK, box)
+}
+
+// EmptyCashBox takes the listed coins and bills out of the cash box, or all
+// of them when none are listed. Taking out more than the cash box holds is a
+// conflict and takes out nothing. The cash box before and after is recorded
+// in the audit trail.
+func (v *VendingMachine) EmptyCashBox(ctx echo.Context) error {
+	var body v1.EmptyCashBoxJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return bindError(ctx, err)
+	}
+	var take []v1.Denomination
+	if body.Denominations != nil {
+		take = *body.Denominations
+	}
+	if err := svc.ValidateDenominations(take); err != nil {
+		return problem(ctx, http.StatusBadRequest, err.Error())
+	}
+	var before v1.CashBox
+	box, err := v.Store.UpdateCashBox(ctx.Request().Context(), func(box *v1.CashBox) error {
+		before = svc.NormalizeCashBox(*box)
+		if len(take) == 0 {
+			box.Denominations = nil
+			return nil
+		}
+		return svc.RemoveCash(box, take)
+	})
+	if err != nil {
+		return problem(ctx, cashBoxErrorStatus(err), err.Error())
+	}
+	if err := v.audit(ctx, opEmptyCashBox, cashBoxTarget, before, box); err != nil {
+		return problem(ctx, http.StatusInternalServerError, err.Error())
+	}
+	return ctx.JSON(http.StatusOK, box)
+}
+
+// giveChange puts the inserted coins and bills into the cash box and takes
+// change out of it, returning the coins and bills of the change. When the
+// cash box cannot make the change it is left as it was and an error wrapping
+// svc.ErrExactChangeOnly is returned.
+func (v *VendingMachine) giveChange(ctx context.Context, inserted []v1.Denomination, change v1.Money) ([]v1.Denomination, error) {
+	var coins []v1.Denomination
+	_, err := v.Store.UpdateCashBox(ctx, func(box *v1.CashBox) error {
+		if !strings.EqualFold(box.Currency, change.Currency) {
+			return fmt.Errorf("%w: the cash box holds %s, the change is in %s", svc.ErrCurrencyMismatch, box.Currency, change.Currency)
+		}
+		if err := svc.AddCash(box, inserted); err != nil {
+			return err
+		}
+		var err error
+		if coins, err = svc.MakeChange(*box, change.Amount); err != nil {
+			return err
+		}
+		return svc.RemoveCash(box, coins)
+	})
+	return coins, err
+}
+
+// undispense puts back the soda sold from slot when the purchase could not
+// be completed after the decrement.
+func (v *VendingMachine) undispense(ctx context.Context, slot v1.VendingSlot) {
+	_, err := v.Store.UpdateSlot(ctx, svc.SlotID(slot), func(slot *v1.VendingSlot) error {
+		qty := *slot.Quantity + 1
+		slot.Quantity = &qty
+		return nil
+	})
+	if err != nil {
+		log.Printf("putting back the soda sold from slot %q: %v", svc.SlotID(slot), err)
+	}
+}
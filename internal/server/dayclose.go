@@ -0,0 +1,102 @@
+package server
+
+import (
+	"colaco-api/internal/api/v1"
+	"colaco-api/svc"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// dayCloseErrorStatus extends storageErrorStatus with the errors of closing
+// periods and looking them up.
+func dayCloseErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, svc.ErrPeriodClosed):
+		return http.StatusConflict
+	case errors.Is(err, svc.ErrPeriodNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, svc.ErrCurrencyMismatch):
+		return http.StatusBadRequest
+	}
+	return storageErrorStatus(err)
+}
+
+// CloseDay closes the current period, which covers the ledger transactions
+// recorded since the previous close, and returns its end-of-day report. The
+// cash counted by the admin is reconciled against the total of the cash box.
+// The report is stored once and never changed; if another close was stored
+// first the period it covers is already closed and 409 is returned. The
+// report is recorded in the audit trail with the period's ID as target.
+func (v *VendingMachine) CloseDay(ctx echo.Context) error {
+	var body v1.CloseDayJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return bindError(ctx, err)
+	}
+	if body.CountedCash.Amount < 0 {
+		return problem(ctx, http.StatusBadRequest, "counted cash cannot be negative")
+	}
+	body.CountedCash.Currency = strings.ToUpper(body.CountedCash.Currency)
+
+	rctx := ctx.Request().Context()
+	closes, err := v.Store.GetDayCloses(rctx)
+	if err != nil {
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	}
+	var previous *v1.DayClose
+	filter := svc.TransactionFilter{}
+	if len(closes) > 0 {
+		previous = &closes[len(closes)-1]
+		filter.After = previous.ThroughTransactionId
+	}
+	txs, err := v.Store.GetTransactions(rctx, filter)
+	if err != nil {
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	}
+	slots, err := v.Store.GetSlots(rctx)
+	if err != nil {
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	}
+	box, err := v.Store.GetCashBox(rctx)
+	if err != nil {
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	}
+
+	report, err := svc.NewDayClose(previous, txs, slots, box, body.CountedCash, time.Now())
+	if err != nil {
+		return problem(ctx, dayCloseErrorStatus(err), err.Error())
+	}
+	report.ClosedBy = actor(ctx)
+	report.Note = body.Note
+	stored, err := v.Store.AppendDayClose(rctx, report)
+	if err != nil {
+		return problem(ctx, dayCloseErrorStatus(err), err.Error())
+	}
+	if err := v.audit(ctx, opCloseDay, strconv.FormatInt(*stored.Id, 10), nil, stored); err != nil {
+		return problem(ctx, http.StatusInternalServerError, err.Error())
+	}
+	return ctx.JSON(http.StatusOK, stored)
+}
+
+// GetDayCloses lists the end-of-day reports of the closed periods, oldest
+// first.
+func (v *VendingMachine) GetDayCloses(ctx echo.Context) error {
+	closes, err := v.Store.GetDayCloses(ctx.Request().Context())
+	if err != nil {
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	}
+	return ctx.JSON(http.StatusOK, v1.DayClosesResponse{Periods: closes})
+}
+
+// GetDayClose returns the end-of-day report of one closed period.
+func (v *VendingMachine) GetDayClose(ctx echo.Context, periodId int64) error {
+	report, err := v.Store.GetDayClose(ctx.Request().Context(), periodId)
+	if err != nil {
+		return problem(ctx, dayCloseErrorStatus(err), err.Error())
+	}
+	return ctx.JSON(http.StatusOK, report)
+}
@@ -0,0 +1,36 @@
+package server
+
+import (
+	"colaco-api/svc"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// dexContentType is the media type DEX audit files are served as.
+const dexContentType = "text/plain; charset=US-ASCII"
+
+// GetDexAudit generates a DEX/UCS audit file of the machine. Its counters
+// since the last reset cover the transactions since the last close.
+func (v *VendingMachine) GetDexAudit(ctx echo.Context) error {
+	rctx := ctx.Request().Context()
+	audit := svc.DEXAudit{Machine: v.machine}
+	var err error
+	if audit.Slots, err = v.Store.GetSlots(rctx); err != nil {
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	}
+	if audit.Transactions, err = v.Store.GetTransactions(rctx, svc.TransactionFilter{}); err != nil {
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	}
+	if audit.CashBox, err = v.Store.GetCashBox(rctx); err != nil {
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	}
+	closes, err := v.Store.GetDayCloses(rctx)
+	if err != nil {
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	}
+	if len(closes) > 0 {
+		audit.ResetAfter = closes[len(closes)-1].ThroughTransactionId
+	}
+	return ctx.Blob(http.StatusOK, dexContentType, svc.MarshalDEX(audit))
+}
@@ -2,132 +2,289 @@ package server
 
 import (
 	"colaco-api/internal/api/v1"
-	"colaco-api/internal/jwt"
+	"colaco-api/svc"
+	"context"
+	"errors"
 	"fmt"
 	"github.com/labstack/echo/v4"
-	"github.com/shopspring/decimal"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
 )
 
+// storageErrorStatus maps the svc sentinel errors returned by the store onto
+// HTTP status codes. Anything unrecognised is an internal server error.
+func storageErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, svc.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, svc.ErrConflict):
+		return http.StatusConflict
+	case errors.Is(err, svc.ErrVersionMismatch):
+		return http.StatusPreconditionFailed
+	case errors.Is(err, svc.ErrUnavailable):
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
+
+// storageError writes the problem details for err. notFound is used as the
+// detail when the slot is missing so callers keep their wording.
+func storageError(ctx echo.Context, err error, notFound string) error {
+	status := storageErrorStatus(err)
+	if status == http.StatusNotFound {
+		return problem(ctx, status, notFound)
+	}
+	return problem(ctx, status, err.Error())
+}
+
 // AuthLogin handles the authentication and login process for the vending
 // machine. It first binds the request body to an AuthRequestBody struct. If the
 // request is invalid, it returns a JSON response with a "Invalid request" error.
-// Next, it authenticates the user using the authenticateUser function. If the
-// username and password are invalid, it returns a JSON response with a "Invalid
-// username and/or password
+// Next, it checks the username and password against the user directory. If they
+// are invalid, it returns a 401 JSON response with a "Invalid username and/or
+// password" error, and a disabled user gets a 403. Otherwise it returns an access
+// token carrying the scopes of the user's role, which the operations in api.yml
+// require, and a refresh token, see issueTokens. Failed logins are counted for the
+// username and the client address, and while the throttle blocks either of them
+// attempts get a 429 with Retry-After, without the password being checked.
 func (v *VendingMachine) AuthLogin(ctx echo.Context) error {
 	var loginReq v1.AuthRequestBody
 
 	if err := ctx.Bind(&loginReq); err != nil {
-		return ctx.JSON(http.StatusBadRequest, genErrorResponse("Invalid request"))
+		return problem(ctx, http.StatusBadRequest, "Invalid request")
 	}
 
-	if !authenticateUser(loginReq.Username, loginReq.Password) {
-		return ctx.JSON(http.StatusUnauthorized, genErrorResponse("Invalid username and/or password"))
+	ip := ctx.RealIP()
+	if wait := v.logins.Allow(loginReq.Username, ip); wait > 0 {
+		ctx.Response().Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+		return problem(ctx, http.StatusTooManyRequests, "Too many failed logins, try again later")
 	}
-	authenticator, err := jwt.NewFakeAuthenticator()
-	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, genErrorResponse("Failed to initialize authenticator"))
+	user, err := svc.Login(ctx.Request().Context(), v.Users, loginReq.Username, loginReq.Password)
+	switch {
+	case errors.Is(err, svc.ErrInvalidCredentials):
+		v.logins.Failed(loginReq.Username, ip)
+		return problem(ctx, http.StatusUnauthorized, "Invalid username and/or password")
+	case errors.Is(err, svc.ErrUserDisabled):
+		v.logins.Release(loginReq.Username, ip)
+		return problem(ctx, http.StatusForbidden, "User is disabled")
+	case err != nil:
+		v.logins.Release(loginReq.Username, ip)
+		return problem(ctx, storageErrorStatus(err), err.Error())
 	}
-	tokenBytes, err := authenticator.CreateJWSWithClaims([]string{"user"})
-	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, genErrorResponse("Failed to sign token"))
-	}
-	// Return the signed token in an authtokenresponse.
-	return ctx.JSON(http.StatusOK, v1.AuthTokenResponse{Token: s2ptr(string(tokenBytes))})
+	v.logins.Succeeded(loginReq.Username, ip)
+	return v.issueTokens(ctx, user)
 }
 
 // PostPurchase handles the process of purchasing a soda from the vending machine.
 // It first binds the request body to a PurchaseSodaBody struct. If the request is invalid,
-// it returns a JSON response with an error message.
-// Then it locks the vending machine to perform the purchase transaction.
-// It converts the soda name to lowercase and checks if it exists in the vending machine's Slots.
-// If the soda does not exist, it returns a JSON response with an error message.
-// If the payment is sufficient to purchase the soda, it calculates the change,
-// decrements the quantity of the soda, updates the vending machine's Slots, and returns
-// a JSON response with the change amount and the purchased soda.
-// If the payment is insufficient, it returns a JSON response with an error message.
+// or names neither a soda nor a slot, it returns a JSON response with an error message.
+// With a slot ID the soda is bought from that slot; otherwise it is bought from the
+// fullest slot holding the soda, see dispense. The stock check, price check and
+// decrement are performed by the store as one atomic DecrementIfAvailable call, so the
+// purchase is safe even when several servers share a backend. The payment is taken
+// from paid, or from the deprecated float payment in svc.DefaultCurrency. If the soda
+// does not exist, it returns a 404 JSON response. If the soda is sold out it returns a
+// 409, if it is paid in another currency a 400 and if the payment is insufficient a
+// 402. Otherwise it calculates the exact change and returns a JSON response with the
+// change, also as the deprecated float, the purchased soda and the slot it came from.
+// A purchase paid with inserted coins and bills is paid their total in the currency of
+// the cash box; they go into the cash box and the change is made from it, see
+// giveChange. When it cannot be made the soda is put back and 422 "exact change only"
+// is returned. Every sale is recorded in the ledger with the price, what was paid and
+// the change.
 func (v *VendingMachine) PostPurchase(ctx echo.Context) error {
 	var purchase v1.PurchaseSodaBody
 	if err := ctx.Bind(&purchase); err != nil {
-		return ctx.JSON(500, genErrorResponse(err.Error()))
-	}
-	v.m.Lock()
-	defer v.m.Unlock()
-	var vslot v1.VendingSlot
-	val, found, _ := v.SlotStorage.GetSlot(purchase.Name)
-	if !found {
-		return ctx.JSON(
-			404,
-			genErrorResponse(fmt.Sprintf("soda with name %v does not exist", purchase.Name)))
-	} else {
-		vslot = val
-	}
-	if purchase.Payment >= *vslot.Cost {
-		purchaseDecimal := decimal.NewFromFloat32(purchase.Payment)
-		costDecimal := decimal.NewFromFloat32(*vslot.Cost)
-		change := purchaseDecimal.Sub(costDecimal)
-		*vslot.Quantity--
-		v.SlotStorage.UpsertSlot(purchase.Name, vslot)
-		f, _ := change.Float64()
-		c := float32(f)
-		return ctx.JSON(200, v1.PurchaseSodaResponse{
-			Change: &c,
-			Soda:   vslot.OccupiedSoda,
-		})
-
-	}
-	mess := fmt.Sprintf("insufficient funds. soda costs %v and you only provided %v", *vslot.Cost, purchase.Payment)
-	return ctx.JSON(402, genMessageResponse(mess))
+		return bindError(ctx, err)
+	}
+	name, slotID := deref(purchase.Name), deref(purchase.SlotId)
+	if name == "" && slotID == "" {
+		return problem(ctx, http.StatusBadRequest, "a soda name or a slot ID is required")
+	}
+	var inserted []v1.Denomination
+	if purchase.Inserted != nil {
+		inserted = *purchase.Inserted
+	}
+	var paid v1.Money
+	switch {
+	case len(inserted) > 0:
+		if err := svc.ValidateInserted(inserted); err != nil {
+			return problem(ctx, http.StatusBadRequest, err.Error())
+		}
+		box, err := v.Store.GetCashBox(ctx.Request().Context())
+		if err != nil {
+			return problem(ctx, storageErrorStatus(err), err.Error())
+		}
+		if paid, err = cashPaid(box, inserted, purchase.Paid); err != nil {
+			return problem(ctx, http.StatusBadRequest, err.Error())
+		}
+	case purchase.Paid != nil:
+		paid = *purchase.Paid
+	case purchase.Payment != nil:
+		paid = svc.NewMoney(*purchase.Payment, svc.DefaultCurrency)
+	default:
+		return problem(ctx, http.StatusBadRequest, "a payment is required")
+	}
+	soda := name
+	if soda == "" {
+		soda = "in slot " + slotID
+	}
+	sold, err := v.sell(ctx, name, slotID, paid, inserted)
+	switch {
+	case errors.Is(err, svc.ErrInsufficientFunds):
+		mess := fmt.Sprintf("insufficient funds. soda costs %v and you only provided %v",
+			svc.FormatMoney(*svc.SlotPrice(sold.slot)), svc.FormatMoney(paid))
+		return insufficientFundsProblem.write(ctx, 402, mess)
+	case errors.Is(err, svc.ErrCurrencyMismatch):
+		return currencyMismatchProblem.write(ctx, http.StatusBadRequest, err.Error())
+	case errors.Is(err, svc.ErrSoldOut):
+		return soldOutProblem.write(ctx, 409, fmt.Sprintf("soda %v is sold out", soda))
+	case errors.Is(err, svc.ErrExactChangeOnly):
+		return exactChangeOnlyProblem.write(ctx, http.StatusUnprocessableEntity, err.Error())
+	case err != nil:
+		return storageError(ctx, err, fmt.Sprintf("soda %v does not exist", soda))
+	}
+	c := svc.MoneyFloat(sold.change)
+	setETag(ctx, sold.slot)
+	return ctx.JSON(200, v1.PurchaseSodaResponse{
+		Change:              &c,
+		ChangeDenominations: sold.coins,
+		ChangeDue:           &sold.change,
+		SlotId:              s2ptr(svc.SlotID(sold.slot)),
+		Soda:                sold.slot.OccupiedSoda,
+	})
+}
+
+// cashPaid returns what the coins and bills inserted pay, their total in the
+// currency of the cash box box. When the payment was also given as paid, it
+// has to agree.
+func cashPaid(box v1.CashBox, inserted []v1.Denomination, paid *v1.Money) (v1.Money, error) {
+	total := v1.Money{Amount: svc.CashTotal(inserted), Currency: box.Currency}
+	if paid != nil && (paid.Amount != total.Amount || !strings.EqualFold(paid.Currency, total.Currency)) {
+		return v1.Money{}, fmt.Errorf("paid %v does not match the %v inserted", svc.FormatMoney(*paid), svc.FormatMoney(total))
+	}
+	return total, nil
+}
+
+// sale is a soda sold by sell.
+type sale struct {
+	// slot is the slot the soda came from, after the sale.
+	slot         v1.VendingSlot
+	paid, change v1.Money
+	// coins are the coins and bills the change was given in, for sales paid
+	// with inserted cash.
+	coins *[]v1.Denomination
+}
+
+// sell sells one soda for paid, see dispense for how name and slotID pick
+// the slot. When inserted coins and bills paid for it, they go into the cash
+// box and the change is made from it, see giveChange; if it cannot be made
+// the soda is put back and the error returned. With ErrInsufficientFunds
+// the slot is returned alongside the error so callers can report the price.
+// The sale is recorded in the ledger with the price, what was paid and the
+// change, and when that fails errUnrecorded is returned although the soda
+// was sold.
+func (v *VendingMachine) sell(ctx echo.Context, name, slotID string, paid v1.Money, inserted []v1.Denomination) (sale, error) {
+	vslot, err := v.dispense(ctx.Request().Context(), name, slotID, paid)
+	if err != nil {
+		return sale{slot: vslot, paid: paid}, err
+	}
+	// The soda is out of the slot, so the sale is completed, or undone, even
+	// if the client goes away now.
+	reqCtx := context.WithoutCancel(ctx.Request().Context())
+	price := svc.SlotPrice(vslot)
+	sold := sale{
+		slot:   vslot,
+		paid:   paid,
+		change: v1.Money{Amount: paid.Amount - price.Amount, Currency: price.Currency},
+	}
+	if len(inserted) > 0 {
+		given, err := v.giveChange(reqCtx, inserted, sold.change)
+		if err != nil {
+			v.undispense(reqCtx, vslot)
+			return sale{slot: vslot, paid: paid}, err
+		}
+		sold.coins = &given
+	}
+	tx := svc.NewTransaction(v1.Purchase, vslot)
+	before := *vslot.Quantity + 1
+	tx.QuantityBefore, tx.Paid, tx.Change = &before, &sold.paid, &sold.change
+	method := v1.Cashless
+	if len(inserted) > 0 {
+		method = v1.Cash
+	}
+	tx.PaymentMethod = &method
+	if err := v.record(ctx, tx); err != nil {
+		return sold, err
+	}
+	return sold, nil
+}
 
+// dispense sells one soda for payment. With a slotID it sells from that slot,
+// which must hold the soda called name if one is given. Otherwise it tries the
+// slots holding the soda fullest first, moving on when a slot was emptied or
+// removed by a concurrent request, and reports ErrSoldOut when none has stock.
+// The first slot that refuses the payment ends the purchase.
+func (v *VendingMachine) dispense(ctx context.Context, name, slotID string, payment v1.Money) (v1.VendingSlot, error) {
+	if slotID != "" {
+		if name != "" {
+			slot, err := v.Store.GetSlot(ctx, slotID)
+			if err != nil {
+				return v1.VendingSlot{}, err
+			}
+			if !svc.HoldsSoda(slot, name) {
+				return v1.VendingSlot{}, fmt.Errorf("%w: slot %q does not hold %q", svc.ErrNotFound, slotID, name)
+			}
+		}
+		return v.Store.DecrementIfAvailable(ctx, slotID, payment)
+	}
+	slots, err := v.Store.GetSlots(ctx)
+	if err != nil {
+		return v1.VendingSlot{}, err
+	}
+	candidates := svc.PurchaseCandidates(slots, name)
+	if len(candidates) == 0 {
+		return v1.VendingSlot{}, fmt.Errorf("%w: no slot holds %q", svc.ErrNotFound, name)
+	}
+	for _, candidate := range candidates {
+		slot, err := v.Store.DecrementIfAvailable(ctx, svc.SlotID(candidate), payment)
+		if errors.Is(err, svc.ErrSoldOut) || errors.Is(err, svc.ErrNotFound) {
+			continue
+		}
+		return slot, err
+	}
+	return v1.VendingSlot{}, svc.ErrSoldOut
 }
 
 // RestockSoda restocks the quantity of a specified soda in the vending machine.
 // It first binds the request body to a RestockRequestBody struct. If the request
-// is invalid, it returns a JSON response with an error message. Next, it locks
-// the vending machine to ensure thread safety while accessing and modifying the
-// Slots map. It then checks if the specified soda exists in the Slots map. If it
-// doesn't, it returns a JSON response with "slot '{soda name}' not found" error.
-// If the soda exists, it retrieves the VendingSlot object from the map. If the
-// sum of the requested quantity and the current quantity is greater than the
-// maximum allowed quantity, it calculates the leftover quantity and sets the
-// vending slot quantity to the maximum allowed quantity, then updates the Slots
-// map. Finally, it returns a JSON response with the updated RestockResponse,
-// including the leftover quantity, new quantity, and old quantity. If the sum of
-// the requested quantity and the current quantity is within the maximum allowed
-// quantity, it simply adds the requested quantity to the current quantity,
-// updates the Slots map, and returns a JSON response with the updated
-// RestockResponse, including the leftover quantity (which is 0 in this case),
-// new quantity
-func (v *VendingMachine) RestockSoda(ctx echo.Context) error {
+// is invalid, or the quantity is not at least 1, it returns a JSON response with
+// an error message. The restock is
+// then applied by the store as one atomic read-modify-write of the slot. If the
+// soda doesn't exist, it returns a JSON response with "slot '{soda name}' not
+// found" error, and if an If-Match header was sent that no longer matches the
+// slot's version it returns 412 without changing anything. If the sum of the
+// requested quantity and the current quantity is greater than the maximum
+// allowed quantity, the slot is filled to the maximum and the rest is reported
+// as leftover. Finally, it returns a JSON response with the updated
+// RestockResponse, including the leftover quantity, new quantity, and old
+// quantity, and the slot's new version as the ETag. The restock is recorded in
+// the ledger and the audit trail.
+func (v *VendingMachine) RestockSoda(ctx echo.Context, params v1.RestockSodaParams) error {
 	var m v1.RestockRequestBody
 	if err := ctx.Bind(&m); err != nil {
-		return ctx.JSON(500, genErrorResponse(err.Error()))
-	}
-	v.m.Lock()
-	defer v.m.Unlock()
-	var vendSlot v1.VendingSlot
-	val, found, _ := v.SlotStorage.GetSlot(m.Name)
-	if !found {
-		return ctx.JSON(404,
-			genErrorResponse(fmt.Sprintf("slot '%v' not found", m.Name)))
-	} else {
-		vendSlot = val
-	}
-	var leftover int
-	oldQty := *vendSlot.Quantity
-	if m.Quantity+*vendSlot.Quantity > *vendSlot.MaxQuantity {
-		leftover = (m.Quantity + *vendSlot.Quantity) - *vendSlot.MaxQuantity
-		vendSlot.Quantity = vendSlot.MaxQuantity
-		v.SlotStorage.UpsertSlot(m.Name, vendSlot)
-		return ctx.JSON(200, v1.RestockResponse{
-			Leftover:    &leftover,
-			NewQuantity: vendSlot.MaxQuantity,
-			OldQuantity: &oldQty,
-		})
-	}
-	*vendSlot.Quantity += m.Quantity
-	v.SlotStorage.UpsertSlot(m.Name, vendSlot)
+		return bindError(ctx, err)
+	}
+	vendSlot, oldQty, leftover, err := v.restock(ctx, opRestockSoda, m.Name, m.Quantity, params.IfMatch)
+	if errors.Is(err, errRestockQuantity) {
+		return problem(ctx, http.StatusBadRequest, err.Error())
+	}
+	if err != nil {
+		return storageError(ctx, err, fmt.Sprintf("slot '%v' not found", m.Name))
+	}
+	setETag(ctx, vendSlot)
 	return ctx.JSON(200, v1.RestockResponse{
 		Leftover:    &leftover,
 		NewQuantity: vendSlot.Quantity,
@@ -135,114 +292,321 @@ func (v *VendingMachine) RestockSoda(ctx echo.Context) error {
 	})
 }
 
+var errRestockQuantity = errors.New("quantity must be at least 1")
+
+// restock loads qty sodas into the slot with slotID as one atomic
+// read-modify-write, if the If-Match header allows, filling it up to its
+// maximum quantity. A qty below 1 is rejected with errRestockQuantity before
+// the store is touched. It returns the slot after the restock, the quantity it
+// held before and what did not fit, and records the restock in the ledger
+// and, as operation, in the audit trail. A slot without a quantity holds
+// none, and one without a maximum quantity cannot be restocked, which is
+// reported as svc.ErrConflict.
+func (v *VendingMachine) restock(ctx echo.Context, operation, slotID string, qty int, header *v1.IfMatch) (v1.VendingSlot, int, int, error) {
+	if qty < 1 {
+		return v1.VendingSlot{}, 0, 0, errRestockQuantity
+	}
+	var leftover, oldQty int
+	var before v1.VendingSlot
+	precondition := ifMatch(header)
+	vendSlot, err := v.Store.UpdateSlot(ctx.Request().Context(), slotID, func(slot *v1.VendingSlot) error {
+		if err := precondition(*slot); err != nil {
+			return err
+		}
+		if slot.MaxQuantity == nil {
+			return fmt.Errorf("%w: slot '%v' has no maximum quantity to restock to", svc.ErrConflict, slotID)
+		}
+		before = *slot
+		if slot.Quantity != nil {
+			oldQty = *slot.Quantity
+		}
+		total := qty + oldQty
+		if total > *slot.MaxQuantity {
+			leftover = total - *slot.MaxQuantity
+			total = *slot.MaxQuantity
+		}
+		slot.Quantity = &total
+		return nil
+	})
+	if err != nil {
+		return v1.VendingSlot{}, 0, 0, err
+	}
+	tx := svc.NewTransaction(v1.Restock, vendSlot)
+	tx.QuantityBefore = &oldQty
+	tx.Leftover = &leftover
+	if err := v.record(ctx, tx); err != nil {
+		return v1.VendingSlot{}, 0, 0, err
+	}
+	if err := v.audit(ctx, operation, svc.SlotID(vendSlot), before, vendSlot); err != nil {
+		return v1.VendingSlot{}, 0, 0, err
+	}
+	return vendSlot, oldQty, leftover, nil
+}
+
 // UpdatePrice updates the price of a soda in the vending machine. It first binds
-// the request body to an UpdatePriceBody struct. If the binding fails, it returns
-// a JSON response with an error message. It then locks the vending machine for
-// writing, retrieves the slot for the specified soda name, and updates its price
-// to the new price provided in the request body. If the slot does not exist, it
-// returns a JSON response with an error message. Finally, it responds with a JSON
-// response indicating the success of the operation and the updated soda price.
-func (v *VendingMachine) UpdatePrice(ctx echo.Context) error {
+// the request body to an UpdatePriceBody struct. If the binding fails, or the body
+// carries neither a price nor the deprecated float newPrice, it returns a JSON
+// response with an error message. It then asks the store to atomically update the
+// slot's price; a float newPrice is taken in the currency the slot is priced in.
+// If the slot does not exist, it returns a JSON response with an error message,
+// and if an If-Match header was sent that no longer matches the slot's version
+// it returns 412. Finally, it responds with a JSON response indicating the
+// success of the operation and the updated soda price, with the slot's new
+// version as the ETag. The ledger records the previous and the new price, and
+// the audit trail the slot before and after.
+func (v *VendingMachine) UpdatePrice(ctx echo.Context, params v1.UpdatePriceParams) error {
 	var m v1.UpdatePriceBody
 	if err := ctx.Bind(&m); err != nil {
-		return ctx.JSON(500, genErrorResponse(err.Error()))
+		return bindError(ctx, err)
 	}
-	// Locking the vending machine for writing
-	v.m.Lock()
-	defer v.m.Unlock()
-
-	// Check if the slot exists
-	slot, found, _ := v.SlotStorage.GetSlot(m.Name)
-	if !found {
-		return ctx.JSON(
-			404,
-			genErrorResponse(fmt.Sprintf("slot '%v' not found", m.Name)))
+	if m.Price == nil && m.NewPrice == nil {
+		return problem(ctx, http.StatusBadRequest, "a price is required")
+	}
+	if m.Price != nil && m.Price.Amount < 0 || m.NewPrice != nil && *m.NewPrice < 0 {
+		return problem(ctx, http.StatusBadRequest, "a price cannot be negative")
+	}
+	slot, old, err := v.reprice(ctx, opUpdatePrice, m.Name, m.Price, m.NewPrice, params.IfMatch)
+	if err != nil {
+		return storageError(ctx, err, fmt.Sprintf("slot '%v' not found", m.Name))
 	}
-	// Update the price in the slot
-	old := slot.Cost
-	slot.Cost = &m.NewPrice
-	v.SlotStorage.UpsertSlot(m.Name, slot)
 
 	// Respond with success
-	return ctx.JSON(http.StatusOK, v1.UpdatePriceResp{
-		NewPrice: &m.NewPrice,
-		OldPrice: old,
+	setETag(ctx, slot)
+	resp := v1.UpdatePriceResp{
+		NewPrice: slot.Cost,
+		Price:    slot.Price,
 		SlotName: &m.Name,
-	})
+	}
+	if old != nil {
+		oldPrice := svc.MoneyFloat(*old)
+		resp.OldPrice = &oldPrice
+		resp.PreviousPrice = old
+	}
+	return ctx.JSON(http.StatusOK, resp)
 
 }
 
+// reprice sets the price of the slot with slotID as one atomic
+// read-modify-write, if the If-Match header allows. The price is price, or
+// the float newPrice in the currency the slot is priced in when price is
+// nil. It returns the slot after the change and its previous price, and
+// records the change in the ledger and, as operation, in the audit trail.
+func (v *VendingMachine) reprice(ctx echo.Context, operation, slotID string, price *v1.Money, newPrice *float32, header *v1.IfMatch) (v1.VendingSlot, *v1.Money, error) {
+	var old *v1.Money
+	var before v1.VendingSlot
+	precondition := ifMatch(header)
+	slot, err := v.Store.UpdateSlot(ctx.Request().Context(), slotID, func(slot *v1.VendingSlot) error {
+		if err := precondition(*slot); err != nil {
+			return err
+		}
+		before = *slot
+		old = svc.SlotPrice(*slot)
+		price := price
+		if price == nil {
+			p := svc.NewMoney(*newPrice, svc.PriceCurrency(*slot))
+			price = &p
+		}
+		svc.SetPrice(slot, *price)
+		return nil
+	})
+	if err != nil {
+		return v1.VendingSlot{}, nil, err
+	}
+	tx := svc.NewTransaction(v1.PriceChange, slot)
+	tx.PreviousPrice, tx.QuantityBefore = old, slot.Quantity
+	if err := v.record(ctx, tx); err != nil {
+		return v1.VendingSlot{}, nil, err
+	}
+	if err := v.audit(ctx, operation, svc.SlotID(slot), before, slot); err != nil {
+		return v1.VendingSlot{}, nil, err
+	}
+	return slot, old, nil
+}
+
 // DeleteVending deletes a vending slot from the vending machine based on the
 // provided name. It first binds the request body to a VendingSlotRequestBody
 // struct. If the binding fails, it returns a JSON response with an error
 // message.
 //
-// After binding the request body, it acquires a write lock on the vending
-// machine to ensure exclusive access during deletion. It then converts the slot
-// name to lowercase for case-insensitive lookup in the Slots map. If the slot
-// with the provided name exists, it is deleted from the Slots map. It returns a
-// JSON response with a success message if the deletion is successful. If the
-// slot does not exist, it returns a JSON response with an error message.
-func (v *VendingMachine) DeleteVending(ctx echo.Context) error {
+// After binding the request body, it asks the store to delete the slot, which
+// is refused with 412 if an If-Match header was sent that no longer matches
+// the slot's version. It returns a JSON response with a success message if the
+// deletion is successful. If the slot does not exist, it returns a JSON
+// response with an error message; other storage failures are mapped by
+// storageErrorStatus. The deletion is recorded in the ledger with the stock
+// the slot still held, and in the audit trail with the deleted slot.
+func (v *VendingMachine) DeleteVending(ctx echo.Context, params v1.DeleteVendingParams) error {
 	var m v1.DeleteVendingJSONBody
 	if err := ctx.Bind(&m); err != nil {
-		return ctx.JSON(500, genErrorResponse(err.Error()))
+		return bindError(ctx, err)
 	}
-	v.m.Lock()
-	defer v.m.Unlock()
-	_, ok, _ := v.SlotStorage.GetSlot(m.Name)
-	if ok {
-		isDeleted, err := v.SlotStorage.DeleteSlot(m.Name)
-		if err != nil {
-			return ctx.JSON(500, genErrorResponse(err.Error()))
-		}
-		if !isDeleted {
-			return ctx.JSON(500, genErrorResponse(fmt.Sprintf("%v is not deleted", m.Name)))
-		}
-		return ctx.JSON(200, genMessageResponse(fmt.Sprintf("soda '%v' deleted successfully", m.Name)))
+	_, err := v.deleteSlot(ctx, opDeleteVending, m.Name, params.IfMatch)
+	if errors.Is(err, svc.ErrNotFound) {
+		return problem(ctx, 404, fmt.Sprintf("soda '%v' not found", m.Name))
 	}
-	return ctx.JSON(404, genMessageResponse(fmt.Sprintf("soda '%v' not found", m.Name)))
+	if err != nil {
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	}
+	return ctx.JSON(200, genMessageResponse(fmt.Sprintf("soda '%v' deleted successfully", m.Name)))
 }
 
-// GetVending retrieves all the vending slots available in the vending machine.
-// It iterates over the slots and appends each slot to the vendingSlots slice.
-// If the vendingSlots slice is empty, it returns a JSON response with
-// an error message indicating that the vending machine is empty.
-// Otherwise, it returns a JSON response with the vendingSlots slice.
-func (v *VendingMachine) GetVending(ctx echo.Context) error {
-	var vendingSlots []v1.VendingSlot
-	vendingSlots = v.SlotStorage.GetSlots()
+// deleteSlot deletes the slot with slotID if the If-Match header allows and
+// returns it. The deletion is recorded in the ledger with the stock the slot
+// still held, and as operation in the audit trail.
+func (v *VendingMachine) deleteSlot(ctx echo.Context, operation, slotID string, header *v1.IfMatch) (v1.VendingSlot, error) {
+	deleted, err := v.Store.DeleteSlotIf(ctx.Request().Context(), slotID, ifMatch(header))
+	if err != nil {
+		return v1.VendingSlot{}, err
+	}
+	tx := svc.NewTransaction(v1.Delete, deleted)
+	tx.QuantityBefore, tx.QuantityAfter = tx.QuantityAfter, i2ptr(0)
+	if err := v.record(ctx, tx); err != nil {
+		return v1.VendingSlot{}, err
+	}
+	if err := v.audit(ctx, operation, svc.SlotID(deleted), deleted, nil); err != nil {
+		return v1.VendingSlot{}, err
+	}
+	return deleted, nil
+}
+
+// GetVending retrieves the vending slots selected by the query parameters,
+// see slotQuery, which are rejected with 400 when invalid.
+// If the machine has no slots at all, it returns a JSON response with
+// an error message indicating that the vending machine is empty, whereas a
+// filter no slot matches is an empty list.
+// Otherwise, it returns a JSON response with the page of slots, the cursor
+// of the next page and a weak ETag for the listing.
+func (v *VendingMachine) GetVending(ctx echo.Context, params v1.GetVendingParams) error {
+	q, err := slotQuery(params)
+	if err != nil {
+		return problem(ctx, http.StatusBadRequest, err.Error())
+	}
+	vendingSlots, next, err := v.querySlots(ctx, q)
+	if err != nil {
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	}
 	count := len(vendingSlots)
-	if vendingSlots == nil {
-		return ctx.JSON(404, map[string]string{"error": "vending machine is empty"})
+	if count == 0 && params == (v1.GetVendingParams{}) {
+		return problem(ctx, 404, "vending machine is empty")
 	}
+	ctx.Response().Header().Set("ETag", listingETag(vendingSlots))
 	return ctx.JSON(200, v1.VendingMachineResponse{
-		Slots: &vendingSlots,
-		Total: &count,
+		NextCursor: next,
+		Slots:      &vendingSlots,
+		Total:      &count,
 	})
 }
 
 // PostNew handles the creation of a new vending slot for a soda in the vending machine.
-// It first binds the request body to a VendingSlot struct. If the request is invalid,
-// it returns a JSON response with an "unacceptable soda" error.
-// Next, it checks if a slot with the same soda name already exists in the vending machine.
-// If it does, it returns a JSON response with a "soda already exists" error.
-// If the slot is unique, it adds the slot to the vending machine and returns a JSON
-// response with a success message.
+// It first binds the request body to a VendingSlot struct. A body that cannot be bound,
+// a slot without a soda, and a slot without a quantity and a maxQuantity it can hold
+// are a 400. The slot's soda is then looked up in the catalog, see catalogSoda, so a
+// soda that is already known can be referenced by its ID alone, and one that is not
+// must have a name, otherwise it is a 400 as well. The slot is named by its ID, or
+// after its soda when it has none. Next, it asks the store to add the slot, which
+// fails with svc.ErrConflict if a slot with the same ID already exists. In that case
+// it returns a JSON response with a "slot already exists" error. If the slot is
+// unique, it is added to the vending machine, recorded in the ledger and the audit
+// trail, and a JSON response with a success message is returned.
 func (v *VendingMachine) PostNew(ctx echo.Context) error {
 	var VSlot v1.PostNewJSONRequestBody
 	if err := ctx.Bind(&VSlot); err != nil {
-		return ctx.JSON(406, genErrorResponse("unacceptable soda"))
+		return bindError(ctx, err)
 	}
-	_, found, _ := v.SlotStorage.GetSlot(*VSlot.Slot.OccupiedSoda.Name)
-
-	if found {
-		return ctx.JSON(
-			409,
-			genErrorResponse(fmt.Sprintf("soda already exists for: '%v'", *VSlot.Slot.OccupiedSoda.Name)))
+	if VSlot.Slot.OccupiedSoda == nil {
+		return problem(ctx, http.StatusBadRequest, "occupiedSoda is required")
+	}
+	qty, maxQty := VSlot.Slot.Quantity, VSlot.Slot.MaxQuantity
+	if qty == nil || maxQty == nil {
+		return problem(ctx, http.StatusBadRequest, "quantity and maxQuantity are required")
+	}
+	if *qty < 0 || *qty > *maxQty {
+		mess := fmt.Sprintf("quantity %d must be between 0 and the maximum quantity %d", *qty, *maxQty)
+		return problem(ctx, http.StatusBadRequest, mess)
+	}
+	soda, err := v.catalogSoda(ctx.Request().Context(), *VSlot.Slot.OccupiedSoda)
+	switch {
+	case errors.Is(err, errUnacceptableSoda):
+		return problem(ctx, http.StatusBadRequest, err.Error())
+	case errors.Is(err, svc.ErrConflict):
+		return problem(ctx, 409, err.Error())
+	case err != nil:
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	}
+	VSlot.Slot.OccupiedSoda = &soda
+	id := svc.SlotID(VSlot.Slot)
+	err = v.Store.AddSlot(ctx.Request().Context(), id, VSlot.Slot)
+	if errors.Is(err, svc.ErrConflict) {
+		return problem(ctx, 409, fmt.Sprintf("slot already exists: '%v'", id))
+	}
+	if err != nil {
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	}
+	VSlot.Slot.Id = &id
+	tx := svc.NewTransaction(v1.Add, VSlot.Slot)
+	tx.QuantityBefore = i2ptr(0)
+	if err := v.record(ctx, tx); err != nil {
+		return problem(ctx, http.StatusInternalServerError, err.Error())
+	}
+	if err := v.audit(ctx, opPostNew, id, nil, VSlot.Slot); err != nil {
+		return problem(ctx, http.StatusInternalServerError, err.Error())
 	}
-	v.SlotStorage.AddSlot(*VSlot.Slot.OccupiedSoda.Name, VSlot.Slot)
 	return ctx.JSON(
 		201,
-		genMessageResponse(fmt.Sprintf("soda created for: '%v'", *VSlot.Slot.OccupiedSoda.Name)))
+		genMessageResponse(fmt.Sprintf("soda created for: '%v' in slot '%v'", *soda.Name, id)))
+}
+
+var errUnacceptableSoda = errors.New("unacceptable soda")
+
+// catalogSoda resolves the soda of a new slot against the catalog. A soda
+// that is not in the catalog yet must have a name. A soda that is must not
+// contradict the catalog: any field that was sent has to match, otherwise
+// svc.ErrConflict is returned. The catalog's definition is returned.
+func (v *VendingMachine) catalogSoda(ctx context.Context, soda v1.Soda) (v1.Soda, error) {
+	id := svc.SodaID(soda)
+	if id == "" {
+		return v1.Soda{}, errUnacceptableSoda
+	}
+	known, err := v.Store.GetSoda(ctx, id)
+	if errors.Is(err, svc.ErrNotFound) {
+		if soda.Name == nil {
+			return v1.Soda{}, fmt.Errorf("%w: soda '%v' is not in the catalog", errUnacceptableSoda, id)
+		}
+		return svc.WithSodaID(soda), nil
+	}
+	if err != nil {
+		return v1.Soda{}, err
+	}
+	if !matches(soda.Name, known.Name) || !matches(soda.Description, known.Description) ||
+		!matches(soda.OriginStory, known.OriginStory) || !matches(soda.Calories, known.Calories) ||
+		!matches(soda.Ounces, known.Ounces) {
+		return v1.Soda{}, fmt.Errorf("%w: soda '%v' already exists in the catalog with different details", svc.ErrConflict, id)
+	}
+	return known, nil
+}
+
+// matches reports whether an optional request field is either absent or
+// equal to the stored value.
+func matches[T comparable](sent, stored *T) bool {
+	return sent == nil || stored != nil && *sent == *stored
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// GetSodas lists the soda catalog.
+func (v *VendingMachine) GetSodas(ctx echo.Context) error {
+	sodas, err := v.Store.GetSodas(ctx.Request().Context())
+	if err != nil {
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	}
+	total := len(sodas)
+	return ctx.JSON(http.StatusOK, v1.SodaCatalogResponse{
+		Sodas: &sodas,
+		Total: &total,
+	})
 }
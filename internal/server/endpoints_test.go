@@ -3,15 +3,30 @@ package server
 import (
 	"bytes"
 	"colaco-api/internal/api/v1"
+	"colaco-api/internal/api/v2"
+	"colaco-api/internal/jwt"
 	"colaco-api/internal/storage"
+	"colaco-api/svc"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/labstack/echo/v4"
+	emiddle "github.com/labstack/echo/v4/middleware"
+	"github.com/lestrrat-go/jwx/jwa"
+	"github.com/lestrrat-go/jwx/jwk"
+	jwtx "github.com/lestrrat-go/jwx/jwt"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func s2p(s string) *string {
@@ -34,6 +49,8 @@ func TestAuthLoginSuccess(t *testing.T) {
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 	vm := NewVendingMachine()
+	_, err := svc.BootstrapAdmin(context.Background(), vm.Users, "admin", "password")
+	require.NoError(t, err)
 
 	if assert.NoError(t, vm.AuthLogin(c)) {
 		assert.Equal(t, http.StatusOK, rec.Code)
@@ -91,7 +108,7 @@ func TestRestockSodaSuccess(t *testing.T) {
 			},
 		}))
 
-	if assert.NoError(t, vm.RestockSoda(c)) {
+	if assert.NoError(t, vm.RestockSoda(c, v1.RestockSodaParams{})) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 	}
 }
@@ -115,7 +132,7 @@ func TestVendingMachine_UpdatePrice(t *testing.T) {
 	newPrice := float32(1.5)
 	updatePriceBody := v1.UpdatePriceBody{
 		Name:     sodaName,
-		NewPrice: newPrice,
+		NewPrice: &newPrice,
 	}
 	bodyBytes, _ := json.Marshal(updatePriceBody) // Error handling omitted for brevity
 
@@ -125,7 +142,7 @@ func TestVendingMachine_UpdatePrice(t *testing.T) {
 	c := e.NewContext(req, rec)
 
 	// Act
-	err := vm.UpdatePrice(c)
+	err := vm.UpdatePrice(c, v1.UpdatePriceParams{})
 
 	// Assert
 	assert.NoError(t, err)
@@ -159,7 +176,7 @@ func TestDeleteVendingSuccess(t *testing.T) {
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 
-	if assert.NoError(t, vm.DeleteVending(c)) {
+	if assert.NoError(t, vm.DeleteVending(c, v1.DeleteVendingParams{})) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 
 		_, existsAfterDelete, _ := vm.SlotStorage.GetSlot(strings.ToLower(sodaName))
@@ -200,14 +217,15 @@ func TestGetVendingSuccess(t *testing.T) {
 	c := e.NewContext(req, rec)
 
 	// Execute the GetVending endpoint
-	if assert.NoError(t, vm.GetVending(c)) {
+	if assert.NoError(t, vm.GetVending(c, v1.GetVendingParams{})) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 
 		// Parse the response body to check if it contains the expected items
-		var vendingSlots []v1.VendingSlot
-		if err := json.Unmarshal(rec.Body.Bytes(), &vendingSlots); err != nil {
+		var resp v1.VendingMachineResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
 			t.Fatalf("Failed to unmarshal response body: %v", err)
 		}
+		vendingSlots := *resp.Slots
 
 		// Verify the response contains the correct number of items
 		assert.Len(t, vendingSlots, 1, "Expected 1 soda in the response")
@@ -249,7 +267,7 @@ func TestPostNewSodaSuccess(t *testing.T) {
 	*newSlot.MaxQuantity = 20
 	*newSlot.Quantity = 15
 
-	reqBodyBytes, err := json.Marshal(newSlot)
+	reqBodyBytes, err := json.Marshal(v1.PostNewJSONRequestBody{Slot: newSlot})
 	if err != nil {
 		t.Fatalf("Failed to marshal new soda request: %v", err)
 	}
@@ -276,3 +294,1985 @@ func TestPostNewSodaSuccess(t *testing.T) {
 		}
 	}
 }
+
+// unavailableStore is a svc.VendingStore whose backend is always down.
+type unavailableStore struct{}
+
+func (unavailableStore) GetSlot(context.Context, string) (v1.VendingSlot, error) {
+	return v1.VendingSlot{}, svc.ErrUnavailable
+}
+func (unavailableStore) GetSlots(context.Context) ([]v1.VendingSlot, error) {
+	return nil, svc.ErrUnavailable
+}
+func (unavailableStore) QuerySlots(context.Context, svc.SlotQuery) ([]v1.VendingSlot, error) {
+	return nil, svc.ErrUnavailable
+}
+func (unavailableStore) AddSlot(context.Context, string, v1.VendingSlot) error {
+	return svc.ErrUnavailable
+}
+func (unavailableStore) UpsertSlot(context.Context, string, v1.VendingSlot) error {
+	return svc.ErrUnavailable
+}
+func (unavailableStore) DeleteSlot(context.Context, string) error { return svc.ErrUnavailable }
+func (unavailableStore) UpdatePrice(context.Context, string, v1.Money) error {
+	return svc.ErrUnavailable
+}
+func (unavailableStore) UpdateQuantity(context.Context, string, int) error {
+	return svc.ErrUnavailable
+}
+func (unavailableStore) DecrementIfAvailable(context.Context, string, v1.Money) (v1.VendingSlot, error) {
+	return v1.VendingSlot{}, svc.ErrUnavailable
+}
+func (unavailableStore) UpdateSlot(context.Context, string, func(*v1.VendingSlot) error) (v1.VendingSlot, error) {
+	return v1.VendingSlot{}, svc.ErrUnavailable
+}
+func (unavailableStore) DeleteSlotIf(context.Context, string, func(v1.VendingSlot) error) (v1.VendingSlot, error) {
+	return v1.VendingSlot{}, svc.ErrUnavailable
+}
+func (unavailableStore) ReplaceSlots(context.Context, func([]v1.VendingSlot) ([]v1.VendingSlot, error)) ([]v1.VendingSlot, error) {
+	return nil, svc.ErrUnavailable
+}
+func (unavailableStore) GetSoda(context.Context, string) (v1.Soda, error) {
+	return v1.Soda{}, svc.ErrUnavailable
+}
+func (unavailableStore) GetSodas(context.Context) ([]v1.Soda, error) {
+	return nil, svc.ErrUnavailable
+}
+func (unavailableStore) GetCashBox(context.Context) (v1.CashBox, error) {
+	return v1.CashBox{}, svc.ErrUnavailable
+}
+func (unavailableStore) UpdateCashBox(context.Context, func(*v1.CashBox) error) (v1.CashBox, error) {
+	return v1.CashBox{}, svc.ErrUnavailable
+}
+func (unavailableStore) AppendTransaction(context.Context, v1.Transaction) (v1.Transaction, error) {
+	return v1.Transaction{}, svc.ErrUnavailable
+}
+func (unavailableStore) GetTransactions(context.Context, svc.TransactionFilter) ([]v1.Transaction, error) {
+	return nil, svc.ErrUnavailable
+}
+
+func (unavailableStore) AppendDayClose(context.Context, v1.DayClose) (v1.DayClose, error) {
+	return v1.DayClose{}, svc.ErrUnavailable
+}
+
+func (unavailableStore) GetDayCloses(context.Context) ([]v1.DayClose, error) {
+	return nil, svc.ErrUnavailable
+}
+
+func (unavailableStore) GetDayClose(context.Context, int64) (v1.DayClose, error) {
+	return v1.DayClose{}, svc.ErrUnavailable
+}
+
+func TestStorageErrorsMapToStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		store   svc.VendingStore
+		handler func(*VendingMachine) func(echo.Context) error
+		body    string
+		want    int
+	}{
+		{"purchase not found", svc.NewLegacyStore(storage.NewMemoryStorage()),
+			func(vm *VendingMachine) func(echo.Context) error { return vm.PostPurchase },
+			`{"name":"Coke","payment":2.00}`, http.StatusNotFound},
+		{"delete not found", svc.NewLegacyStore(storage.NewMemoryStorage()),
+			func(vm *VendingMachine) func(echo.Context) error {
+				return func(c echo.Context) error { return vm.DeleteVending(c, v1.DeleteVendingParams{}) }
+			},
+			`{"name":"Coke"}`, http.StatusNotFound},
+		{"purchase unavailable", unavailableStore{},
+			func(vm *VendingMachine) func(echo.Context) error { return vm.PostPurchase },
+			`{"name":"Coke","payment":2.00}`, http.StatusServiceUnavailable},
+		{"restock unavailable", unavailableStore{},
+			func(vm *VendingMachine) func(echo.Context) error {
+				return func(c echo.Context) error { return vm.RestockSoda(c, v1.RestockSodaParams{}) }
+			},
+			`{"name":"Coke","quantity":1}`, http.StatusServiceUnavailable},
+		{"update price unavailable", unavailableStore{},
+			func(vm *VendingMachine) func(echo.Context) error {
+				return func(c echo.Context) error { return vm.UpdatePrice(c, v1.UpdatePriceParams{}) }
+			},
+			`{"name":"Coke","newPrice":1}`, http.StatusServiceUnavailable},
+		{"get vending unavailable", unavailableStore{},
+			func(vm *VendingMachine) func(echo.Context) error {
+				return func(c echo.Context) error { return vm.GetVending(c, v1.GetVendingParams{}) }
+			},
+			``, http.StatusServiceUnavailable},
+		{"post new unavailable", unavailableStore{},
+			func(vm *VendingMachine) func(echo.Context) error { return vm.PostNew },
+			`{"slot":{"occupiedSoda":{"name":"Coke"},"quantity":0,"maxQuantity":10}}`, http.StatusServiceUnavailable},
+		{"transactions unavailable", unavailableStore{},
+			func(vm *VendingMachine) func(echo.Context) error {
+				return func(c echo.Context) error { return vm.GetTransactions(c, v1.GetTransactionsParams{}) }
+			},
+			``, http.StatusServiceUnavailable},
+		{"sales report unavailable", unavailableStore{},
+			func(vm *VendingMachine) func(echo.Context) error {
+				return func(c echo.Context) error { return vm.GetSalesReport(c, v1.GetSalesReportParams{}) }
+			},
+			``, http.StatusServiceUnavailable},
+		{"close day unavailable", unavailableStore{},
+			func(vm *VendingMachine) func(echo.Context) error { return vm.CloseDay },
+			`{"countedCash":{"amount":0,"currency":"USD"}}`, http.StatusServiceUnavailable},
+		{"fill cash box unavailable", unavailableStore{},
+			func(vm *VendingMachine) func(echo.Context) error { return vm.FillCashBox },
+			`{"denominations":[{"value":25,"count":4}]}`, http.StatusServiceUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			vm := NewVendingMachine(WithStore(tt.store))
+
+			if assert.NoError(t, tt.handler(vm)(e.NewContext(req, rec))) {
+				assert.Equal(t, tt.want, rec.Code)
+			}
+		})
+	}
+}
+
+func TestWithStorageUnwrapsLegacyStorage(t *testing.T) {
+	store := storage.NewMemoryStorage()
+	legacy := svc.NewLegacyStorage(svc.NewLegacyStore(store))
+	vm := NewVendingMachine(WithStorage(legacy))
+	assert.Same(t, legacy, vm.SlotStorage)
+	assert.Same(t, legacy.Store, vm.Store, "the handlers must use the wrapped store, not an adapter around the adapter")
+}
+
+func TestPostNewConflict(t *testing.T) {
+	e := echo.New()
+	vm := NewVendingMachine(
+		WithStorage(storage.NewMemoryStorage()),
+		WithStartingSodas([]v1.VendingSlot{{OccupiedSoda: &v1.Soda{Name: s2p("Coke")}}}))
+
+	req := httptest.NewRequest(http.MethodPost, "/vending",
+		bytes.NewBufferString(`{"slot":{"occupiedSoda":{"name":"COKE"},"quantity":0,"maxQuantity":10}}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	if assert.NoError(t, vm.PostNew(e.NewContext(req, rec))) {
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	}
+}
+
+func TestPostNewRequiresQuantities(t *testing.T) {
+	for _, body := range []string{
+		`{"slot":{"occupiedSoda":{"name":"Pop"},"maxQuantity":10}}`,
+		`{"slot":{"occupiedSoda":{"name":"Pop"},"quantity":5}}`,
+		`{"slot":{"occupiedSoda":{"name":"Pop"},"quantity":-1,"maxQuantity":10}}`,
+		`{"slot":{"occupiedSoda":{"name":"Pop"},"quantity":11,"maxQuantity":10}}`,
+	} {
+		vm := newColaMachine()
+		rec := serve(t, vm.PostNew, body)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, body)
+		_, err := vm.Store.GetSlot(context.Background(), "pop")
+		assert.ErrorIs(t, err, svc.ErrNotFound, body)
+	}
+}
+
+func TestPostNewRejectsInvalidSlots(t *testing.T) {
+	for _, body := range []string{
+		`{"slot":`,
+		`{"slot":{"quantity":"five","maxQuantity":10}}`,
+		`{"slot":{"id":"A4","quantity":1,"maxQuantity":10}}`,
+		`{"slot":{"id":"A4","occupiedSoda":{},"quantity":1,"maxQuantity":10}}`,
+	} {
+		rec := serve(t, newColaMachine().PostNew, body)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, body)
+		assert.Equal(t, "application/problem+json", rec.Header().Get(echo.HeaderContentType), body)
+	}
+}
+
+func TestRestockSlotWithoutQuantities(t *testing.T) {
+	vm := NewVendingMachine(WithStorage(storage.NewMemoryStorage()))
+	ctx := context.Background()
+	require.NoError(t, vm.Store.AddSlot(ctx, "A1", v1.VendingSlot{OccupiedSoda: &v1.Soda{Name: s2p("Coke")}, MaxQuantity: i2p(6)}))
+	require.NoError(t, vm.Store.AddSlot(ctx, "A2", v1.VendingSlot{OccupiedSoda: &v1.Soda{Name: s2p("Fizz")}}))
+	restock := func(c echo.Context) error { return vm.RestockSoda(c, v1.RestockSodaParams{}) }
+
+	rec := serve(t, restock, `{"name":"A1","quantity":4}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp v1.RestockResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, 0, *resp.OldQuantity, "a slot without a quantity holds none")
+	assert.Equal(t, 4, *resp.NewQuantity)
+
+	rec = serve(t, restock, `{"name":"A2","quantity":4}`)
+	assert.Equal(t, http.StatusConflict, rec.Code, "a slot without a maximum quantity cannot be filled up")
+}
+
+func TestRestockRequiresPositiveQuantity(t *testing.T) {
+	vm := NewVendingMachine(WithStorage(storage.NewMemoryStorage()))
+	ctx := context.Background()
+	require.NoError(t, vm.Store.AddSlot(ctx, "A1", v1.VendingSlot{OccupiedSoda: &v1.Soda{Name: s2p("Coke")}, Quantity: i2p(5), MaxQuantity: i2p(6)}))
+	restock := func(c echo.Context) error { return vm.RestockSoda(c, v1.RestockSodaParams{}) }
+
+	for _, body := range []string{`{"name":"A1","quantity":0}`, `{"name":"A1","quantity":-3}`} {
+		rec := serve(t, restock, body)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, body)
+	}
+	slot, err := vm.Store.GetSlot(ctx, "A1")
+	require.NoError(t, err)
+	assert.Equal(t, 5, *slot.Quantity, "a rejected restock leaves the slot alone")
+	txs, err := vm.Store.GetTransactions(ctx, svc.TransactionFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, txs, "a rejected restock is not recorded")
+}
+
+func TestPostPurchaseRejections(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity int
+		body     string
+		want     int
+	}{
+		{"sold out", 0, `{"name":"Coke","payment":2.00}`, http.StatusConflict},
+		{"insufficient funds", 3, `{"name":"Coke","payment":1.00}`, http.StatusPaymentRequired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			mockStorage := storage.NewMemoryStorage()
+			vm := NewVendingMachine(
+				WithStorage(mockStorage),
+				WithStartingSodas([]v1.VendingSlot{{
+					OccupiedSoda: &v1.Soda{Name: s2p("Coke")},
+					Cost:         f322p(1.5),
+					Quantity:     i2p(tt.quantity),
+				}}))
+			req := httptest.NewRequest(http.MethodPost, "/purchase", bytes.NewBufferString(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			if assert.NoError(t, vm.PostPurchase(e.NewContext(req, rec))) {
+				assert.Equal(t, tt.want, rec.Code)
+			}
+			slot, _, _ := mockStorage.GetSlot("coke")
+			assert.Equal(t, tt.quantity, *slot.Quantity, "a rejected purchase must not change the stock")
+		})
+	}
+}
+
+func TestIfMatchPreconditions(t *testing.T) {
+	vm := NewVendingMachine(
+		WithStorage(storage.NewMemoryStorage()),
+		WithStartingSodas([]v1.VendingSlot{{
+			OccupiedSoda: &v1.Soda{Name: s2p("Coke")},
+			Cost:         f322p(1),
+			Quantity:     i2p(5),
+			MaxQuantity:  i2p(15),
+		}}))
+	do := func(handler func(echo.Context) error, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		require.NoError(t, handler(echo.New().NewContext(req, rec)))
+		return rec
+	}
+	restock := func(ifMatch *string) *httptest.ResponseRecorder {
+		return do(func(c echo.Context) error {
+			return vm.RestockSoda(c, v1.RestockSodaParams{IfMatch: ifMatch})
+		}, `{"name":"Coke","quantity":1}`)
+	}
+
+	rec := restock(s2p(`"1"`))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `"2"`, rec.Header().Get("ETag"))
+
+	rec = restock(s2p(`"1"`))
+	assert.Equal(t, http.StatusPreconditionFailed, rec.Code, "a stale ETag must be rejected")
+	rec = restock(s2p(`W/"2"`))
+	assert.Equal(t, http.StatusPreconditionFailed, rec.Code, "weak ETags never match If-Match")
+	slot, err := vm.Store.GetSlot(context.Background(), "coke")
+	require.NoError(t, err)
+	assert.Equal(t, 6, *slot.Quantity, "rejected restocks must not change the slot")
+
+	rec = restock(s2p(`"7", "2"`))
+	assert.Equal(t, http.StatusOK, rec.Code, "any listed ETag may match")
+	rec = restock(s2p("*"))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	rec = restock(nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `"5"`, rec.Header().Get("ETag"))
+
+	rec = do(func(c echo.Context) error {
+		return vm.UpdatePrice(c, v1.UpdatePriceParams{IfMatch: s2p(`"4"`)})
+	}, `{"name":"Coke","newPrice":2}`)
+	assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+
+	rec = do(func(c echo.Context) error {
+		return vm.DeleteVending(c, v1.DeleteVendingParams{IfMatch: s2p(`"4"`)})
+	}, `{"name":"Coke"}`)
+	assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+	rec = do(func(c echo.Context) error {
+		return vm.DeleteVending(c, v1.DeleteVendingParams{IfMatch: s2p(`"5"`)})
+	}, `{"name":"Coke"}`)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	require.NoError(t, vm.Store.AddSlot(context.Background(), "coke", v1.VendingSlot{
+		OccupiedSoda: &v1.Soda{Name: s2p("Coke")},
+		Cost:         f322p(1),
+		Quantity:     i2p(5),
+		MaxQuantity:  i2p(15),
+	}))
+	for _, stale := range []string{`"1"`, `"5"`} {
+		rec = restock(s2p(stale))
+		assert.Equal(t, http.StatusPreconditionFailed, rec.Code,
+			"an ETag of the deleted slot must not match the recreated one")
+	}
+}
+
+func TestGetVendingListingETag(t *testing.T) {
+	vm := NewVendingMachine(
+		WithStorage(storage.NewMemoryStorage()),
+		WithStartingSodas([]v1.VendingSlot{{
+			OccupiedSoda: &v1.Soda{Name: s2p("Coke")},
+			Quantity:     i2p(5),
+			MaxQuantity:  i2p(15),
+		}}))
+	etag := func() string {
+		rec := httptest.NewRecorder()
+		require.NoError(t, vm.GetVending(echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec), v1.GetVendingParams{}))
+		require.Equal(t, http.StatusOK, rec.Code)
+		return rec.Header().Get("ETag")
+	}
+
+	first := etag()
+	assert.True(t, strings.HasPrefix(first, `W/"`), "the listing ETag should be weak")
+	assert.Equal(t, first, etag(), "an unchanged listing keeps its ETag")
+	require.NoError(t, vm.Store.UpdateQuantity(context.Background(), "coke", 1))
+	assert.NotEqual(t, first, etag())
+
+	moved := func(id string) []v1.VendingSlot {
+		return []v1.VendingSlot{{Id: s2p(id), OccupiedSoda: &v1.Soda{Name: s2p("Coke")}}}
+	}
+	assert.NotEqual(t, listingETag(moved("A1")), listingETag(moved("A2")),
+		"a slot replaced by another at the same version changes the listing")
+}
+
+// serve runs handler on a JSON request with body and returns the response.
+func serve(t *testing.T, handler echo.HandlerFunc, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	require.NoError(t, handler(echo.New().NewContext(req, rec)))
+	return rec
+}
+
+// newColaMachine returns a machine with Cola in slots A1 and A2 and Fizz in
+// B1.
+func newColaMachine() *VendingMachine {
+	cola := func() *v1.Soda { return &v1.Soda{Id: s2p("cola"), Name: s2p("Cola")} }
+	return NewVendingMachine(
+		WithStorage(storage.NewMemoryStorage()),
+		WithStartingSodas([]v1.VendingSlot{
+			{Id: s2p("A1"), OccupiedSoda: cola(), Cost: f322p(1), Quantity: i2p(2), MaxQuantity: i2p(10)},
+			{Id: s2p("A2"), OccupiedSoda: cola(), Cost: f322p(1), Quantity: i2p(3), MaxQuantity: i2p(10)},
+			{Id: s2p("B1"), OccupiedSoda: &v1.Soda{Name: s2p("Fizz")}, Cost: f322p(1), Quantity: i2p(1), MaxQuantity: i2p(10)},
+		}))
+}
+
+func TestPostPurchaseFromFullestSlot(t *testing.T) {
+	vm := newColaMachine()
+	var dispensed []string
+	for i := 0; i < 5; i++ {
+		rec := serve(t, vm.PostPurchase, `{"name":"cola","payment":1}`)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var resp v1.PurchaseSodaResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "Cola", *resp.Soda.Name)
+		dispensed = append(dispensed, *resp.SlotId)
+	}
+	assert.Equal(t, []string{"A2", "A1", "A2", "A1", "A2"}, dispensed)
+
+	rec := serve(t, vm.PostPurchase, `{"name":"Cola","payment":1}`)
+	assert.Equal(t, http.StatusConflict, rec.Code, "cola is sold out in every slot")
+	rec = serve(t, vm.PostPurchase, `{"name":"Pop","payment":1}`)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestPostPurchaseFromSlot(t *testing.T) {
+	vm := newColaMachine()
+
+	rec := serve(t, vm.PostPurchase, `{"slotId":"a1","payment":1}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp v1.PurchaseSodaResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, "A1", *resp.SlotId)
+	slot, err := vm.Store.GetSlot(context.Background(), "A1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, *slot.Quantity, "the fuller A2 must not be touched")
+
+	rec = serve(t, vm.PostPurchase, `{"name":"Fizz","slotId":"A1","payment":1}`)
+	assert.Equal(t, http.StatusNotFound, rec.Code, "A1 does not hold Fizz")
+	rec = serve(t, vm.PostPurchase, `{"slotId":"C9","payment":1}`)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	rec = serve(t, vm.PostPurchase, `{"payment":1}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestPostNewSlotsShareCatalogSoda(t *testing.T) {
+	vm := newColaMachine()
+
+	rec := serve(t, vm.PostNew, `{"slot":{"id":"A3","occupiedSoda":{"id":"COLA"},"cost":1.25,"quantity":4,"maxQuantity":8}}`)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	slot, err := vm.Store.GetSlot(context.Background(), "a3")
+	require.NoError(t, err)
+	assert.Equal(t, "Cola", *slot.OccupiedSoda.Name, "a soda referenced by ID comes from the catalog")
+
+	rec = serve(t, vm.PostNew, `{"slot":{"id":"A4","occupiedSoda":{"id":"cola","name":"Diet Cola"},"quantity":0,"maxQuantity":8}}`)
+	assert.Equal(t, http.StatusConflict, rec.Code, "details contradicting the catalog are rejected")
+	rec = serve(t, vm.PostNew, `{"slot":{"id":"A4","occupiedSoda":{"id":"pop"},"quantity":0,"maxQuantity":8}}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code, "an unknown soda needs a name")
+	rec = serve(t, vm.PostNew, `{"slot":{"id":"a1","occupiedSoda":{"name":"Pop"},"quantity":0,"maxQuantity":8}}`)
+	assert.Equal(t, http.StatusConflict, rec.Code, "slot IDs are unique")
+
+	rec = serve(t, vm.GetSodas, ``)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var catalog v1.SodaCatalogResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &catalog))
+	var ids []string
+	for _, soda := range *catalog.Sodas {
+		ids = append(ids, *soda.Id)
+	}
+	assert.Equal(t, []string{"cola", "fizz"}, ids)
+}
+
+const testPlanogram = `{
+	"layout": {"rows": [
+		{"row": "A", "coils": [{"column": 1, "capacity": 6}, {"column": 2, "capacity": 6}]},
+		{"row": "B", "coils": [{"column": 1, "capacity": 4}]}
+	]},
+	"assignments": [
+		{"slotId": "A1", "soda": {"id": "cola"}, "cost": 1.5, "quantity": 6},
+		{"slotId": "a2", "soda": {"name": "Lime Fizz"}, "cost": 1, "quantity": 2}
+	]
+}`
+
+// putPlanogram returns the PutPlanogram handler of vm sending ifMatch as the
+// If-Match header.
+func putPlanogram(vm *VendingMachine, ifMatch *string) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return vm.PutPlanogram(c, v1.PutPlanogramParams{IfMatch: ifMatch})
+	}
+}
+
+func TestPutPlanogram(t *testing.T) {
+	vm := newColaMachine()
+	rec := serve(t, putPlanogram(vm, nil), testPlanogram)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var applied v1.Planogram
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &applied))
+	assert.Len(t, applied.Layout.Rows, 2)
+	assert.Len(t, *applied.Assignments, 2)
+
+	slot, err := vm.Store.GetSlot(context.Background(), "a1")
+	require.NoError(t, err)
+	assert.Equal(t, "Cola", *slot.OccupiedSoda.Name, "a soda referenced by ID comes from the catalog")
+	assert.Equal(t, 6, *slot.MaxQuantity, "the coil capacity becomes the slot's maximum quantity")
+	assert.Equal(t, &v1.SlotPosition{Row: "A", Column: 1}, slot.Position)
+
+	slot, err = vm.Store.GetSlot(context.Background(), "b1")
+	require.NoError(t, err)
+	assert.Nil(t, slot.OccupiedSoda, "B1 is replaced by an empty coil")
+	assert.Equal(t, 0, *slot.Quantity)
+
+	slots, err := vm.Store.GetSlots(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, slots, 3, "slots outside the planogram are removed")
+
+	rec = serve(t, vm.PostPurchase, `{"name":"lime-fizz","payment":1}`)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestPutPlanogramKeepsStockAndRecordsChanges(t *testing.T) {
+	vm := newColaMachine()
+	rec := serve(t, func(c echo.Context) error { return vm.GetPlanogram(c, v1.GetPlanogramParams{}) }, ``)
+	require.Equal(t, http.StatusOK, rec.Code)
+	etag := rec.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	planogram := `{
+		"layout": {"rows": [{"row": "A", "coils": [{"column": 1, "capacity": 6}, {"column": 2, "capacity": 6}]}]},
+		"assignments": [
+			{"slotId": "A1", "soda": {"id": "cola"}, "cost": 1.5},
+			{"slotId": "A2", "soda": {"name": "Lime Fizz"}, "cost": 1}
+		]
+	}`
+	stale := `"0"`
+	rec = serve(t, putPlanogram(vm, &stale), planogram)
+	assert.Equal(t, http.StatusPreconditionFailed, rec.Code, "a stale If-Match is refused")
+	rec = serve(t, putPlanogram(vm, &etag), planogram)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.NotEqual(t, etag, rec.Header().Get("ETag"))
+
+	a1, err := vm.Store.GetSlot(context.Background(), "A1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, *a1.Quantity, "a slot keeping its soda keeps its stock")
+	a2, err := vm.Store.GetSlot(context.Background(), "A2")
+	require.NoError(t, err)
+	assert.Equal(t, 0, *a2.Quantity, "a new soda starts out empty")
+
+	txs, err := vm.Store.GetTransactions(context.Background(), svc.TransactionFilter{})
+	require.NoError(t, err)
+	var changes []string
+	for _, tx := range txs {
+		changes = append(changes, string(tx.Operation)+" "+tx.SlotId)
+	}
+	assert.Equal(t, []string{"price_change A1", "delete A2", "add A2", "delete B1"}, changes)
+
+	records, err := vm.Audit.GetAuditRecords(context.Background(), 0, 0)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "put-planogram", records[0].Operation)
+	assert.Equal(t, "planogram", records[0].Target)
+}
+
+func TestPutPlanogramRejectsInvalidPlanograms(t *testing.T) {
+	tests := []struct {
+		name, body string
+	}{
+		{"OverCapacity", `{"layout":{"rows":[{"row":"A","coils":[{"column":1,"capacity":6}]}]},
+			"assignments":[{"slotId":"A1","soda":{"id":"cola"},"quantity":7}]}`},
+		{"UnknownSlot", `{"layout":{"rows":[{"row":"A","coils":[{"column":1,"capacity":6}]}]},
+			"assignments":[{"slotId":"C9","soda":{"id":"cola"}}]}`},
+		{"DuplicateColumn", `{"layout":{"rows":[{"row":"A","coils":[{"column":1,"capacity":6},{"column":1,"capacity":4}]}]}}`},
+		{"DuplicateRow", `{"layout":{"rows":[{"row":"A","coils":[{"column":1,"capacity":6}]},{"row":"a","coils":[{"column":2,"capacity":6}]}]}}`},
+		{"UnknownSoda", `{"layout":{"rows":[{"row":"A","coils":[{"column":1,"capacity":6}]}]},
+			"assignments":[{"slotId":"A1","soda":{"id":"pop"}}]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vm := newColaMachine()
+			rec := serve(t, putPlanogram(vm, nil), tt.body)
+			assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+			slots, err := vm.Store.GetSlots(context.Background())
+			require.NoError(t, err)
+			assert.Len(t, slots, 3, "a rejected planogram changes nothing")
+		})
+	}
+
+	vm := newColaMachine()
+	rec := serve(t, putPlanogram(vm, nil), `{"layout":{"rows":[{"row":"A","coils":[{"column":1,"capacity":6}]}]},
+		"assignments":[{"slotId":"A1","soda":{"id":"cola","name":"Diet Cola"}}]}`)
+	assert.Equal(t, http.StatusConflict, rec.Code, "details contradicting the catalog are rejected")
+}
+
+func TestPlanogramYAMLRoundTrip(t *testing.T) {
+	vm := newColaMachine()
+	require.Equal(t, http.StatusOK, serve(t, putPlanogram(vm, nil), testPlanogram).Code)
+
+	yamlFormat := v1.GetPlanogramParamsFormatYaml
+	req := httptest.NewRequest(http.MethodGet, "/planogram?format=yaml", nil)
+	rec := httptest.NewRecorder()
+	require.NoError(t, vm.GetPlanogram(echo.New().NewContext(req, rec), v1.GetPlanogramParams{Format: &yamlFormat}))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/x-yaml", rec.Header().Get(echo.HeaderContentType))
+	assert.Contains(t, rec.Body.String(), "slotId: A1", "YAML uses the JSON field names")
+	exported := rec.Body.String()
+
+	req = httptest.NewRequest(http.MethodPut, "/planogram", bytes.NewBufferString(exported))
+	req.Header.Set(echo.HeaderContentType, "application/x-yaml")
+	rec = httptest.NewRecorder()
+	require.NoError(t, vm.PutPlanogram(echo.New().NewContext(req, rec), v1.PutPlanogramParams{}))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, exported, rec.Body.String(), "importing an export changes nothing")
+}
+
+func TestPostPurchaseExactChange(t *testing.T) {
+	vm := newColaMachine()
+
+	rec := serve(t, vm.PostPurchase, `{"name":"cola","paid":{"amount":110,"currency":"USD"}}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp v1.PurchaseSodaResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, v1.Money{Amount: 10, Currency: "USD"}, *resp.ChangeDue)
+	assert.Equal(t, float32(0.1), *resp.Change, "the deprecated change is derived from the exact change")
+
+	rec = serve(t, vm.PostPurchase, `{"name":"cola","payment":1.1}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, v1.Money{Amount: 10, Currency: "USD"}, *resp.ChangeDue, "float payments are read as dollars")
+
+	rec = serve(t, vm.PostPurchase, `{"name":"cola","paid":{"amount":500,"currency":"EUR"}}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code, "paying in another currency is rejected")
+	rec = serve(t, vm.PostPurchase, `{"name":"cola"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code, "a payment is required")
+}
+
+func TestUpdatePriceWithMoney(t *testing.T) {
+	vm := newColaMachine()
+
+	rec := serve(t, func(c echo.Context) error { return vm.UpdatePrice(c, v1.UpdatePriceParams{}) },
+		`{"name":"A1","price":{"amount":135,"currency":"USD"}}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp v1.UpdatePriceResp
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, v1.Money{Amount: 100, Currency: "USD"}, *resp.PreviousPrice)
+	assert.Equal(t, v1.Money{Amount: 135, Currency: "USD"}, *resp.Price)
+	assert.Equal(t, float32(1), *resp.OldPrice)
+	assert.Equal(t, float32(1.35), *resp.NewPrice)
+
+	slot, err := vm.Store.GetSlot(context.Background(), "a1")
+	require.NoError(t, err)
+	assert.Equal(t, v1.Money{Amount: 135, Currency: "USD"}, *slot.Price)
+	assert.Equal(t, float32(1.35), *slot.Cost)
+
+	rec = serve(t, func(c echo.Context) error { return vm.UpdatePrice(c, v1.UpdatePriceParams{}) },
+		`{"name":"A1"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code, "a price is required")
+}
+
+func TestCashBoxFillAndEmpty(t *testing.T) {
+	vm := newColaMachine()
+
+	rec := serve(t, vm.FillCashBox, `{"denominations":[{"value":25,"count":4},{"value":100,"count":1},{"value":25,"count":2}]}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var box v1.CashBox
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &box))
+	assert.Equal(t, "USD", box.Currency)
+	assert.Equal(t, []v1.Denomination{{Value: 100, Count: 1}, {Value: 25, Count: 6}}, box.Denominations)
+	assert.Equal(t, v1.Money{Amount: 250, Currency: "USD"}, *box.Total)
+
+	rec = serve(t, vm.FillCashBox, `{"currency":"EUR","denominations":[]}`)
+	assert.Equal(t, http.StatusConflict, rec.Code, "a cash box holding dollars cannot switch to euros")
+	rec = serve(t, vm.FillCashBox, `{"denominations":[{"value":0,"count":1}]}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = serve(t, vm.EmptyCashBox, `{"denominations":[{"value":25,"count":7}]}`)
+	assert.Equal(t, http.StatusConflict, rec.Code, "the cash box only holds six quarters")
+	rec = serve(t, vm.EmptyCashBox, `{"denominations":[{"value":25,"count":6}]}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &box))
+	assert.Equal(t, []v1.Denomination{{Value: 100, Count: 1}}, box.Denominations)
+
+	rec = serve(t, vm.EmptyCashBox, `{}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &box))
+	assert.Empty(t, box.Denominations)
+
+	rec = serve(t, vm.FillCashBox, `{"currency":"eur","denominations":[{"value":50,"count":2}]}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &box))
+	assert.Equal(t, "EUR", box.Currency, "an empty cash box can switch currency")
+
+	records, err := vm.Audit.GetAuditRecords(context.Background(), 0, 0)
+	require.NoError(t, err)
+	var ops []string
+	for _, r := range records {
+		ops = append(ops, r.Operation)
+		assert.Equal(t, cashBoxTarget, r.Target)
+	}
+	assert.Equal(t, []string{opFillCashBox, opEmptyCashBox, opEmptyCashBox, opFillCashBox}, ops,
+		"refused changes are not recorded")
+	assert.Equal(t, []any{}, (*records[0].Before)["denominations"])
+	assert.Equal(t, 250.0, (*records[0].After)["total"].(map[string]any)["amount"])
+	assert.Equal(t, 250.0, (*records[1].Before)["total"].(map[string]any)["amount"])
+	assert.Equal(t, 100.0, (*records[1].After)["total"].(map[string]any)["amount"])
+}
+
+func TestPostPurchaseWithInsertedCash(t *testing.T) {
+	vm := newColaMachine()
+	rec := serve(t, vm.FillCashBox, `{"denominations":[{"value":25,"count":1},{"value":10,"count":3}]}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// Cola costs a dollar: 30 cents change must be three dimes, not a quarter.
+	rec = serve(t, vm.PostPurchase, `{"slotId":"A1","inserted":[{"value":100,"count":1},{"value":10,"count":3}]}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp v1.PurchaseSodaResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, v1.Money{Amount: 30, Currency: "USD"}, *resp.ChangeDue)
+	assert.Equal(t, []v1.Denomination{{Value: 10, Count: 3}}, *resp.ChangeDenominations)
+
+	box, err := vm.Store.GetCashBox(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []v1.Denomination{{Value: 100, Count: 1}, {Value: 25, Count: 1}, {Value: 10, Count: 3}}, box.Denominations,
+		"the inserted dollar and dimes stay in the cash box and three dimes leave it")
+
+	rec = serve(t, vm.PostPurchase, `{"slotId":"A1","inserted":[{"value":100,"count":1}],"paid":{"amount":200,"currency":"USD"}}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code, "paid must match the inserted cash")
+}
+
+// disconnectingStore is a svc.VendingStore whose client goes away right
+// after a soda is dispensed.
+type disconnectingStore struct {
+	svc.VendingStore
+	cancel context.CancelFunc
+}
+
+func (s disconnectingStore) DecrementIfAvailable(ctx context.Context, name string, payment v1.Money) (v1.VendingSlot, error) {
+	slot, err := s.VendingStore.DecrementIfAvailable(ctx, name, payment)
+	s.cancel()
+	return slot, err
+}
+
+func TestPostPurchaseOutlivesClient(t *testing.T) {
+	vm := newColaMachine()
+	require.Equal(t, http.StatusOK, serve(t, vm.FillCashBox, `{"denominations":[{"value":25,"count":3}]}`).Code)
+	store := vm.Store
+	purchase := func(body string) int {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		vm.Store = disconnectingStore{store, cancel}
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)).WithContext(ctx)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		require.NoError(t, vm.PostPurchase(echo.New().NewContext(req, rec)))
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusOK, purchase(`{"slotId":"A1","inserted":[{"value":100,"count":1},{"value":25,"count":1}]}`))
+	box, err := store.GetCashBox(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []v1.Denomination{{Value: 100, Count: 1}, {Value: 25, Count: 3}}, box.Denominations,
+		"the change is given although the client went away")
+	txs, err := store.GetTransactions(context.Background(), svc.TransactionFilter{})
+	require.NoError(t, err)
+	assert.Len(t, txs, 1, "the sale is recorded")
+
+	assert.Equal(t, http.StatusUnprocessableEntity, purchase(`{"slotId":"A1","inserted":[{"value":500,"count":1}]}`))
+	slot, err := store.GetSlot(context.Background(), "A1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, *slot.Quantity, "the soda is put back although the client went away")
+}
+
+func TestPostPurchaseExactChangeOnly(t *testing.T) {
+	vm := newColaMachine()
+	rec := serve(t, vm.FillCashBox, `{"denominations":[{"value":25,"count":3}]}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = serve(t, vm.PostPurchase, `{"slotId":"A1","inserted":[{"value":500,"count":1}]}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code, "four dollars change cannot be made from three quarters")
+	assert.Contains(t, rec.Body.String(), "exact change only")
+
+	slot, err := vm.Store.GetSlot(context.Background(), "A1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, *slot.Quantity, "the soda is put back")
+	box, err := vm.Store.GetCashBox(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []v1.Denomination{{Value: 25, Count: 3}}, box.Denominations, "the cash box is left as it was")
+
+	rec = serve(t, vm.PostPurchase, `{"slotId":"A1","inserted":[{"value":100,"count":1}]}`)
+	require.Equal(t, http.StatusOK, rec.Code, "exact change is always accepted")
+	rec = serve(t, vm.PostPurchase, `{"slotId":"A1","payment":1.10}`)
+	assert.Equal(t, http.StatusOK, rec.Code, "cashless purchases do not need the cash box")
+}
+
+func TestPostPurchaseBoundsInsertedCash(t *testing.T) {
+	vm := newColaMachine()
+	rec := serve(t, vm.FillCashBox, `{"denominations":[{"value":25,"count":40}]}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = serve(t, vm.PostPurchase, `{"slotId":"A1","inserted":[{"value":100000000000,"count":1}]}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code, "a denomination above the maximum value")
+	rec = serve(t, vm.PostPurchase, `{"slotId":"A1","inserted":[{"value":100000,"count":2}]}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code, "more than a purchase can insert")
+
+	box, err := vm.Store.GetCashBox(context.Background())
+	require.NoError(t, err)
+	change, err := svc.MakeChange(box, svc.MaxChange+1)
+	assert.ErrorIs(t, err, svc.ErrExactChangeOnly)
+	assert.Nil(t, change)
+	change, err = svc.MakeChange(box, 150)
+	require.NoError(t, err)
+	assert.Equal(t, []v1.Denomination{{Value: 25, Count: 6}}, change)
+
+	jws, err := vm.auth.CreateJWSForSubject("alice", svc.RoleScopes(v1.Customer))
+	require.NoError(t, err)
+	rec = call(newAPI(t, vm), http.MethodPost, "/purchase", `{"slotId":"A1","inserted":[{"value":100000000000,"count":1}]}`, string(jws))
+	assert.Equal(t, http.StatusBadRequest, rec.Code, "the validator enforces the maximum")
+	assert.Contains(t, rec.Body.String(), "inserted.0.value")
+}
+
+func TestLedgerRecordsChanges(t *testing.T) {
+	vm := newColaMachine()
+	transactions := func(params v1.GetTransactionsParams) v1.TransactionsResponse {
+		t.Helper()
+		rec := serve(t, func(c echo.Context) error { return vm.GetTransactions(c, params) }, ``)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var resp v1.TransactionsResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		return resp
+	}
+
+	// The purchase is made by alice, whose token names her as its subject.
+	jws, err := vm.auth.CreateJWSForSubject("alice", svc.RoleScopes(v1.Customer))
+	require.NoError(t, err)
+	token, err := vm.auth.ValidateJWS(string(jws))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, serve(t, func(c echo.Context) error {
+		c.Set(jwt.JWTClaimsContextKey, token)
+		return vm.PostPurchase(c)
+	}, `{"slotId":"A1","paid":{"amount":125,"currency":"USD"}}`).Code)
+
+	require.Equal(t, http.StatusOK, serve(t, func(c echo.Context) error { return vm.RestockSoda(c, v1.RestockSodaParams{}) },
+		`{"name":"A1","quantity":4}`).Code)
+	require.Equal(t, http.StatusOK, serve(t, func(c echo.Context) error { return vm.UpdatePrice(c, v1.UpdatePriceParams{}) },
+		`{"name":"A1","price":{"amount":150,"currency":"USD"}}`).Code)
+	require.Equal(t, http.StatusCreated, serve(t, vm.PostNew,
+		`{"slot":{"id":"C1","occupiedSoda":{"id":"cola"},"quantity":6,"maxQuantity":10}}`).Code)
+	require.Equal(t, http.StatusOK, serve(t, func(c echo.Context) error { return vm.DeleteVending(c, v1.DeleteVendingParams{}) },
+		`{"name":"B1"}`).Code)
+	require.Equal(t, http.StatusNotFound, serve(t, vm.PostPurchase, `{"slotId":"B1","payment":1}`).Code,
+		"failed requests are not recorded")
+
+	all := transactions(v1.GetTransactionsParams{}).Transactions
+	require.Len(t, all, 5)
+	purchase := all[0]
+	assert.Equal(t, v1.Purchase, purchase.Operation)
+	assert.Equal(t, "alice", purchase.Actor)
+	assert.Equal(t, "A1", purchase.SlotId)
+	assert.Equal(t, "cola", *purchase.SodaId)
+	assert.Equal(t, v1.Money{Amount: 100, Currency: "USD"}, *purchase.Price)
+	assert.Equal(t, v1.Money{Amount: 125, Currency: "USD"}, *purchase.Paid)
+	assert.Equal(t, v1.Money{Amount: 25, Currency: "USD"}, *purchase.Change)
+	assert.Equal(t, 2, *purchase.QuantityBefore)
+	assert.Equal(t, 1, *purchase.QuantityAfter)
+
+	restock := all[1]
+	assert.Equal(t, v1.Restock, restock.Operation)
+	assert.Equal(t, anonymousActor, restock.Actor)
+	assert.Equal(t, 1, *restock.QuantityBefore)
+	assert.Equal(t, 5, *restock.QuantityAfter)
+
+	priceChange := all[2]
+	assert.Equal(t, v1.PriceChange, priceChange.Operation)
+	assert.Equal(t, v1.Money{Amount: 100, Currency: "USD"}, *priceChange.PreviousPrice)
+	assert.Equal(t, v1.Money{Amount: 150, Currency: "USD"}, *priceChange.Price)
+
+	assert.Equal(t, v1.Add, all[3].Operation)
+	assert.Equal(t, "C1", all[3].SlotId)
+	assert.Equal(t, 0, *all[3].QuantityBefore)
+	assert.Equal(t, 6, *all[3].QuantityAfter)
+	assert.Equal(t, v1.Delete, all[4].Operation)
+	assert.Equal(t, "Fizz", *all[4].SodaName)
+	assert.Equal(t, 1, *all[4].QuantityBefore)
+	assert.Equal(t, 0, *all[4].QuantityAfter)
+
+	op := v1.Purchase
+	assert.Len(t, transactions(v1.GetTransactionsParams{Operation: &op}).Transactions, 1)
+	assert.Len(t, transactions(v1.GetTransactionsParams{Actor: s2p("alice")}).Transactions, 1)
+	assert.Len(t, transactions(v1.GetTransactionsParams{SlotId: s2p("a1")}).Transactions, 3)
+}
+
+// unledgeredStore is a svc.VendingStore whose ledger is down.
+type unledgeredStore struct{ svc.VendingStore }
+
+func (unledgeredStore) AppendTransaction(context.Context, v1.Transaction) (v1.Transaction, error) {
+	return v1.Transaction{}, svc.ErrUnavailable
+}
+
+// unauditedStore is a svc.AuditStore that cannot append to the audit trail.
+type unauditedStore struct{ svc.AuditStore }
+
+func (unauditedStore) AppendAuditRecord(context.Context, v1.AuditRecord) (v1.AuditRecord, error) {
+	return v1.AuditRecord{}, svc.ErrUnavailable
+}
+
+func TestUnrecordedChangesFail(t *testing.T) {
+	vm := newColaMachine()
+	vm.Store = unledgeredStore{vm.Store}
+
+	rec := serve(t, func(c echo.Context) error { return vm.RestockSoda(c, v1.RestockSodaParams{}) },
+		`{"name":"A1","quantity":4}`)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code, rec.Body.String())
+	assert.Contains(t, rec.Body.String(), "could not be recorded in the ledger")
+	slot, err := vm.Store.GetSlot(context.Background(), "A1")
+	require.NoError(t, err)
+	assert.Equal(t, 6, *slot.Quantity, "the restock itself is not undone")
+
+	rec = serve(t, vm.PostPurchase, `{"slotId":"A1","paid":{"amount":125,"currency":"USD"}}`)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code, rec.Body.String())
+
+	vm = newColaMachine()
+	vm.Audit = unauditedStore{vm.Audit}
+	rec = serve(t, func(c echo.Context) error { return vm.UpdatePrice(c, v1.UpdatePriceParams{}) },
+		`{"name":"A1","price":{"amount":150,"currency":"USD"}}`)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code, rec.Body.String())
+	assert.Contains(t, rec.Body.String(), "could not be recorded in the audit trail")
+}
+
+func TestGetTransactionsPages(t *testing.T) {
+	vm := newColaMachine()
+	for i := 0; i < 5; i++ {
+		require.Equal(t, http.StatusOK, serve(t, vm.PostPurchase, `{"name":"cola","payment":1}`).Code)
+	}
+
+	var seen []int64
+	params := v1.GetTransactionsParams{Limit: i2p(2)}
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 3, "five transactions fit on three pages of two")
+		rec := serve(t, func(c echo.Context) error { return vm.GetTransactions(c, params) }, ``)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var resp v1.TransactionsResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		for _, tx := range resp.Transactions {
+			seen = append(seen, *tx.Id)
+		}
+		if resp.NextCursor == nil {
+			break
+		}
+		params.Cursor = resp.NextCursor
+	}
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, seen)
+
+	rec := serve(t, func(c echo.Context) error {
+		return vm.GetTransactions(c, v1.GetTransactionsParams{Cursor: s2p("next")})
+	}, ``)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = serve(t, func(c echo.Context) error {
+		return vm.GetTransactions(c, v1.GetTransactionsParams{Limit: i2p(501)})
+	}, ``)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestGetSalesReport(t *testing.T) {
+	vm := newColaMachine()
+	sale := func(at, soda string, price int64) {
+		ts, err := time.Parse(time.RFC3339, at)
+		require.NoError(t, err)
+		_, err = vm.Store.AppendTransaction(context.Background(), v1.Transaction{
+			Timestamp: ts,
+			Operation: v1.Purchase,
+			SlotId:    "a1",
+			SodaId:    s2p(soda),
+			SodaName:  s2p(strings.ToUpper(soda)),
+			Price:     &v1.Money{Amount: price, Currency: "USD"},
+		})
+		require.NoError(t, err)
+	}
+	sale("2023-12-31T23:59:59Z", "cola", 150)
+	sale("2024-01-01T10:15:00Z", "cola", 150)
+	sale("2024-01-01T10:45:00Z", "cola", 100)
+	sale("2024-01-02T09:00:00Z", "fanta", 200)
+	sale("2024-01-03T12:00:00Z", "cola", 125)
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)
+	report := func(params v1.GetSalesReportParams) v1.SalesReport {
+		t.Helper()
+		params.From, params.To = &from, &to
+		rec := serve(t, func(c echo.Context) error { return vm.GetSalesReport(c, params) }, ``)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var r v1.SalesReport
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &r))
+		return r
+	}
+
+	daily := report(v1.GetSalesReportParams{})
+	assert.Equal(t, v1.Day, daily.Bucket)
+	require.Len(t, daily.Rows, 3)
+	assert.Equal(t, from, *daily.Rows[0].PeriodStart)
+	assert.Equal(t, "cola", daily.Rows[0].SodaId)
+	assert.Equal(t, 2, daily.Rows[0].UnitsSold)
+	assert.Equal(t, v1.Money{Amount: 250, Currency: "USD"}, daily.Rows[0].Revenue)
+	assert.Equal(t, v1.Money{Amount: 125, Currency: "USD"}, daily.Rows[0].AveragePrice)
+	assert.Equal(t, "fanta", daily.Rows[1].SodaId)
+	require.Len(t, daily.Totals, 2)
+	assert.Nil(t, daily.Totals[0].PeriodStart)
+	assert.Equal(t, 3, daily.Totals[0].UnitsSold)
+	assert.Equal(t, v1.Money{Amount: 375, Currency: "USD"}, daily.Totals[0].Revenue)
+
+	weekly := report(v1.GetSalesReportParams{Bucket: func() *v1.ReportBucket { b := v1.Week; return &b }()})
+	require.Len(t, weekly.Rows, 2, "one week, two sodas")
+	assert.Equal(t, from, *weekly.Rows[0].PeriodStart, "weeks start on Monday")
+
+	fanta := report(v1.GetSalesReportParams{SodaId: s2p("FANTA")})
+	require.Len(t, fanta.Totals, 1)
+	assert.Equal(t, "fanta", fanta.Totals[0].SodaId)
+
+	csvFormat := v1.GetSalesReportParamsFormatCsv
+	rec := serve(t, func(c echo.Context) error {
+		return vm.GetSalesReport(c, v1.GetSalesReportParams{From: &from, To: &to, Format: &csvFormat})
+	}, ``)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/csv", rec.Header().Get(echo.HeaderContentType))
+	lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+	require.Len(t, lines, 6, "header, three rows and two totals")
+	assert.Equal(t, "period_start,soda_id,soda_name,units_sold,revenue,average_price,currency", lines[0])
+	assert.Equal(t, "2024-01-01T00:00:00Z,cola,COLA,2,2.50,1.25,USD", lines[1])
+	assert.Equal(t, ",cola,COLA,3,3.75,1.25,USD", lines[4])
+
+	rec = serve(t, func(c echo.Context) error {
+		return vm.GetSalesReport(c, v1.GetSalesReportParams{From: &to, To: &from})
+	}, ``)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestCloseDay(t *testing.T) {
+	vm := newColaMachine()
+	require.Equal(t, http.StatusOK, serve(t, vm.FillCashBox, `{"denominations":[{"value":25,"count":4}]}`).Code)
+	require.Equal(t, http.StatusOK, serve(t, vm.PostPurchase, `{"slotId":"A1","inserted":[{"value":100,"count":1}]}`).Code)
+	require.Equal(t, http.StatusOK, serve(t, vm.PostPurchase, `{"slotId":"A2","payment":1}`).Code)
+	rec := serve(t, func(c echo.Context) error { return vm.RestockSoda(c, v1.RestockSodaParams{}) },
+		`{"name":"A2","quantity":9}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = serve(t, vm.CloseDay, `{"countedCash":{"amount":100,"currency":"EUR"}}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code, "the cash box holds dollars")
+
+	rec = serve(t, vm.CloseDay, `{"countedCash":{"amount":190,"currency":"USD"},"note":"a dime short"}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var first v1.DayClose
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &first))
+	assert.Equal(t, int64(1), *first.Id)
+	assert.Equal(t, int64(0), first.FromTransactionId)
+	assert.Equal(t, int64(3), first.ThroughTransactionId)
+	assert.Equal(t, v1.Money{Amount: 200, Currency: "USD"}, first.ExpectedCash)
+	assert.Equal(t, int64(-10), first.Variance)
+	assert.Equal(t, []v1.Money{{Amount: 200, Currency: "USD"}}, first.Revenue)
+	assert.Equal(t, anonymousActor, first.ClosedBy)
+	assert.Equal(t, []v1.DayCloseSlot{
+		{SlotId: "A1", SodaId: s2p("cola"), SodaName: s2p("Cola"), OpeningStock: 2, ClosingStock: 1, UnitsSold: 1},
+		{SlotId: "A2", SodaId: s2p("cola"), SodaName: s2p("Cola"), OpeningStock: 3, ClosingStock: 10, UnitsSold: 1, Restocked: 8, Leftover: 1},
+		{SlotId: "B1", SodaId: s2p("fizz"), SodaName: s2p("Fizz"), OpeningStock: 1, ClosingStock: 1},
+	}, first.Slots)
+
+	rec = serve(t, vm.CloseDay, `{"countedCash":{"amount":200,"currency":"USD"}}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var second v1.DayClose
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &second))
+	assert.Equal(t, first.ThroughTransactionId, second.FromTransactionId, "the next period starts where the last ended")
+	assert.Equal(t, first.ClosedAt, second.OpenedAt)
+	assert.Empty(t, second.Revenue)
+	assert.Equal(t, 10, second.Slots[1].OpeningStock)
+
+	_, err := vm.Store.AppendDayClose(context.Background(), first)
+	assert.ErrorIs(t, err, svc.ErrPeriodClosed, "a closed period cannot be closed again")
+
+	rec = serve(t, vm.GetDayCloses, ``)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var closes v1.DayClosesResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &closes))
+	assert.Len(t, closes.Periods, 2)
+
+	rec = serve(t, func(c echo.Context) error { return vm.GetDayClose(c, 1) }, ``)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var stored v1.DayClose
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &stored))
+	assert.Equal(t, first, stored)
+	records, err := vm.Audit.GetAuditRecords(context.Background(), 0, 0)
+	require.NoError(t, err)
+	var closed []v1.AuditRecord
+	for _, r := range records {
+		if r.Operation == opCloseDay {
+			closed = append(closed, r)
+		}
+	}
+	require.Len(t, closed, 2, "the refused close is not recorded")
+	assert.Equal(t, "1", closed[0].Target)
+	assert.Nil(t, closed[0].Before)
+	assert.Equal(t, "a dime short", (*closed[0].After)["note"])
+	rec = serve(t, func(c echo.Context) error { return vm.GetDayClose(c, 3) }, ``)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestGetDexAudit(t *testing.T) {
+	vm := newColaMachine()
+	WithMachineIdentity(svc.MachineIdentity{Serial: "VM123", Model: "M1", Asset: "AST9"})(vm)
+	require.Equal(t, http.StatusOK, serve(t, vm.FillCashBox, `{"denominations":[{"value":25,"count":4}]}`).Code)
+	require.Equal(t, http.StatusOK, serve(t, vm.PostPurchase, `{"slotId":"A1","inserted":[{"value":100,"count":1},{"value":25,"count":1}]}`).Code)
+	require.Equal(t, http.StatusOK, serve(t, vm.PostPurchase, `{"slotId":"A2","payment":1}`).Code)
+	require.Equal(t, http.StatusOK, serve(t, vm.CloseDay, `{"countedCash":{"amount":200,"currency":"USD"}}`).Code)
+	require.Equal(t, http.StatusOK, serve(t, vm.PostPurchase, `{"slotId":"A2","payment":1}`).Code)
+
+	rec := serve(t, vm.GetDexAudit, ``)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, strings.HasPrefix(rec.Header().Get(echo.HeaderContentType), "text/plain"))
+	body := rec.Body.String()
+	require.True(t, strings.HasSuffix(body, "\r\n"), "every segment ends in CR LF")
+	segments := strings.Split(strings.TrimSuffix(body, "\r\n"), "\r\n")
+	require.Len(t, segments, 19)
+	assert.Equal(t, []string{
+		"DXS*VM123*VA*V1/1*1",
+		"ST*001*0001",
+		"ID1*VM123*M1****AST9",
+		"ID4*2*840*USD",
+		"VA1*300*3*100*1",
+		"CA2*100*1*0*0",
+		"CA3*0**0**125**125",
+		"CA4*0**25",
+		"CA15*200",
+		"DA2*200*2*100*1",
+		"PA1*A1*100*cola",
+		"PA2*1*100*0*0",
+		"PA1*A2*100*cola",
+		"PA2*2*200*1*100",
+		"PA1*B1*100*fizz",
+		"PA2*0*0*0*0",
+		"G85*18D0", // CRC-16/ARC of the segments from ST to the last PA2
+		"SE*17*0001",
+		"DXE*1*1",
+	}, segments)
+}
+
+func TestUserDirectory(t *testing.T) {
+	vm := NewVendingMachine()
+	login := func(body string) *httptest.ResponseRecorder {
+		return serve(t, vm.AuthLogin, body)
+	}
+	assert.Equal(t, http.StatusUnauthorized, login(`{"username":"admin","password":"password"}`).Code,
+		"there is no baked-in admin")
+
+	created, err := svc.BootstrapAdmin(context.Background(), vm.Users, "admin", "s3cret-admin")
+	require.NoError(t, err)
+	assert.True(t, created)
+	created, err = svc.BootstrapAdmin(context.Background(), vm.Users, "root", "s3cret-root")
+	require.NoError(t, err)
+	assert.False(t, created, "only an empty directory is bootstrapped")
+
+	rec := login(`{"username":"admin","password":"s3cret-admin"}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var token v1.AuthTokenResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &token))
+	fa := vm.auth
+	parsed, err := fa.ValidateJWS(*token.Token)
+	require.NoError(t, err)
+	assert.Equal(t, "admin", parsed.Subject())
+	assert.NoError(t, jwt.CheckTokenClaims([]string{svc.ScopeUsersWrite, svc.ScopeRestockWrite, svc.ScopePurchaseWrite}, parsed))
+
+	rec = serve(t, vm.CreateUser, `{"username":"bob","password":"short"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = serve(t, vm.CreateUser, `{"username":"bob","password":"bob-password"}`)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "passwordHash")
+	rec = serve(t, vm.CreateUser, `{"username":"Bob","password":"bob-password"}`)
+	assert.Equal(t, http.StatusConflict, rec.Code, "usernames are case-insensitive")
+
+	assert.Equal(t, http.StatusUnauthorized, login(`{"username":"bob","password":"wrong-password"}`).Code)
+	rec = login(`{"username":"bob","password":"bob-password"}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &token))
+	parsed, err = fa.ValidateJWS(*token.Token)
+	require.NoError(t, err)
+	assert.NoError(t, jwt.CheckTokenClaims([]string{svc.ScopeVendingRead, svc.ScopePurchaseWrite}, parsed))
+	assert.ErrorIs(t, jwt.CheckTokenClaims([]string{svc.ScopeRestockWrite}, parsed), jwt.ErrClaimsInvalid,
+		"bob is a customer")
+
+	rec = serve(t, func(c echo.Context) error { return vm.SetUserRole(c, "bob") }, `{"role":"janitor"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = serve(t, func(c echo.Context) error { return vm.SetUserRole(c, "bob") }, `{"role":"operator"}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = serve(t, func(c echo.Context) error { return vm.DisableUser(c, "bob") }, ``)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, http.StatusForbidden, login(`{"username":"bob","password":"bob-password"}`).Code)
+	rec = serve(t, func(c echo.Context) error { return vm.EnableUser(c, "bob") }, ``)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = serve(t, func(c echo.Context) error { return vm.ResetUserPassword(c, "bob") }, `{"password":"new-password"}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, http.StatusUnauthorized, login(`{"username":"bob","password":"bob-password"}`).Code)
+	assert.Equal(t, http.StatusOK, login(`{"username":"bob","password":"new-password"}`).Code)
+	rec = serve(t, func(c echo.Context) error { return vm.ResetUserPassword(c, "carol") }, `{"password":"new-password"}`)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = serve(t, vm.GetUsers, ``)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var users v1.UsersResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &users))
+	require.Len(t, users.Users, 2)
+	assert.Equal(t, "admin", users.Users[0].Username)
+	assert.Equal(t, v1.Admin, users.Users[0].Role)
+	assert.Equal(t, "bob", users.Users[1].Username)
+	assert.Equal(t, v1.Operator, users.Users[1].Role)
+	assert.False(t, users.Users[1].Disabled)
+}
+
+// newAPI returns an echo server serving vm behind the request validator, as
+// Run does.
+func newAPI(t *testing.T, vm *VendingMachine) *echo.Echo {
+	t.Helper()
+	mw, err := CreateMiddleware(vm.tokens, apiKeyValidator{vm.APIKeys}, vm.revoked)
+	require.NoError(t, err)
+	e := echo.New()
+	e.HTTPErrorHandler = problemErrorHandler
+	e.IPExtractor = vm.ipExtractor()
+	e.Use(emiddle.Recover())
+	e.Use(emiddle.RequestID())
+	e.Use(mw...)
+	v1.RegisterHandlers(e, vm)
+	v2.RegisterHandlers(e, apiV2{vm})
+	return e
+}
+
+// call sends a request with the JSON body to e, authorized with token unless
+// it is empty.
+func call(e *echo.Echo, method, path, body, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	if token != "" {
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestScopesEnforced(t *testing.T) {
+	vm := newColaMachine()
+	e := newAPI(t, vm)
+
+	tokens := map[v1.Role]string{}
+	for _, role := range []v1.Role{v1.Customer, v1.Operator, v1.Admin} {
+		jws, err := vm.auth.CreateJWSForSubject(string(role), svc.RoleScopes(role))
+		require.NoError(t, err)
+		tokens[role] = string(jws)
+	}
+	do := func(method, path, body, token string) *httptest.ResponseRecorder {
+		return call(e, method, path, body, token)
+	}
+
+	rec := do(http.MethodGet, "/vending", "", "")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, "Bearer", rec.Header().Get(echo.HeaderWWWAuthenticate))
+	assert.Equal(t, http.StatusUnauthorized, do(http.MethodGet, "/vending", "", "not-a-token").Code)
+	assert.Equal(t, http.StatusOK, do(http.MethodGet, "/vending", "", tokens[v1.Customer]).Code)
+
+	restock := `{"name":"A1","quantity":1}`
+	rec = do(http.MethodPost, "/restock", restock, tokens[v1.Customer])
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	var resp v1.Problem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Contains(t, resp.Detail, svc.ScopeRestockWrite)
+	assert.Equal(t, http.StatusOK, do(http.MethodPost, "/restock", restock, tokens[v1.Operator]).Code)
+
+	assert.Equal(t, http.StatusForbidden, do(http.MethodGet, "/users", "", tokens[v1.Operator]).Code)
+	assert.Equal(t, http.StatusOK, do(http.MethodGet, "/users", "", tokens[v1.Admin]).Code)
+}
+
+func TestTokenLifecycle(t *testing.T) {
+	vm := newColaMachine()
+	_, err := svc.BootstrapAdmin(context.Background(), vm.Users, "admin", "s3cret-admin")
+	require.NoError(t, err)
+	e := newAPI(t, vm)
+	tokens := func(rec *httptest.ResponseRecorder) v1.AuthTokenResponse {
+		t.Helper()
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var resp v1.AuthTokenResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		return resp
+	}
+
+	login := tokens(call(e, http.MethodPost, "/auth/login", `{"username":"admin","password":"s3cret-admin"}`, ""))
+	assert.WithinDuration(t, time.Now().Add(jwt.DefaultAccessTokenTTL), *login.ExpiresAt, time.Minute)
+	assert.WithinDuration(t, time.Now().Add(jwt.DefaultRefreshTokenTTL), *login.RefreshExpiresAt, time.Minute)
+	parsed, err := vm.auth.ValidateJWS(*login.Token)
+	require.NoError(t, err)
+	assert.Equal(t, "admin", parsed.Subject())
+	assert.NotEmpty(t, parsed.JwtID())
+	assert.False(t, parsed.IssuedAt().IsZero())
+	assert.False(t, parsed.NotBefore().IsZero())
+	assert.Equal(t, http.StatusOK, call(e, http.MethodGet, "/vending", "", *login.Token).Code)
+	assert.Equal(t, http.StatusUnauthorized, call(e, http.MethodGet, "/vending", "", *login.RefreshToken).Code,
+		"refresh tokens do not authorize requests")
+
+	refreshBody := `{"refreshToken":"` + *login.RefreshToken + `"}`
+	refreshed := tokens(call(e, http.MethodPost, "/auth/refresh", refreshBody, ""))
+	assert.NotEqual(t, *login.Token, *refreshed.Token)
+	assert.Equal(t, http.StatusOK, call(e, http.MethodGet, "/vending", "", *refreshed.Token).Code)
+	assert.Equal(t, http.StatusUnauthorized, call(e, http.MethodPost, "/auth/refresh", refreshBody, "").Code,
+		"a refresh token can only be exchanged once")
+	assert.Equal(t, http.StatusUnauthorized,
+		call(e, http.MethodPost, "/auth/refresh", `{"refreshToken":"`+*refreshed.Token+`"}`, "").Code,
+		"access tokens cannot be exchanged")
+
+	rec := call(e, http.MethodPost, "/auth/logout", `{"refreshToken":"`+*refreshed.RefreshToken+`"}`, *refreshed.Token)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, http.StatusUnauthorized, call(e, http.MethodGet, "/vending", "", *refreshed.Token).Code)
+	assert.Equal(t, http.StatusUnauthorized,
+		call(e, http.MethodPost, "/auth/refresh", `{"refreshToken":"`+*refreshed.RefreshToken+`"}`, "").Code)
+	assert.Equal(t, http.StatusOK, call(e, http.MethodGet, "/vending", "", *login.Token).Code,
+		"logging out leaves other sessions alone")
+
+	_, err = vm.Users.UpdateUser(context.Background(), "admin", func(user *svc.UserRecord) error {
+		user.Disabled = true
+		return nil
+	})
+	require.NoError(t, err)
+	rec = call(e, http.MethodPost, "/auth/refresh", `{"refreshToken":"`+*login.RefreshToken+`"}`, "")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestExpiredTokensAreRejected(t *testing.T) {
+	vm := NewVendingMachine(WithStorage(storage.NewMemoryStorage()), WithTokenTTL(-time.Hour, -time.Hour))
+	_, err := svc.BootstrapAdmin(context.Background(), vm.Users, "admin", "s3cret-admin")
+	require.NoError(t, err)
+	e := newAPI(t, vm)
+
+	rec := call(e, http.MethodPost, "/auth/login", `{"username":"admin","password":"s3cret-admin"}`, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var login v1.AuthTokenResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &login))
+	assert.Equal(t, http.StatusUnauthorized, call(e, http.MethodGet, "/vending", "", *login.Token).Code)
+	assert.Equal(t, http.StatusUnauthorized,
+		call(e, http.MethodPost, "/auth/refresh", `{"refreshToken":"`+*login.RefreshToken+`"}`, "").Code)
+
+	claims := jwtx.New()
+	require.NoError(t, claims.Set(jwtx.IssuerKey, jwt.Issuer))
+	require.NoError(t, claims.Set(jwtx.AudienceKey, jwt.Audience))
+	forever, err := vm.auth.SignToken(claims)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, call(e, http.MethodGet, "/vending", "", string(forever)).Code,
+		"tokens without an expiry are rejected")
+}
+
+func TestSigningKeyRotation(t *testing.T) {
+	oldKey, err := jwt.GenerateKey()
+	require.NoError(t, err)
+	newKey, err := jwt.GenerateKey()
+	require.NoError(t, err)
+	before := NewVendingMachine(WithSigningKeys(oldKey))
+	during := NewVendingMachine(WithSigningKeys(newKey, oldKey))
+	after := NewVendingMachine(WithSigningKeys(newKey))
+
+	oldToken, err := before.auth.CreateJWSWithClaims(nil)
+	require.NoError(t, err)
+	newToken, err := during.auth.CreateJWSWithClaims(nil)
+	require.NoError(t, err)
+	_, err = during.auth.ValidateJWS(string(oldToken))
+	assert.NoError(t, err, "tokens signed with the old key stay valid during the rotation")
+	_, err = during.auth.ValidateJWS(string(newToken))
+	assert.NoError(t, err)
+	_, err = after.auth.ValidateJWS(string(oldToken))
+	assert.Error(t, err, "the old key can be retired")
+	_, err = before.auth.ValidateJWS(string(newToken))
+	assert.Error(t, err)
+	_, err = NewVendingMachine().auth.ValidateJWS(string(newToken))
+	assert.Error(t, err, "machines without keys generate their own")
+
+	rec := call(newAPI(t, during), http.MethodGet, "/.well-known/jwks.json", "", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	set, err := jwk.Parse(rec.Body.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, 2, set.Len())
+	signing, ok := set.LookupKeyID(during.auth.SigningKeyID())
+	require.True(t, ok, "the signing key is published under its kid")
+	assert.Equal(t, jwa.ES256.String(), signing.Algorithm())
+	_, isPrivate := signing.(jwk.ECDSAPrivateKey)
+	assert.False(t, isPrivate, "only public keys are published")
+	_, err = jwtx.Parse(newToken, jwtx.WithKeySet(set))
+	assert.NoError(t, err, "other services can verify tokens with the published keys")
+}
+
+// newIdentityProvider serves the discovery document and the keys of an OIDC
+// identity provider, and returns its issuer and a function signing tokens
+// with its key.
+func newIdentityProvider(t *testing.T) (string, func(claims map[string]interface{}) string) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	private, err := jwk.New(key)
+	require.NoError(t, err)
+	require.NoError(t, private.Set(jwk.KeyIDKey, "idp-key"))
+	public, err := jwk.PublicKeyOf(private)
+	require.NoError(t, err)
+	set := jwk.NewSet()
+	set.Add(public)
+
+	mux := http.NewServeMux()
+	idp := httptest.NewServer(mux)
+	t.Cleanup(idp.Close)
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"issuer": idp.URL, "jwks_uri": idp.URL + "/keys"})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(set)
+	})
+
+	sign := func(claims map[string]interface{}) string {
+		token := jwtx.New()
+		for name, value := range map[string]interface{}{
+			jwtx.IssuerKey:     idp.URL,
+			jwtx.AudienceKey:   "vending",
+			jwtx.SubjectKey:    "00u1a2b3c4",
+			jwtx.ExpirationKey: time.Now().Add(time.Hour),
+		} {
+			require.NoError(t, token.Set(name, value))
+		}
+		for name, value := range claims {
+			require.NoError(t, token.Set(name, value))
+		}
+		jws, err := jwtx.Sign(token, jwa.RS256, private)
+		require.NoError(t, err)
+		return string(jws)
+	}
+	return idp.URL, sign
+}
+
+func TestOIDCTokens(t *testing.T) {
+	issuer, sign := newIdentityProvider(t)
+	_, err := jwt.NewOIDCValidator(context.Background(), issuer, "")
+	assert.Error(t, err)
+	_, err = jwt.NewOIDCValidator(context.Background(), issuer+"/elsewhere", "vending")
+	assert.Error(t, err, "the discovery document must be found")
+	oidc, err := jwt.NewOIDCValidator(context.Background(), issuer, "vending",
+		jwt.WithClaimMapping("groups", map[string][]string{
+			"vending-staff":  svc.RoleScopes(v1.Operator),
+			"vending-admins": svc.RoleScopes(v1.Admin),
+		}),
+		jwt.WithSubjectClaim("email"))
+	require.NoError(t, err)
+
+	vm := NewVendingMachine(WithStorage(storage.NewMemoryStorage()), WithTokenValidators(true, oidc))
+	e := newAPI(t, vm)
+	staff := sign(map[string]interface{}{"groups": []string{"everyone", "vending-staff"}, "email": "sam@colaco.example"})
+	admin := sign(map[string]interface{}{"groups": []string{"vending-admins"}, "email": "ana@colaco.example"})
+	outsider := sign(map[string]interface{}{"groups": []string{"everyone"}, "email": "eve@colaco.example"})
+
+	assert.Equal(t, http.StatusOK, call(e, http.MethodGet, "/cashbox", "", staff).Code)
+	assert.Equal(t, http.StatusForbidden, call(e, http.MethodGet, "/users", "", staff).Code)
+	assert.Equal(t, http.StatusOK, call(e, http.MethodGet, "/users", "", admin).Code)
+	assert.Equal(t, http.StatusForbidden, call(e, http.MethodGet, "/cashbox", "", outsider).Code,
+		"groups that are not mapped grant nothing")
+	assert.Equal(t, http.StatusForbidden, call(e, http.MethodGet, "/cashbox", "",
+		sign(map[string]interface{}{"perm": svc.RoleScopes(v1.Admin), "email": "eve@colaco.example"})).Code,
+		"the provider cannot grant scopes directly")
+
+	token, err := oidc.ValidateJWS(staff)
+	require.NoError(t, err)
+	assert.Equal(t, "sam@colaco.example", token.Subject())
+
+	for name, jws := range map[string]string{
+		"audience": sign(map[string]interface{}{jwtx.AudienceKey: "another-app", "groups": []string{"vending-admins"}, "email": "ana@colaco.example"}),
+		"issuer":   sign(map[string]interface{}{jwtx.IssuerKey: "https://evil.example", "groups": []string{"vending-admins"}, "email": "ana@colaco.example"}),
+		"expired":  sign(map[string]interface{}{jwtx.ExpirationKey: time.Now().Add(-time.Hour), "groups": []string{"vending-admins"}, "email": "ana@colaco.example"}),
+		"subject":  sign(map[string]interface{}{"groups": []string{"vending-admins"}}),
+	} {
+		assert.Equal(t, http.StatusUnauthorized, call(e, http.MethodGet, "/users", "", jws).Code, name)
+	}
+
+	builtin, err := vm.auth.CreateJWSForSubject("admin", svc.RoleScopes(v1.Admin))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, call(e, http.MethodGet, "/users", "", string(builtin)).Code,
+		"the tokens of the API are accepted alongside")
+
+	only := NewVendingMachine(WithStorage(storage.NewMemoryStorage()), WithTokenValidators(false, oidc))
+	e = newAPI(t, only)
+	builtin, err = only.auth.CreateJWSForSubject("admin", svc.RoleScopes(v1.Admin))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, call(e, http.MethodGet, "/users", "", string(builtin)).Code)
+	assert.Equal(t, http.StatusOK, call(e, http.MethodGet, "/users", "", admin).Code)
+}
+
+func TestAPIKeys(t *testing.T) {
+	vm := newColaMachine()
+	e := newAPI(t, vm)
+	admin, err := vm.auth.CreateJWSForSubject("admin", svc.RoleScopes(v1.Admin))
+	require.NoError(t, err)
+	withKey := func(method, path, body, key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(jwt.APIKeyHeader, key)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := call(e, http.MethodPost, "/apikeys", `{"name":"handheld","scopes":["vending:read","bogus:write"]}`, string(admin))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = call(e, http.MethodPost, "/apikeys",
+		`{"name":"handheld","scopes":["restock:write","vending:read","transactions:read"]}`, string(admin))
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var created v1.CreatedAPIKeyResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	assert.True(t, strings.HasPrefix(created.Key, svc.APIKeyPrefix+created.ApiKey.Id+"_"))
+	assert.Equal(t, "admin", created.ApiKey.CreatedBy)
+	assert.Equal(t, []string{"restock:write", "transactions:read", "vending:read"}, created.ApiKey.Scopes)
+
+	rec = call(e, http.MethodGet, "/apikeys", "", string(admin))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), created.Key, "keys are only shown when created")
+	assert.NotContains(t, rec.Body.String(), "keyHash")
+
+	assert.Equal(t, http.StatusOK, withKey(http.MethodPost, "/restock", `{"name":"A1","quantity":1}`, created.Key).Code)
+	assert.Equal(t, http.StatusForbidden, withKey(http.MethodGet, "/users", "", created.Key).Code)
+	assert.Equal(t, http.StatusForbidden, withKey(http.MethodGet, "/apikeys", "", created.Key).Code,
+		"API keys cannot manage API keys")
+	assert.Equal(t, http.StatusUnauthorized, withKey(http.MethodGet, "/vending", "", created.Key+"x").Code)
+	assert.Equal(t, http.StatusUnauthorized, withKey(http.MethodGet, "/vending", "", "colaco_unknown_secret").Code)
+
+	rec = withKey(http.MethodGet, "/transactions?operation=restock", "", created.Key)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var ledger v1.TransactionsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &ledger))
+	require.Len(t, ledger.Transactions, 1)
+	assert.Equal(t, svc.APIKeyActor(created.ApiKey.Id), ledger.Transactions[0].Actor, "the key is recorded as the actor")
+
+	rec = call(e, http.MethodDelete, "/apikeys/"+created.ApiKey.Id, "", string(admin))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var revoked v1.APIKey
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &revoked))
+	assert.NotNil(t, revoked.RevokedAt)
+	assert.Equal(t, http.StatusUnauthorized, withKey(http.MethodGet, "/vending", "", created.Key).Code)
+	assert.Equal(t, http.StatusNotFound, call(e, http.MethodDelete, "/apikeys/nope", "", string(admin)).Code)
+
+	expiresAt := time.Now().Add(50 * time.Millisecond)
+	key, record, err := svc.NewAPIKey("telemetry", []string{svc.ScopeVendingRead}, "admin", &expiresAt)
+	require.NoError(t, err)
+	require.NoError(t, vm.APIKeys.CreateAPIKey(context.Background(), record))
+	assert.Equal(t, http.StatusOK, withKey(http.MethodGet, "/vending", "", key).Code)
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, http.StatusUnauthorized, withKey(http.MethodGet, "/vending", "", key).Code)
+}
+
+func TestLoginThrottleConcurrentGuesses(t *testing.T) {
+	logins := svc.NewLoginThrottle(svc.WithLoginPolicies(
+		svc.LoginPolicy{FreeFailures: 2, BaseDelay: time.Hour, MaxDelay: time.Hour, ResetAfter: time.Hour},
+		svc.LoginPolicy{FreeFailures: 100, ResetAfter: time.Hour}))
+	vm := NewVendingMachine(WithStorage(storage.NewMemoryStorage()), WithLoginThrottle(logins))
+	_, err := svc.BootstrapAdmin(context.Background(), vm.Users, "admin", "s3cret-admin")
+	require.NoError(t, err)
+	e := newAPI(t, vm)
+
+	const guesses = 20
+	codes := make([]int, guesses)
+	var wg sync.WaitGroup
+	for i := 0; i < guesses; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			codes[i] = call(e, http.MethodPost, "/auth/login",
+				fmt.Sprintf(`{"username":"admin","password":"guess-%d"}`, i), "").Code
+		}(i)
+	}
+	wg.Wait()
+	checked := 0
+	for _, code := range codes {
+		if code == http.StatusUnauthorized {
+			checked++
+		} else {
+			assert.Equal(t, http.StatusTooManyRequests, code)
+		}
+	}
+	assert.Equal(t, 3, checked, "guesses sent at once get no more tries than guesses sent one by one")
+}
+
+func TestLoginThrottle(t *testing.T) {
+	var events []svc.LoginEvent
+	var vm *VendingMachine
+	var logins *svc.LoginThrottle
+	logins = svc.NewLoginThrottle(
+		svc.WithLoginPolicies(
+			svc.LoginPolicy{FreeFailures: 1, BaseDelay: 20 * time.Millisecond, MaxDelay: 40 * time.Millisecond,
+				LockoutFailures: 4, LockoutDuration: time.Hour, ResetAfter: time.Hour},
+			svc.LoginPolicy{FreeFailures: 5, LockoutFailures: 6, LockoutDuration: time.Hour, ResetAfter: time.Hour}),
+		svc.WithLockoutHandler(func(e svc.LoginEvent) {
+			events = append(events, e)
+			assert.NotEmpty(t, logins.Lockouts(), "the handler may use the throttle")
+			vm.auditLockout(e)
+		}))
+	vm = NewVendingMachine(WithStorage(storage.NewMemoryStorage()), WithLoginThrottle(logins))
+	_, err := svc.BootstrapAdmin(context.Background(), vm.Users, "admin", "s3cret-admin")
+	require.NoError(t, err)
+	e := newAPI(t, vm)
+	admin, err := vm.auth.CreateJWSForSubject("admin", svc.RoleScopes(v1.Admin))
+	require.NoError(t, err)
+	login := func(username, password, ip string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/auth/login",
+			strings.NewReader(`{"username":"`+username+`","password":"`+password+`"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.RemoteAddr = ip + ":4242"
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+	const home, elsewhere = "192.0.2.1", "198.51.100.7"
+
+	assert.Equal(t, http.StatusUnauthorized, login("admin", "guess-one", home).Code)
+	assert.Equal(t, http.StatusUnauthorized, login("admin", "guess-two", home).Code)
+	rec := login("admin", "s3cret-admin", home)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code, "the backoff applies even to the right password")
+	assert.Equal(t, "1", rec.Header().Get("Retry-After"))
+
+	time.Sleep(25 * time.Millisecond)
+	assert.Equal(t, http.StatusUnauthorized, login("admin", "guess-three", home).Code)
+	time.Sleep(45 * time.Millisecond)
+	assert.Equal(t, http.StatusUnauthorized, login("ADMIN", "guess-four", home).Code)
+	require.Len(t, events, 1)
+	assert.Equal(t, svc.LoginEvent{Kind: v1.Username, Value: "admin", Failures: 4, Until: events[0].Until}, events[0])
+	records, err := vm.Audit.GetAuditRecords(context.Background(), 0, 0)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, opLoginLockout, records[0].Operation)
+	assert.Equal(t, `username "admin"`, records[0].Target)
+	assert.Equal(t, anonymousActor, records[0].Subject)
+	assert.Nil(t, records[0].Before)
+	assert.Equal(t, true, (*records[0].After)["lockedOut"])
+	assert.Equal(t, 4.0, (*records[0].After)["failures"])
+	rec = login("admin", "s3cret-admin", elsewhere)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code, "the username is locked out from everywhere")
+	retry, err := strconv.Atoi(rec.Header().Get("Retry-After"))
+	require.NoError(t, err)
+	assert.InDelta(t, 3600, retry, 5)
+
+	rec = call(e, http.MethodGet, "/lockouts", "", string(admin))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var lockouts v1.LoginLockoutsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &lockouts))
+	require.Len(t, lockouts.Lockouts, 1)
+	assert.Equal(t, v1.Username, lockouts.Lockouts[0].Kind)
+	assert.Equal(t, "admin", lockouts.Lockouts[0].Value)
+	assert.True(t, lockouts.Lockouts[0].LockedOut)
+
+	assert.Equal(t, http.StatusBadRequest, call(e, http.MethodDelete, "/lockouts", "", string(admin)).Code)
+	assert.Equal(t, http.StatusOK, call(e, http.MethodDelete, "/lockouts?username=Admin", "", string(admin)).Code)
+	assert.Equal(t, http.StatusNotFound, call(e, http.MethodDelete, "/lockouts?username=admin", "", string(admin)).Code)
+	assert.Equal(t, http.StatusOK, login("admin", "s3cret-admin", home).Code)
+
+	// The address keeps its failures across usernames and successes.
+	assert.Equal(t, http.StatusUnauthorized, login("nobody", "guess", home).Code)
+	assert.Equal(t, http.StatusUnauthorized, login("somebody", "guess", home).Code)
+	require.Len(t, events, 2)
+	assert.Equal(t, v1.Ip, events[1].Kind)
+	assert.Equal(t, home, events[1].Value)
+	assert.Equal(t, http.StatusTooManyRequests, login("admin", "s3cret-admin", home).Code)
+	assert.Equal(t, http.StatusOK, login("admin", "s3cret-admin", elsewhere).Code)
+	assert.Equal(t, http.StatusOK, call(e, http.MethodDelete, "/lockouts?ip="+home, "", string(admin)).Code)
+	assert.Equal(t, http.StatusOK, login("admin", "s3cret-admin", home).Code)
+}
+
+func TestAuditTrail(t *testing.T) {
+	vm := newColaMachine()
+	e := newAPI(t, vm)
+	token := func(subject string, role v1.Role) string {
+		jws, err := vm.auth.CreateJWSForSubject(subject, svc.RoleScopes(role))
+		require.NoError(t, err)
+		return string(jws)
+	}
+	operator, admin := token("olivia", v1.Operator), token("ada", v1.Admin)
+
+	req := httptest.NewRequest(http.MethodPut, "/updatePrice", strings.NewReader(`{"name":"A1","newPrice":1.5}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer "+operator)
+	req.Header.Set(echo.HeaderXRequestID, "req-price")
+	req.RemoteAddr = "203.0.113.5:4242"
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, http.StatusOK, call(e, http.MethodPost, "/restock", `{"name":"A2","quantity":1}`, operator).Code)
+	require.Equal(t, http.StatusOK, call(e, http.MethodDelete, "/vending", `{"name":"B1"}`, operator).Code)
+	require.Equal(t, http.StatusCreated, call(e, http.MethodPost, "/users",
+		`{"username":"bob","password":"bobs-password"}`, admin).Code)
+	require.Equal(t, http.StatusOK, call(e, http.MethodPut, "/users/bob/role", `{"role":"operator"}`, admin).Code)
+	rec = call(e, http.MethodPost, "/apikeys", `{"name":"handheld","scopes":["vending:read"]}`, admin)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var created v1.CreatedAPIKeyResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	require.Equal(t, http.StatusOK, call(e, http.MethodDelete, "/apikeys/"+created.ApiKey.Id, "", admin).Code)
+	require.Equal(t, http.StatusNotFound, call(e, http.MethodDelete, "/vending", `{"name":"B1"}`, operator).Code,
+		"failed calls change nothing and are not recorded")
+
+	assert.Equal(t, http.StatusForbidden, call(e, http.MethodGet, "/audit/trail", "", operator).Code)
+	trail := func(query string) v1.AuditTrailResponse {
+		t.Helper()
+		rec := call(e, http.MethodGet, "/audit/trail"+query, "", admin)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var resp v1.AuditTrailResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		return resp
+	}
+	records := trail("").Records
+	var ops []string
+	for _, r := range records {
+		ops = append(ops, r.Operation)
+	}
+	assert.Equal(t, []string{"updatePrice", "restockSoda", "delete-vending", "create-user", "set-user-role",
+		"create-api-key", "revoke-api-key"}, ops)
+	require.NoError(t, svc.VerifyAuditTrail(records))
+
+	price := records[0]
+	assert.Equal(t, int64(1), price.Seq)
+	assert.Empty(t, price.PrevHash)
+	assert.Equal(t, "olivia", price.Subject)
+	assert.Equal(t, "203.0.113.5", price.SourceIp)
+	assert.Equal(t, "req-price", price.RequestId)
+	assert.Equal(t, "A1", price.Target)
+	require.NotNil(t, price.Before)
+	require.NotNil(t, price.After)
+	assert.Equal(t, 1.0, (*price.Before)["cost"])
+	assert.Equal(t, 1.5, (*price.After)["cost"])
+	assert.NotEmpty(t, records[1].RequestId, "the request ID is generated when the client sends none")
+	assert.Equal(t, records[0].Hash, records[1].PrevHash)
+	assert.Equal(t, "B1", records[2].Target)
+	assert.NotNil(t, records[2].Before)
+	assert.Nil(t, records[2].After)
+	assert.Equal(t, "ada", records[3].Subject)
+	assert.Nil(t, records[3].Before)
+	assert.NotContains(t, *records[3].After, "passwordHash")
+	assert.Equal(t, "customer", (*records[4].Before)["role"])
+	assert.Equal(t, "operator", (*records[4].After)["role"])
+	assert.Equal(t, created.ApiKey.Id, records[6].Target)
+	assert.NotContains(t, *records[6].After, "keyHash")
+	assert.NotNil(t, (*records[6].After)["revokedAt"])
+
+	page := trail("?limit=3")
+	assert.Equal(t, records[:3], page.Records)
+	require.NotNil(t, page.NextCursor)
+	page = trail("?limit=10&cursor=" + *page.NextCursor)
+	assert.Equal(t, records[3:], page.Records)
+	assert.Nil(t, page.NextCursor)
+	assert.Equal(t, http.StatusBadRequest, call(e, http.MethodGet, "/audit/trail?cursor=-1", "", admin).Code)
+
+	edited := append([]v1.AuditRecord{}, records...)
+	edited[1].Target = "A9"
+	err := svc.VerifyAuditTrail(edited)
+	assert.ErrorIs(t, err, svc.ErrAuditTrailBroken)
+	assert.ErrorContains(t, err, "record 2 was changed")
+	edited[1].Hash = svc.AuditRecordHash(edited[1])
+	assert.ErrorContains(t, svc.VerifyAuditTrail(edited), "record 3 does not follow record 2")
+	gap := append(append([]v1.AuditRecord{}, records[:2]...), records[3:]...)
+	assert.ErrorContains(t, svc.VerifyAuditTrail(gap), "record 3 is missing")
+}
+
+func TestV2Slots(t *testing.T) {
+	vm := newColaMachine()
+	e := newAPI(t, vm)
+	token := func(subject string, role v1.Role) string {
+		jws, err := vm.auth.CreateJWSForSubject(subject, svc.RoleScopes(role))
+		require.NoError(t, err)
+		return string(jws)
+	}
+	customer, operator := token("carl", v1.Customer), token("olivia", v1.Operator)
+	ifMatch := func(method, path, body, etag string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+operator)
+		req.Header.Set("If-Match", etag)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := call(e, http.MethodGet, "/v2/slots", "", customer)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.True(t, strings.HasPrefix(rec.Header().Get("ETag"), "W/"))
+	var slots v2.SlotsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &slots))
+	require.Len(t, slots.Slots, 3)
+	assert.Equal(t, "A1", slots.Slots[0].Id)
+	assert.Equal(t, v2.Money{Amount: 100, Currency: "USD"}, slots.Slots[0].Price)
+	assert.Equal(t, "cola", *slots.Slots[0].Soda.Id)
+	assert.Equal(t, http.StatusUnauthorized, call(e, http.MethodGet, "/v2/slots", "", "").Code)
+
+	rec = call(e, http.MethodGet, "/v2/slots/a1", "", customer)
+	require.Equal(t, http.StatusOK, rec.Code)
+	etag := rec.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+	assert.Equal(t, http.StatusNotFound, call(e, http.MethodGet, "/v2/slots/Z9", "", customer).Code)
+
+	price := `{"price":{"amount":150,"currency":"USD"}}`
+	assert.Equal(t, http.StatusForbidden, call(e, http.MethodPatch, "/v2/slots/A1", price, customer).Code)
+	rec = ifMatch(http.MethodPatch, "/v2/slots/A1", price, etag)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var slot v2.Slot
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &slot))
+	assert.Equal(t, int64(150), slot.Price.Amount)
+	assert.NotEqual(t, etag, rec.Header().Get("ETag"))
+	assert.Equal(t, http.StatusPreconditionFailed, ifMatch(http.MethodPatch, "/v2/slots/A1", price, etag).Code)
+
+	rec = call(e, http.MethodPost, "/v2/slots/A1/restocks", `{"quantity":9}`, operator)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var restocked v2.RestockResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &restocked))
+	assert.Equal(t, 2, restocked.OldQuantity)
+	assert.Equal(t, 1, restocked.Leftover)
+	assert.Equal(t, 10, restocked.Slot.Quantity)
+	assert.Equal(t, http.StatusBadRequest, call(e, http.MethodPost, "/v2/slots/A1/restocks", `{"quantity":0}`, operator).Code)
+
+	rec = call(e, http.MethodPost, "/v2/slots/A1/purchases", `{"paid":{"amount":200,"currency":"USD"}}`, customer)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var bought v2.PurchaseResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &bought))
+	assert.Equal(t, "A1", bought.SlotId)
+	assert.Equal(t, v2.Money{Amount: 50, Currency: "USD"}, bought.Change)
+	assert.Equal(t, http.StatusPaymentRequired,
+		call(e, http.MethodPost, "/v2/slots/A1/purchases", `{"paid":{"amount":100,"currency":"USD"}}`, customer).Code)
+	assert.Equal(t, http.StatusCreated,
+		call(e, http.MethodPost, "/v2/slots/B1/purchases", `{"paid":{"amount":100,"currency":"USD"}}`, customer).Code)
+	assert.Equal(t, http.StatusConflict,
+		call(e, http.MethodPost, "/v2/slots/B1/purchases", `{"paid":{"amount":100,"currency":"USD"}}`, customer).Code)
+
+	put := func(body string) *httptest.ResponseRecorder {
+		return call(e, http.MethodPut, "/v2/slots/C1", body, operator)
+	}
+	rec = put(`{"soda":{"id":"cola"},"price":{"amount":120,"currency":"USD"},"quantity":1,"maxQuantity":5}`)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &slot))
+	assert.Equal(t, "Cola", *slot.Soda.Name, "the soda is taken from the catalog")
+	rec = put(`{"soda":{"name":"Lemonade"},"price":{"amount":130,"currency":"USD"},"quantity":2,"maxQuantity":5}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &slot))
+	assert.Equal(t, "lemonade", *slot.Soda.Id)
+	assert.Equal(t, 2, slot.Quantity)
+	assert.Equal(t, http.StatusConflict,
+		put(`{"soda":{"id":"cola","name":"Pepsi"},"price":{"amount":1,"currency":"USD"},"quantity":1,"maxQuantity":5}`).Code)
+	assert.Equal(t, http.StatusBadRequest,
+		put(`{"soda":{"id":"cola"},"price":{"amount":1,"currency":"USD"},"quantity":6,"maxQuantity":5}`).Code)
+	assert.Equal(t, http.StatusBadRequest, put(`{"soda":{"id":"cola"},"quantity":1,"maxQuantity":5}`).Code,
+		"the validator requires every field")
+	assert.Equal(t, http.StatusPreconditionFailed, ifMatch(http.MethodPut, "/v2/slots/C9",
+		`{"soda":{"id":"cola"},"price":{"amount":1,"currency":"USD"},"quantity":1,"maxQuantity":5}`, "*").Code)
+
+	rec = call(e, http.MethodGet, "/v2/sodas/LEMONADE", "", customer)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var sodas v2.SodasResponse
+	require.NoError(t, json.Unmarshal(call(e, http.MethodGet, "/v2/sodas", "", customer).Body.Bytes(), &sodas))
+	var ids []string
+	for _, soda := range sodas.Sodas {
+		ids = append(ids, *soda.Id)
+	}
+	assert.Contains(t, ids, "lemonade")
+	assert.Equal(t, http.StatusNotFound, call(e, http.MethodGet, "/v2/sodas/pepsi", "", customer).Code)
+
+	assert.Equal(t, http.StatusNoContent, call(e, http.MethodDelete, "/v2/slots/C1", "", operator).Code)
+	assert.Equal(t, http.StatusNotFound, call(e, http.MethodDelete, "/v2/slots/C1", "", operator).Code)
+	assert.Equal(t, http.StatusOK, call(e, http.MethodGet, "/vending", "", customer).Code, "version 1 is still served")
+
+	txs, err := vm.Store.GetTransactions(context.Background(), svc.TransactionFilter{SlotID: "C1"})
+	require.NoError(t, err)
+	var ops []v1.TransactionOperation
+	for _, tx := range txs {
+		ops = append(ops, tx.Operation)
+	}
+	assert.Equal(t, []v1.TransactionOperation{v1.Add, v1.Delete, v1.Add, v1.Delete}, ops,
+		"a replacement is recorded as a deletion and an addition")
+	records, err := vm.Audit.GetAuditRecords(context.Background(), 0, 0)
+	require.NoError(t, err)
+	var audited []string
+	for _, r := range records {
+		audited = append(audited, r.Operation)
+	}
+	assert.Equal(t, []string{"patch-slot", "restock-slot", "put-slot", "put-slot", "delete-slot"}, audited)
+}
+
+func TestInventoryQuery(t *testing.T) {
+	vm := newColaMachine()
+	e := newAPI(t, vm)
+	jws, err := vm.auth.CreateJWSForSubject("carl", svc.RoleScopes(v1.Customer))
+	require.NoError(t, err)
+	customer := string(jws)
+	list := func(query string) v2.SlotsResponse {
+		t.Helper()
+		rec := call(e, http.MethodGet, "/v2/slots?"+query, "", customer)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var resp v2.SlotsResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		return resp
+	}
+	ids := func(slots []v2.Slot) []string {
+		ids := []string{}
+		for _, slot := range slots {
+			ids = append(ids, slot.Id)
+		}
+		return ids
+	}
+
+	assert.Equal(t, []string{"B1"}, ids(list("name=FIZ").Slots))
+	assert.Equal(t, []string{"A1", "B1"}, ids(list("stock=low_stock").Slots))
+	assert.Equal(t, []string{"A1", "A2", "B1"}, ids(list("minPrice=100&maxPrice=100&currency=USD").Slots))
+	assert.Empty(t, list("minPrice=101").Slots)
+	assert.Empty(t, list("maxPrice=500&currency=EUR").Slots)
+
+	var paged []string
+	query := "sort=-quantity&limit=1"
+	for i := 0; i < 5; i++ {
+		page := list(query)
+		paged = append(paged, ids(page.Slots)...)
+		if page.NextCursor == nil {
+			break
+		}
+		query = "sort=-quantity&limit=1&cursor=" + *page.NextCursor
+	}
+	assert.Equal(t, []string{"A2", "A1", "B1"}, paged)
+
+	page := list("sort=-quantity&limit=1")
+	require.NotNil(t, page.NextCursor)
+	assert.Equal(t, http.StatusBadRequest, call(e, http.MethodGet, "/v2/slots?cursor="+*page.NextCursor, "", customer).Code,
+		"a cursor is only valid for the sort it was issued for")
+	assert.Equal(t, http.StatusBadRequest, call(e, http.MethodGet, "/v2/slots?sort=-quantity&cursor=garbage", "", customer).Code)
+	assert.Equal(t, http.StatusBadRequest, call(e, http.MethodGet, "/v2/slots?sort=colour", "", customer).Code)
+	assert.Equal(t, http.StatusBadRequest, call(e, http.MethodGet, "/v2/slots?stock=plenty", "", customer).Code)
+	assert.Equal(t, http.StatusBadRequest, call(e, http.MethodGet, "/v2/slots?limit=0", "", customer).Code)
+
+	rec := call(e, http.MethodGet, "/vending?name=cola&sort=-id&limit=1", "", customer)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp v1.VendingMachineResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Len(t, *resp.Slots, 1)
+	assert.Equal(t, "A2", *(*resp.Slots)[0].Id)
+	assert.NotNil(t, resp.NextCursor)
+	rec = call(e, http.MethodGet, "/vending?name=pop", "", customer)
+	require.Equal(t, http.StatusOK, rec.Code, "a filter no slot matches is not an empty machine")
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Empty(t, *resp.Slots)
+}
+
+func TestProblemDetails(t *testing.T) {
+	vm := newColaMachine()
+	e := newAPI(t, vm)
+	jws, err := vm.auth.CreateJWSForSubject("olivia", svc.RoleScopes(v1.Operator))
+	require.NoError(t, err)
+	operator := string(jws)
+	problemOf := func(rec *httptest.ResponseRecorder, status int) v1.Problem {
+		t.Helper()
+		require.Equal(t, status, rec.Code, rec.Body.String())
+		assert.Equal(t, "application/problem+json", rec.Header().Get(echo.HeaderContentType))
+		var p v1.Problem
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+		assert.Equal(t, status, p.Status)
+		assert.NotEmpty(t, p.Title)
+		assert.NotEmpty(t, p.Detail)
+		return p
+	}
+
+	p := problemOf(call(e, http.MethodGet, "/v2/slots", "", ""), http.StatusUnauthorized)
+	assert.Equal(t, "about:blank", p.Type)
+	assert.Equal(t, "Unauthorized", p.Title)
+	assert.Equal(t, "/v2/slots", *p.Instance)
+
+	p = problemOf(call(e, http.MethodPost, "/v2/slots/A1/restocks", `{"quantity":0}`, operator), http.StatusBadRequest)
+	assert.Equal(t, "/problems/validation", p.Type)
+	if assert.NotNil(t, p.Errors) && assert.Len(t, *p.Errors, 1) {
+		assert.Equal(t, "quantity", (*p.Errors)[0].Field)
+	}
+	p = problemOf(call(e, http.MethodGet, "/v2/slots?limit=0&stock=plenty", "", operator), http.StatusBadRequest)
+	if assert.NotNil(t, p.Errors) {
+		var fields []string
+		for _, field := range *p.Errors {
+			fields = append(fields, field.Field)
+		}
+		assert.ElementsMatch(t, []string{"limit", "stock"}, fields, "every invalid field is reported")
+	}
+
+	p = problemOf(call(e, http.MethodPost, "/v2/slots/A1/purchases", `{"paid":{"amount":50,"currency":"USD"}}`, operator), http.StatusPaymentRequired)
+	assert.Equal(t, "/problems/insufficient-funds", p.Type)
+	p = problemOf(call(e, http.MethodPost, "/purchase", `{"slotId":"Z9","payment":1}`, operator), http.StatusNotFound)
+	assert.Equal(t, "about:blank", p.Type)
+	problemOf(call(e, http.MethodGet, "/nowhere", "", operator), http.StatusNotFound)
+	problemOf(serve(t, vm.PostPurchase, `{"slotId":`), http.StatusBadRequest)
+
+	empty := NewVendingMachine(WithStorage(storage.NewMemoryStorage()))
+	rec := httptest.NewRecorder()
+	require.NoError(t, empty.GetVending(echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/vending", nil), rec), v1.GetVendingParams{}))
+	assert.Equal(t, "vending machine is empty", problemOf(rec, http.StatusNotFound).Detail)
+
+	panicking := NewVendingMachine(WithStore(panickingStore{}))
+	jws, err = panicking.auth.CreateJWSForSubject("olivia", svc.RoleScopes(v1.Operator))
+	require.NoError(t, err)
+	p = problemOf(call(newAPI(t, panicking), http.MethodGet, "/v2/slots", "", string(jws)), http.StatusInternalServerError)
+	assert.Equal(t, "about:blank", p.Type)
+	assert.NotContains(t, p.Detail, "store exploded", "a panic must not leak")
+}
+
+// panickingStore panics when slots are queried.
+type panickingStore struct {
+	unavailableStore
+}
+
+func (panickingStore) QuerySlots(context.Context, svc.SlotQuery) ([]v1.VendingSlot, error) {
+	panic("store exploded")
+}
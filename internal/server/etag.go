@@ -0,0 +1,82 @@
+package server
+
+import (
+	"colaco-api/internal/api/v1"
+	"colaco-api/svc"
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// slotETag returns the strong entity tag for the version of slot.
+func slotETag(slot v1.VendingSlot) string {
+	return fmt.Sprintf("%q", fmt.Sprint(svc.SlotVersion(slot)))
+}
+
+// listingETag returns a weak entity tag for a listing of slots. It changes
+// whenever a slot is added, removed or changed, including when one slot is
+// swapped for another at the same version.
+func listingETag(slots []v1.VendingSlot) string {
+	h := fnv.New64a()
+	for _, slot := range slots {
+		if slot.OccupiedSoda != nil && slot.OccupiedSoda.Name != nil {
+			fmt.Fprint(h, *slot.OccupiedSoda.Name)
+		}
+		fmt.Fprintf(h, "\x00%s\x00%d\x00", svc.SlotID(slot), svc.SlotVersion(slot))
+	}
+	return fmt.Sprintf("W/\"%x\"", h.Sum64())
+}
+
+// planogramETag returns the strong entity tag of the planogram made of
+// slots, which are all the slots of the machine. It changes whenever a slot
+// is added, removed or changed.
+func planogramETag(slots []v1.VendingSlot) string {
+	h := fnv.New64a()
+	for _, slot := range slots {
+		fmt.Fprintf(h, "%s\x00%d\x00", strings.ToLower(svc.SlotID(slot)), svc.SlotVersion(slot))
+	}
+	return fmt.Sprintf("\"%x\"", h.Sum64())
+}
+
+// setETag sets the ETag response header to the version of slot.
+func setETag(ctx echo.Context, slot v1.VendingSlot) {
+	ctx.Response().Header().Set("ETag", slotETag(slot))
+}
+
+// ifMatch returns a precondition for svc.VendingStore.UpdateSlot and
+// DeleteSlotIf that enforces the If-Match header. Entity tags are compared
+// strongly as required by RFC 9110, so weak tags never match. A missing
+// header always matches.
+func ifMatch(header *v1.IfMatch) func(slot v1.VendingSlot) error {
+	return func(slot v1.VendingSlot) error {
+		if header == nil {
+			return nil
+		}
+		current := slotETag(slot)
+		for _, tag := range strings.Split(*header, ",") {
+			tag = strings.TrimSpace(tag)
+			if tag == "*" || tag == current {
+				return nil
+			}
+		}
+		return fmt.Errorf("%w: slot is at version %v", svc.ErrVersionMismatch, current)
+	}
+}
+
+// planogramIfMatch enforces the If-Match header of a planogram import
+// against the current slots like ifMatch does for a single slot.
+func planogramIfMatch(header *string, slots []v1.VendingSlot) error {
+	if header == nil {
+		return nil
+	}
+	current := planogramETag(slots)
+	for _, tag := range strings.Split(*header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || tag == current {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: the planogram is at version %v", svc.ErrVersionMismatch, current)
+}
@@ -0,0 +1,125 @@
+package server
+
+import (
+	"colaco-api/internal/api/v1"
+	"colaco-api/svc"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const maxInventoryPage = 1000
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+// inventoryCursor is what the opaque cursor of an inventory listing encodes:
+// the sort it was issued for and the last slot of the page, trimmed to its
+// ID and the values slots are ordered by. The next page continues after
+// those values, so it neither skips nor repeats slots when that slot was
+// changed or deleted in the meantime.
+type inventoryCursor struct {
+	Sort string         `json:"sort"`
+	Last v1.VendingSlot `json:"last"`
+}
+
+func encodeCursor(sort string, slot v1.VendingSlot) string {
+	last := v1.VendingSlot{Id: s2ptr(svc.SlotID(slot)), Price: svc.SlotPrice(slot), Quantity: slot.Quantity}
+	if soda := slot.OccupiedSoda; soda != nil {
+		last.OccupiedSoda = &v1.Soda{Calories: soda.Calories, Name: soda.Name, Ounces: soda.Ounces}
+	}
+	b, _ := json.Marshal(inventoryCursor{Sort: sort, Last: last})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(cursor, sort string) (v1.VendingSlot, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return v1.VendingSlot{}, errInvalidCursor
+	}
+	var c inventoryCursor
+	if err := json.Unmarshal(b, &c); err != nil || c.Sort != sort || c.Last.Id == nil {
+		return v1.VendingSlot{}, errInvalidCursor
+	}
+	return c.Last, nil
+}
+
+// sortParam returns the sort query parameter that orders slots like q.
+func sortParam(q svc.SlotQuery) string {
+	if q.Descending {
+		return "-" + string(q.OrderBy)
+	}
+	return string(q.OrderBy)
+}
+
+// slotQuery returns the svc.SlotQuery selected by the query parameters of an
+// inventory listing, which are the same for GET /vending and GET /v2/slots.
+// Prices are given in the minor unit of currency, USD unless given. With a
+// limit, one slot more than asked for is queried so querySlots can tell
+// whether there is a next page.
+func slotQuery(params v1.GetVendingParams) (svc.SlotQuery, error) {
+	q := svc.SlotQuery{
+		Name:        deref(params.Name),
+		MinCalories: params.MinCalories,
+		MaxCalories: params.MaxCalories,
+		MinOunces:   params.MinOunces,
+		MaxOunces:   params.MaxOunces,
+		OrderBy:     svc.OrderByID,
+	}
+	currency := svc.DefaultCurrency
+	if params.Currency != nil {
+		currency = strings.ToUpper(*params.Currency)
+	}
+	if params.MinPrice != nil {
+		q.MinPrice = &v1.Money{Amount: *params.MinPrice, Currency: currency}
+	}
+	if params.MaxPrice != nil {
+		q.MaxPrice = &v1.Money{Amount: *params.MaxPrice, Currency: currency}
+	}
+	if params.Stock != nil {
+		q.Stock = svc.StockStatus(*params.Stock)
+		switch q.Stock {
+		case svc.InStock, svc.LowStock, svc.SoldOut:
+		default:
+			return svc.SlotQuery{}, fmt.Errorf("invalid stock %q", *params.Stock)
+		}
+	}
+	if params.Sort != nil {
+		order, descending := strings.CutPrefix(*params.Sort, "-")
+		q.OrderBy, q.Descending = svc.SlotOrder(order), descending
+		if !svc.ValidSlotOrder(q.OrderBy) {
+			return svc.SlotQuery{}, fmt.Errorf("invalid sort %q", *params.Sort)
+		}
+	}
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > maxInventoryPage {
+			return svc.SlotQuery{}, fmt.Errorf("limit must be between 1 and %d", maxInventoryPage)
+		}
+		q.Limit = *params.Limit + 1
+	}
+	if params.Cursor != nil {
+		after, err := decodeCursor(*params.Cursor, sortParam(q))
+		if err != nil {
+			return svc.SlotQuery{}, err
+		}
+		q.After = &after
+	}
+	return q, nil
+}
+
+// querySlots returns the page of slots selected by q, built by slotQuery,
+// and the cursor of the next page, which is nil on the last one.
+func (v *VendingMachine) querySlots(ctx echo.Context, q svc.SlotQuery) ([]v1.VendingSlot, *string, error) {
+	slots, err := v.Store.QuerySlots(ctx.Request().Context(), q)
+	if err != nil {
+		return nil, nil, err
+	}
+	if q.Limit > 0 && len(slots) >= q.Limit {
+		slots = slots[:q.Limit-1]
+		return slots, s2ptr(encodeCursor(sortParam(q), slots[len(slots)-1])), nil
+	}
+	return slots, nil, nil
+}
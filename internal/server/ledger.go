@@ -0,0 +1,99 @@
+package server
+
+import (
+	"colaco-api/internal/api/v1"
+	"colaco-api/internal/jwt"
+	"colaco-api/svc"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	jwtx "github.com/lestrrat-go/jwx/jwt"
+)
+
+const (
+	defaultTransactionPage = 50
+	maxTransactionPage     = 500
+)
+
+// anonymousActor is recorded for requests that reached a handler without a
+// token naming a user.
+const anonymousActor = "anonymous"
+
+// actor returns the user a request was made by: the subject of the token
+// that jwt.Authenticate put on the echo context.
+func actor(ctx echo.Context) string {
+	if token, ok := ctx.Get(jwt.JWTClaimsContextKey).(jwtx.Token); ok && token.Subject() != "" {
+		return token.Subject()
+	}
+	return anonymousActor
+}
+
+// errUnrecorded is returned when a change was made but could not be recorded.
+// The change is not undone, so the request fails with 500 to tell the client
+// that the records are incomplete.
+var errUnrecorded = errors.New("the change was made but could not be recorded")
+
+// record appends tx to the ledger on behalf of the user making the request.
+// The change it describes has already been made, so it is recorded even if
+// the client went away meanwhile. Failing to record it is logged and
+// returned as errUnrecorded.
+func (v *VendingMachine) record(ctx echo.Context, tx v1.Transaction) error {
+	tx.Actor = actor(ctx)
+	if _, err := v.Store.AppendTransaction(context.WithoutCancel(ctx.Request().Context()), tx); err != nil {
+		log.Printf("recording %s of slot %q in the ledger: %v", tx.Operation, tx.SlotId, err)
+		return fmt.Errorf("%w in the ledger", errUnrecorded)
+	}
+	return nil
+}
+
+// GetTransactions lists one page of the ledger, oldest first, filtered by
+// the query parameters. The cursor of the next page is the ID of the last
+// transaction on this one and is only returned while more transactions
+// match.
+func (v *VendingMachine) GetTransactions(ctx echo.Context, params v1.GetTransactionsParams) error {
+	limit := defaultTransactionPage
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit < 1 || limit > maxTransactionPage {
+		return problem(ctx, http.StatusBadRequest, "limit must be between 1 and 500")
+	}
+	filter := svc.TransactionFilter{
+		SlotID: deref(params.SlotId),
+		SodaID: deref(params.SodaId),
+		Actor:  deref(params.Actor),
+		Limit:  limit + 1,
+	}
+	if params.Operation != nil {
+		filter.Operation = *params.Operation
+	}
+	if params.Since != nil {
+		filter.Since = *params.Since
+	}
+	if params.Until != nil {
+		filter.Until = *params.Until
+	}
+	if params.Cursor != nil {
+		after, err := strconv.ParseInt(*params.Cursor, 10, 64)
+		if err != nil || after < 0 {
+			return problem(ctx, http.StatusBadRequest, "invalid cursor")
+		}
+		filter.After = after
+	}
+
+	txs, err := v.Store.GetTransactions(ctx.Request().Context(), filter)
+	if err != nil {
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	}
+	resp := v1.TransactionsResponse{Transactions: txs}
+	if len(txs) > limit {
+		resp.Transactions = txs[:limit]
+		resp.NextCursor = s2ptr(strconv.FormatInt(svc.TransactionID(txs[limit-1]), 10))
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"colaco-api/internal/api/v1"
+	"colaco-api/svc"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// auditLockout records a lockout started by the login throttle in the audit
+// trail as opLoginLockout, with the lockout as the state after. No user asked
+// for it, so it is recorded as made by anonymousActor. Failing to record it
+// is logged, as the login it came from has failed anyway.
+func (v *VendingMachine) auditLockout(e svc.LoginEvent) {
+	target := fmt.Sprintf("%s %q", e.Kind, e.Value)
+	lockout := v1.LoginLockout{
+		Kind:         e.Kind,
+		Value:        e.Value,
+		Failures:     e.Failures,
+		BlockedUntil: e.Until.UTC(),
+		LockedOut:    true,
+	}
+	rec := svc.NewAuditRecord(opLoginLockout, target, nil, lockout)
+	rec.Subject = anonymousActor
+	if e.Kind == v1.Ip {
+		rec.SourceIp = e.Value
+	}
+	if _, err := v.Audit.AppendAuditRecord(context.Background(), rec); err != nil {
+		log.Printf("recording the login lockout of %s until %s in the audit trail: %v",
+			target, e.Until.UTC().Format(time.RFC3339), err)
+	}
+}
+
+// GetLoginLockouts lists the usernames and client addresses the login
+// throttle blocks.
+func (v *VendingMachine) GetLoginLockouts(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, v1.LoginLockoutsResponse{Lockouts: v.logins.Lockouts()})
+}
+
+// ClearLoginLockout forgets the failed logins of a username, a client address
+// or both, which is recorded in the audit trail.
+func (v *VendingMachine) ClearLoginLockout(ctx echo.Context, params v1.ClearLoginLockoutParams) error {
+	username, ip := strings.TrimSpace(deref(params.Username)), strings.TrimSpace(deref(params.Ip))
+	if username == "" && ip == "" {
+		return problem(ctx, http.StatusBadRequest, "A username or ip is required")
+	}
+	var cleared []string
+	if username != "" {
+		cleared = append(cleared, fmt.Sprintf("username %q", username))
+	}
+	if ip != "" {
+		cleared = append(cleared, fmt.Sprintf("ip %q", ip))
+	}
+	what := strings.Join(cleared, " and ")
+	if !v.logins.Clear(username, ip) {
+		return problem(ctx, http.StatusNotFound, "No failed logins for "+what)
+	}
+	if err := v.audit(ctx, opClearLoginLockout, what, nil, nil); err != nil {
+		return problem(ctx, http.StatusInternalServerError, err.Error())
+	}
+	return ctx.JSON(http.StatusOK, genMessageResponse("Cleared the failed logins of "+what))
+}
@@ -0,0 +1,209 @@
+package server
+
+import (
+	"colaco-api/internal/api/v1"
+	"colaco-api/svc"
+	"errors"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const yamlContentType = "application/x-yaml"
+
+// GetPlanogram exports the layout of the slots that have a position together
+// with the sodas assigned to them, as JSON or, when the format parameter asks
+// for it, as YAML, with the ETag PutPlanogram accepts as If-Match.
+func (v *VendingMachine) GetPlanogram(ctx echo.Context, params v1.GetPlanogramParams) error {
+	slots, err := v.Store.GetSlots(ctx.Request().Context())
+	if err != nil {
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	}
+	asYAML := params.Format != nil && *params.Format == v1.GetPlanogramParamsFormatYaml
+	ctx.Response().Header().Set("ETag", planogramETag(slots))
+	return writePlanogram(ctx, svc.PlanogramFromSlots(slots), asYAML)
+}
+
+// PutPlanogram imports a planogram sent as JSON or YAML, replacing every slot
+// of the machine with the slots it describes, see svc.PlanogramSlots. The
+// planogram is validated and its sodas resolved against the catalog, see
+// catalogSoda, before anything is written: an invalid planogram is rejected
+// with 400 and a soda contradicting the catalog with 409. The slots are then
+// replaced in one atomic write, if the If-Match header allows. A slot that
+// keeps its soda keeps its quantity, up to the capacity of its coil, unless
+// the assignment gives one. The changes to the sodas of every slot are
+// recorded in the ledger, see recordPlanogram, the import in the audit trail,
+// and the applied planogram is returned in the format it was sent in.
+func (v *VendingMachine) PutPlanogram(ctx echo.Context, params v1.PutPlanogramParams) error {
+	asYAML := strings.Contains(ctx.Request().Header.Get(echo.HeaderContentType), "yaml")
+	var p v1.Planogram
+	if asYAML {
+		data, err := io.ReadAll(ctx.Request().Body)
+		if err != nil {
+			return problem(ctx, http.StatusBadRequest, err.Error())
+		}
+		if p, err = svc.UnmarshalPlanogramYAML(data); err != nil {
+			return problem(ctx, http.StatusBadRequest, err.Error())
+		}
+	} else if err := ctx.Bind(&p); err != nil {
+		return bindError(ctx, err)
+	}
+	slots, err := svc.PlanogramSlots(p)
+	if err != nil {
+		return problem(ctx, http.StatusBadRequest, err.Error())
+	}
+
+	reqCtx := ctx.Request().Context()
+	// Sodas defined by an earlier assignment may be referenced by ID alone
+	// before they reach the catalog.
+	resolved := map[string]v1.Soda{}
+	for i, slot := range slots {
+		if slot.OccupiedSoda == nil {
+			continue
+		}
+		id := svc.SodaID(*slot.OccupiedSoda)
+		soda, ok := resolved[id]
+		if !ok {
+			soda, err = v.catalogSoda(reqCtx, *slot.OccupiedSoda)
+			switch {
+			case errors.Is(err, errUnacceptableSoda):
+				return problem(ctx, http.StatusBadRequest, err.Error())
+			case errors.Is(err, svc.ErrConflict):
+				return problem(ctx, http.StatusConflict, err.Error())
+			case err != nil:
+				return problem(ctx, storageErrorStatus(err), err.Error())
+			}
+			resolved[id] = soda
+		}
+		slots[i].OccupiedSoda = &soda
+	}
+	unloaded := map[string]bool{}
+	if p.Assignments != nil {
+		for _, a := range *p.Assignments {
+			if a.Quantity == nil {
+				unloaded[strings.ToLower(a.SlotId)] = true
+			}
+		}
+	}
+
+	var before []v1.VendingSlot
+	applied, err := v.Store.ReplaceSlots(reqCtx, func(current []v1.VendingSlot) ([]v1.VendingSlot, error) {
+		if err := planogramIfMatch(params.IfMatch, current); err != nil {
+			return nil, err
+		}
+		before = current
+		stocked := map[string]v1.VendingSlot{}
+		for _, slot := range current {
+			stocked[strings.ToLower(svc.SlotID(slot))] = slot
+		}
+		next := append([]v1.VendingSlot{}, slots...)
+		for i, slot := range next {
+			id := strings.ToLower(*slot.Id)
+			prev, ok := stocked[id]
+			if !ok || !unloaded[id] || prev.Quantity == nil || sodaID(prev) != sodaID(slot) {
+				continue
+			}
+			qty := min(*prev.Quantity, *slot.MaxQuantity)
+			next[i].Quantity = &qty
+		}
+		return next, nil
+	})
+	if err != nil {
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	}
+	if err := v.recordPlanogram(ctx, before, applied); err != nil {
+		return problem(ctx, http.StatusInternalServerError, err.Error())
+	}
+	if err := v.audit(ctx, opPutPlanogram, "planogram", svc.PlanogramFromSlots(before), svc.PlanogramFromSlots(applied)); err != nil {
+		return problem(ctx, http.StatusInternalServerError, err.Error())
+	}
+	ctx.Response().Header().Set("ETag", planogramETag(applied))
+	return writePlanogram(ctx, svc.PlanogramFromSlots(applied), asYAML)
+}
+
+// sodaID returns the catalog ID of the soda in slot, or "" for an empty slot.
+func sodaID(slot v1.VendingSlot) string {
+	if slot.OccupiedSoda == nil {
+		return ""
+	}
+	return svc.SodaID(*slot.OccupiedSoda)
+}
+
+// recordPlanogram records in the ledger how importing a planogram changed
+// the slots from before to after: a delete for every soda taken out of a
+// slot, an add for every soda put into one, and a restock or price change
+// for a slot that kept its soda with another quantity or price. It stops at
+// the first transaction it fails to record, see record.
+func (v *VendingMachine) recordPlanogram(ctx echo.Context, before, after []v1.VendingSlot) error {
+	prev := map[string]v1.VendingSlot{}
+	for _, slot := range before {
+		prev[strings.ToLower(svc.SlotID(slot))] = slot
+	}
+	next := map[string]v1.VendingSlot{}
+	for _, slot := range after {
+		next[strings.ToLower(svc.SlotID(slot))] = slot
+	}
+	var ids []string
+	for id := range prev {
+		ids = append(ids, id)
+	}
+	for id := range next {
+		if _, ok := prev[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		old, had := prev[id]
+		slot, has := next[id]
+		oldSoda, newSoda := sodaID(old), sodaID(slot)
+		if had && oldSoda != "" && (!has || newSoda != oldSoda) {
+			tx := svc.NewTransaction(v1.Delete, old)
+			tx.QuantityBefore, tx.QuantityAfter = tx.QuantityAfter, i2ptr(0)
+			if err := v.record(ctx, tx); err != nil {
+				return err
+			}
+		}
+		if !has || newSoda == "" {
+			continue
+		}
+		if !had || newSoda != oldSoda {
+			tx := svc.NewTransaction(v1.Add, slot)
+			tx.QuantityBefore = i2ptr(0)
+			if err := v.record(ctx, tx); err != nil {
+				return err
+			}
+			continue
+		}
+		if old.Quantity != nil && slot.Quantity != nil && *old.Quantity != *slot.Quantity {
+			tx := svc.NewTransaction(v1.Restock, slot)
+			tx.QuantityBefore, tx.Leftover = i2ptr(*old.Quantity), i2ptr(0)
+			if err := v.record(ctx, tx); err != nil {
+				return err
+			}
+		}
+		if oldPrice, newPrice := svc.SlotPrice(old), svc.SlotPrice(slot); oldPrice != nil && newPrice != nil && *oldPrice != *newPrice {
+			tx := svc.NewTransaction(v1.PriceChange, slot)
+			tx.PreviousPrice, tx.QuantityBefore = oldPrice, slot.Quantity
+			if err := v.record(ctx, tx); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func writePlanogram(ctx echo.Context, p v1.Planogram, asYAML bool) error {
+	if !asYAML {
+		return ctx.JSON(http.StatusOK, p)
+	}
+	data, err := svc.MarshalPlanogramYAML(p)
+	if err != nil {
+		return problem(ctx, http.StatusInternalServerError, err.Error())
+	}
+	return ctx.Blob(http.StatusOK, yamlContentType, data)
+}
@@ -0,0 +1,162 @@
+package server
+
+import (
+	"colaco-api/internal/api/v1"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/labstack/echo/v4"
+)
+
+// mimeProblemJSON is the media type of RFC 7807 problem details.
+const mimeProblemJSON = "application/problem+json"
+
+// problemType is a kind of problem documented in the Problem schema of
+// api.yml, identified by its URI and summarized by its title.
+type problemType struct {
+	uri, title string
+}
+
+var (
+	// blankProblem is a problem the status code describes, titled with its
+	// reason phrase.
+	blankProblem             = problemType{uri: "about:blank"}
+	validationProblem        = problemType{"/problems/validation", "Invalid request"}
+	currencyMismatchProblem  = problemType{"/problems/currency-mismatch", "Currency mismatch"}
+	insufficientFundsProblem = problemType{"/problems/insufficient-funds", "Insufficient funds"}
+	soldOutProblem           = problemType{"/problems/sold-out", "Sold out"}
+	exactChangeOnlyProblem   = problemType{"/problems/exact-change-only", "Exact change only"}
+)
+
+// newProblem returns the problem details of a problem of type t that
+// occurred on the request of ctx and is answered with status.
+func (t problemType) newProblem(ctx echo.Context, status int, detail string) v1.Problem {
+	title := t.title
+	if title == "" {
+		title = http.StatusText(status)
+	}
+	return v1.Problem{
+		Detail:   detail,
+		Instance: s2ptr(ctx.Request().URL.Path),
+		Status:   status,
+		Title:    title,
+		Type:     t.uri,
+	}
+}
+
+// write answers the request with a problem of type t.
+func (t problemType) write(ctx echo.Context, status int, detail string) error {
+	return writeProblem(ctx, t.newProblem(ctx, status, detail))
+}
+
+// writeProblem answers the request with p as application/problem+json.
+func writeProblem(ctx echo.Context, p v1.Problem) error {
+	ctx.Response().Header().Set(echo.HeaderContentType, mimeProblemJSON)
+	return ctx.JSON(p.Status, p)
+}
+
+// problem answers the request with problem details that only the status and
+// detail describe. Every handler reports its errors this way.
+func problem(ctx echo.Context, status int, detail string) error {
+	return blankProblem.write(ctx, status, detail)
+}
+
+// bindError answers a request whose body ctx.Bind could not decode with 400.
+func bindError(ctx echo.Context, err error) error {
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		return problem(ctx, http.StatusBadRequest, fmt.Sprint(he.Message))
+	}
+	return problem(ctx, http.StatusBadRequest, err.Error())
+}
+
+// problemErrorHandler is the echo.HTTPErrorHandler of the server. It answers
+// the errors that reach echo rather than a handler's own response, such as
+// 404 for unknown routes and 400 for parameters the generated wrappers cannot
+// bind, with problem details. Errors other than an echo.HTTPError are a 500
+// whose detail does not leak the error.
+func problemErrorHandler(err error, ctx echo.Context) {
+	if ctx.Response().Committed {
+		return
+	}
+	status, detail := http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError)
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		status, detail = he.Code, fmt.Sprint(he.Message)
+	} else {
+		ctx.Logger().Error(err)
+	}
+	if err := problem(ctx, status, detail); err != nil {
+		ctx.Logger().Error(err)
+	}
+}
+
+// validationMultiErrorHandler turns the errors the validator found in a
+// request into the echo.HTTPError handed to validationErrorHandler. The
+// credentials are checked first: a request without them is a 401 and one
+// lacking a scope a 403, see jwt.Authenticate. Otherwise it is a 400 whose
+// internal error is the MultiError the fields are reported from.
+func validationMultiErrorHandler(me openapi3.MultiError) *echo.HTTPError {
+	var details []string
+	for _, err := range me {
+		var security *openapi3filter.SecurityRequirementsError
+		if errors.As(err, &security) {
+			for _, err := range security.Errors {
+				var he *echo.HTTPError
+				if errors.As(err, &he) {
+					return he
+				}
+			}
+			return &echo.HTTPError{Code: http.StatusForbidden, Message: security.Error(), Internal: err}
+		}
+	}
+	for _, field := range fieldErrors("", me) {
+		details = append(details, field.Field+": "+field.Message)
+	}
+	return &echo.HTTPError{
+		Code:     http.StatusBadRequest,
+		Message:  "invalid request: " + strings.Join(details, "; "),
+		Internal: me,
+	}
+}
+
+// fieldErrors returns what is wrong with each field of a request in err, an
+// error of request validation. field names the parameter or the body the
+// error is about, and the fields of the body are named by their JSON path.
+func fieldErrors(field string, err error) []v1.FieldError {
+	var fields []v1.FieldError
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		for _, err := range e {
+			fields = append(fields, fieldErrors(field, err)...)
+		}
+	case *openapi3filter.RequestError:
+		switch {
+		case e.Parameter != nil:
+			field = e.Parameter.Name
+		case e.RequestBody != nil:
+			field = "body"
+		}
+		if e.Err == nil {
+			return []v1.FieldError{{Field: field, Message: e.Reason}}
+		}
+		return fieldErrors(field, e.Err)
+	case *openapi3.SchemaError:
+		if path := e.JSONPointer(); len(path) > 0 {
+			if field == "body" {
+				field = strings.Join(path, ".")
+			} else {
+				field += "." + strings.Join(path, ".")
+			}
+		}
+		fields = append(fields, v1.FieldError{Field: field, Message: e.Reason})
+	default:
+		message, _, _ := strings.Cut(err.Error(), "\n")
+		fields = append(fields, v1.FieldError{Field: field, Message: message})
+	}
+	return fields
+}
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"colaco-api/internal/api/v1"
+	"colaco-api/svc"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// defaultReportRange is how far back a sales report starts unless from is
+// given.
+const defaultReportRange = 7 * 24 * time.Hour
+
+// GetSalesReport reports the units sold and revenue per soda and period,
+// computed from the purchases in the ledger, as JSON or CSV.
+func (v *VendingMachine) GetSalesReport(ctx echo.Context, params v1.GetSalesReportParams) error {
+	to := time.Now().UTC()
+	if params.To != nil {
+		to = params.To.UTC()
+	}
+	from := to.Add(-defaultReportRange)
+	if params.From != nil {
+		from = params.From.UTC()
+	}
+	if !from.Before(to) {
+		return problem(ctx, http.StatusBadRequest, "from must be before to")
+	}
+	bucket := v1.Day
+	if params.Bucket != nil {
+		bucket = *params.Bucket
+	}
+	switch bucket {
+	case v1.Hour, v1.Day, v1.Week:
+	default:
+		return problem(ctx, http.StatusBadRequest, "bucket must be hour, day or week")
+	}
+
+	txs, err := v.Store.GetTransactions(ctx.Request().Context(), svc.TransactionFilter{
+		Operation: v1.Purchase,
+		SodaID:    deref(params.SodaId),
+		Since:     from,
+		Until:     to,
+	})
+	if err != nil {
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	}
+	report := svc.SalesReport(txs, from, to, bucket)
+
+	if params.Format != nil && *params.Format == v1.GetSalesReportParamsFormatCsv {
+		data, err := svc.MarshalSalesReportCSV(report)
+		if err != nil {
+			return problem(ctx, http.StatusInternalServerError, err.Error())
+		}
+		return ctx.Blob(http.StatusOK, "text/csv", data)
+	}
+	return ctx.JSON(http.StatusOK, report)
+}
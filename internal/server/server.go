@@ -2,8 +2,12 @@ package server
 
 import (
 	"colaco-api/internal/api/v1"
+	"colaco-api/internal/api/v2"
 	"colaco-api/internal/jwt"
+	"colaco-api/internal/storage"
 	"colaco-api/svc"
+	"context"
+	"crypto/ecdsa"
 	"fmt"
 	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/labstack/echo/v4"
@@ -14,15 +18,15 @@ import (
 	"net"
 	"net/http"
 	"strings"
-	"sync"
+	"time"
 )
 
 func s2ptr(s string) *string {
 	return &s
 }
 
-func genErrorResponse(error string) v1.ErrorResp {
-	return v1.ErrorResp{Error: s2ptr(error)}
+func i2ptr(i int) *int {
+	return &i
 }
 
 func genMessageResponse(s string) v1.MessageResponse {
@@ -32,15 +36,39 @@ func genMessageResponse(s string) v1.MessageResponse {
 var _ v1.ServerInterface = (*VendingMachine)(nil)
 
 type VendingMachine struct {
-	m           sync.RWMutex
-	port        string
+	port string
+	// SlotStorage is the legacy storage handed to WithStorage, kept for
+	// callers that still use VendingStorageInterface directly. It is nil when
+	// the machine was configured with WithStore.
 	SlotStorage svc.VendingStorageInterface
-}
-
-func authenticateUser(username, password string) bool {
-	// For demonstration purposes only. We would actually call another method to verify
-	// a username and password but this will be fine for now.
-	return username == "admin" && password == "password"
+	// Store is what the handlers use to reach the slots.
+	Store svc.VendingStore
+	// machine identifies the vending machine in audit files.
+	machine svc.MachineIdentity
+	// Users is the directory AuthLogin checks usernames and passwords
+	// against.
+	Users svc.UserStore
+	// APIKeys holds the API keys of the ApiKeyAuth security scheme.
+	APIKeys svc.APIKeyStore
+	// Audit keeps the audit trail of privileged calls.
+	Audit svc.AuditStore
+	// auth signs the tokens AuthLogin and AuthRefresh issue and validates
+	// them.
+	auth        *jwt.Authenticator
+	signingKeys []*ecdsa.PrivateKey
+	// revoked holds the IDs of the tokens revoked by AuthLogout and of the
+	// refresh tokens exchanged by AuthRefresh.
+	revoked    jwt.Denylist
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+	// logins throttles AuthLogin after failed logins.
+	logins     *svc.LoginThrottle
+	trustProxy bool
+	// tokens validates the tokens requests are authorized with: those of
+	// auth, unless noBuiltinTokens, and those of the external validators.
+	tokens          jwt.JWSValidator
+	external        []jwt.JWSValidator
+	noBuiltinTokens bool
 }
 
 func WithPort(port string) func(machine *VendingMachine) {
@@ -49,9 +77,114 @@ func WithPort(port string) func(machine *VendingMachine) {
 	}
 }
 
+// WithStorage configures a VendingStorageInterface implementation, adapting
+// it to svc.VendingStore for the handlers. A svc.LegacyStorage hands the
+// handlers the store it wraps.
 func WithStorage(s svc.VendingStorageInterface) func(machine *VendingMachine) {
 	return func(vm *VendingMachine) {
 		vm.SlotStorage = s
+		if l, ok := s.(*svc.LegacyStorage); ok {
+			vm.Store = l.Store
+			return
+		}
+		vm.Store = svc.NewLegacyStore(s)
+	}
+}
+
+// WithStore configures a native svc.VendingStore implementation.
+func WithStore(s svc.VendingStore) func(machine *VendingMachine) {
+	return func(vm *VendingMachine) {
+		vm.SlotStorage = nil
+		vm.Store = s
+	}
+}
+
+// WithUserStore configures the user directory. Without it the machine starts
+// with an empty directory kept in memory.
+func WithUserStore(users svc.UserStore) func(machine *VendingMachine) {
+	return func(vm *VendingMachine) {
+		vm.Users = users
+	}
+}
+
+// WithAPIKeyStore configures where API keys are kept. Without it the machine
+// starts without API keys, and those it creates are kept in memory.
+func WithAPIKeyStore(keys svc.APIKeyStore) func(machine *VendingMachine) {
+	return func(vm *VendingMachine) {
+		vm.APIKeys = keys
+	}
+}
+
+// WithAuditStore configures where the audit trail is kept. Without it the
+// machine keeps it in memory.
+func WithAuditStore(audit svc.AuditStore) func(machine *VendingMachine) {
+	return func(vm *VendingMachine) {
+		vm.Audit = audit
+	}
+}
+
+// WithLoginThrottle sets how failed logins slow down further attempts.
+// Without it svc.NewLoginThrottle applies, logging lockouts.
+func WithLoginThrottle(logins *svc.LoginThrottle) func(machine *VendingMachine) {
+	return func(vm *VendingMachine) {
+		vm.logins = logins
+	}
+}
+
+// WithTrustedProxy, when trust is true, takes the client address from the
+// X-Forwarded-For header when the request comes from a private or loopback
+// address, as it does behind a reverse proxy. Otherwise the address is the one
+// the request came from, as clients could escape the login throttle by making
+// up addresses.
+func WithTrustedProxy(trust bool) func(machine *VendingMachine) {
+	return func(vm *VendingMachine) {
+		vm.trustProxy = trust
+	}
+}
+
+// WithTokenTTL sets how long access and refresh tokens are valid. Zero keeps
+// the defaults, jwt.DefaultAccessTokenTTL and jwt.DefaultRefreshTokenTTL.
+func WithTokenTTL(access, refresh time.Duration) func(machine *VendingMachine) {
+	return func(vm *VendingMachine) {
+		vm.accessTTL = access
+		vm.refreshTTL = refresh
+	}
+}
+
+// WithSigningKeys sets the keys tokens are signed and validated with, see
+// jwt.NewKeyAuthenticator. Without them the machine generates a key that only
+// lives as long as the process.
+func WithSigningKeys(keys ...*ecdsa.PrivateKey) func(machine *VendingMachine) {
+	return func(vm *VendingMachine) {
+		vm.signingKeys = keys
+	}
+}
+
+// WithTokenValidators sets which tokens authorize requests besides, or when
+// builtin is false instead of, the tokens issued by /auth/login, such as those
+// of an external identity provider validated by a jwt.OIDCValidator. Users of
+// the directory can still log in when builtin is false, but their tokens are
+// rejected.
+func WithTokenValidators(builtin bool, external ...jwt.JWSValidator) func(machine *VendingMachine) {
+	return func(vm *VendingMachine) {
+		vm.noBuiltinTokens = !builtin
+		vm.external = external
+	}
+}
+
+// WithMachineIdentity sets the serial number, model, asset number and
+// location the machine is identified by in DEX audit files. Empty fields keep
+// their defaults from svc.DefaultMachineIdentity.
+func WithMachineIdentity(id svc.MachineIdentity) func(machine *VendingMachine) {
+	return func(vm *VendingMachine) {
+		if id.Serial != "" {
+			vm.machine.Serial = id.Serial
+		}
+		if id.Model != "" {
+			vm.machine.Model = id.Model
+		}
+		vm.machine.Asset = id.Asset
+		vm.machine.Location = id.Location
 	}
 }
 
@@ -60,49 +193,71 @@ func WithStorage(s svc.VendingStorageInterface) func(machine *VendingMachine) {
 //
 // The function takes in a slice of VendingSlot objects representing the slots in
 // the vending machine, and returns a function that modifies the provided
-// VendingMachine by setting the
+// VendingMachine by adding each of them to its store under the slot's ID, or
+// under its soda's name when it has none.
+//
+// The sodas are only seeded into empty storage so that a durable backend keeps
+// the restocks, price changes and purchases it recorded before a restart.
 func WithStartingSodas(sodas []v1.VendingSlot) func(machine *VendingMachine) {
 	return func(vm *VendingMachine) {
-		if vm.SlotStorage == nil {
-			log.Fatalln("please initialize storage first via WithStorage option.")
+		if vm.Store == nil {
+			log.Fatalln("please initialize storage first via WithStorage or WithStore option.")
+		}
+		ctx := context.Background()
+		existing, err := vm.Store.GetSlots(ctx)
+		if err != nil {
+			log.Fatalln("error reading storage:", err.Error())
+		}
+		if len(existing) > 0 {
+			return
 		}
 		for _, soda := range sodas {
-			if soda.OccupiedSoda.Name != nil {
-				// We will just set the name of the vending slot to the name of the Soda
-				// until we need to support multiple slots with the same soda. Cola Co
-				// hasn't stated they needed this.
-				vm.SlotStorage.AddSlot(strings.ToLower(*soda.OccupiedSoda.Name), soda)
+			// Slots without an ID are named after their soda.
+			if id := svc.SlotID(soda); id != "" {
+				err := vm.Store.AddSlot(ctx, id, soda)
+				if err != nil {
+					log.Fatalln("error seeding starting sodas:", err.Error())
+				}
 			}
 		}
 	}
 }
 
-// CreateMiddleware takes a JWSValidator and returns a slice of echo.MiddlewareFunc
-// and an error. The function first tries to load the Swagger specification using the
-// GetSwagger function. If there is an error loading the spec, it returns an error.
-// Next, it creates a validator middleware using the OapiRequestValidatorWithOptions
-// function from the "github.com/deepmap/oapi-codegen/v2/pkg/middleware" package. The
-// validator middleware is configured with options to silence warning messages and
-// set the authentication function using the NewAuthenticator function. Then, a custom
+// CreateMiddleware takes a JWSValidator, an APIKeyValidator and the Denylist of revoked tokens and returns a slice of echo.MiddlewareFunc
+// and an error. The function first tries to load the Swagger specifications of both
+// versions of the API using their GetSwagger functions. If there is an error loading a spec,
+// it returns an error. Next, it creates a validator middleware for each using the
+// OapiRequestValidatorWithOptions function from the "github.com/oapi-codegen/echo-middleware"
+// package. The validator middleware is configured with options to silence warning messages,
+// set the authentication function using the NewAuthenticator function and answer
+// rejected requests with validationErrorHandler. Then, a custom
 // skipAuthMiddleware is defined as a function that checks if the request path is
-// "/auth/login", "/openapi.yaml", or "/docs". If the path matches any of these, it
-// skips the validator middleware and proceeds to the next handler. Otherwise, it
-// applies the validator middleware using the validator function returned by the
-// oapi-codegen library. Finally, the skipAuthMiddleware is returned as the only
-// element in the middleware slice.
-func CreateMiddleware(v jwt.JWSValidator) ([]echo.MiddlewareFunc, error) {
+// "/auth/login", "/openapi.yaml", "/v2/openapi.yaml" or "/docs". If the path matches any
+// of these, it skips the validator middleware and proceeds to the next handler. Otherwise,
+// it applies the validator of the version the path belongs to, version 2 for the paths
+// under /v2/. Finally, the skipAuthMiddleware is returned as the only element in the
+// middleware slice.
+func CreateMiddleware(v jwt.JWSValidator, keys jwt.APIKeyValidator, revoked jwt.Denylist) ([]echo.MiddlewareFunc, error) {
 	spec, err := v1.GetSwagger()
 	if err != nil {
 		return nil, fmt.Errorf("loading spec: %w", err)
 	}
+	specV2, err := v2.GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("loading v2 spec: %w", err)
+	}
 
-	validator := middleware.OapiRequestValidatorWithOptions(spec,
-		&middleware.Options{
-			SilenceServersWarning: true,
-			Options: openapi3filter.Options{
-				AuthenticationFunc: jwt.NewAuthenticator(v),
-			},
-		})
+	options := &middleware.Options{
+		SilenceServersWarning: true,
+		Options: openapi3filter.Options{
+			AuthenticationFunc: jwt.NewAuthenticator(v, keys, revoked),
+			MultiError:         true,
+		},
+		ErrorHandler:      validationErrorHandler,
+		MultiErrorHandler: validationMultiErrorHandler,
+	}
+	validator := middleware.OapiRequestValidatorWithOptions(spec, options)
+	validatorV2 := middleware.OapiRequestValidatorWithOptions(specV2, options)
 
 	// Wrap the validator in a custom middleware to exclude the /auth/login path
 	skipAuthMiddleware := func(next echo.HandlerFunc) echo.HandlerFunc {
@@ -112,7 +267,7 @@ func CreateMiddleware(v jwt.JWSValidator) ([]echo.MiddlewareFunc, error) {
 				// If so, skip the validator middleware and continue to the next handler
 				return next(c)
 			}
-			if c.Path() == "/openapi.yaml" {
+			if c.Path() == "/openapi.yaml" || c.Path() == "/v2/openapi.yaml" {
 				// If so, skip the validator middleware and continue to the next handler
 				return next(c)
 			}
@@ -120,6 +275,10 @@ func CreateMiddleware(v jwt.JWSValidator) ([]echo.MiddlewareFunc, error) {
 				// If so, skip the validator middleware and continue to the next handler
 				return next(c)
 			}
+			// Version 2 lives under /v2 and is validated against its own spec
+			if strings.HasPrefix(c.Path(), "/v2/") {
+				return validatorV2(next)(c)
+			}
 			// For all other paths, apply the validator middleware
 			return validator(next)(c)
 		}
@@ -128,11 +287,29 @@ func CreateMiddleware(v jwt.JWSValidator) ([]echo.MiddlewareFunc, error) {
 	return []echo.MiddlewareFunc{skipAuthMiddleware}, nil
 }
 
+// validationErrorHandler answers requests the validator rejected with
+// problem details, like the handlers do. Requests without a valid token get
+// 401 and those whose token lacks a scope the operation requires get 403, see
+// jwt.Authenticate; requests not matching the spec get 400 with the errors of
+// each field, see validationMultiErrorHandler.
+func validationErrorHandler(c echo.Context, err *echo.HTTPError) error {
+	if err.Code == http.StatusUnauthorized {
+		c.Response().Header().Set(echo.HeaderWWWAuthenticate, "Bearer")
+	}
+	if err.Code != http.StatusBadRequest || err.Internal == nil {
+		return problem(c, err.Code, fmt.Sprint(err.Message))
+	}
+	p := validationProblem.newProblem(c, err.Code, fmt.Sprint(err.Message))
+	fields := fieldErrors("", err.Internal)
+	p.Errors = &fields
+	return writeProblem(c, p)
+}
+
 // NewVendingMachine creates a new instance of the VendingMachine struct with the
 // provided options applied. The options parameter is a variadic function that
 // takes in functions with
 func NewVendingMachine(options ...func(machine *VendingMachine)) *VendingMachine {
-	vm := &VendingMachine{}
+	vm := &VendingMachine{machine: svc.DefaultMachineIdentity}
 	for _, option := range options {
 		option(vm)
 	}
@@ -141,16 +318,67 @@ func NewVendingMachine(options ...func(machine *VendingMachine)) *VendingMachine
 		// port is not set via func opt.
 		vm.port = "8080"
 	}
+	if vm.Users == nil {
+		vm.Users = storage.NewMemoryUserStore()
+	}
+	if vm.APIKeys == nil {
+		vm.APIKeys = storage.NewMemoryAPIKeyStore()
+	}
+	if vm.Audit == nil {
+		vm.Audit = storage.NewMemoryAuditStore()
+	}
+	if vm.logins == nil {
+		vm.logins = svc.NewLoginThrottle(svc.WithLockoutHandler(vm.auditLockout))
+	}
+	if len(vm.signingKeys) == 0 {
+		key, err := jwt.GenerateKey()
+		if err != nil {
+			log.Fatalln("error creating the authenticator and can't move forward:", err.Error())
+		}
+		vm.signingKeys = append(vm.signingKeys, key)
+	}
+	var authOptions []func(*jwt.Authenticator)
+	if vm.accessTTL != 0 {
+		authOptions = append(authOptions, jwt.WithAccessTokenTTL(vm.accessTTL))
+	}
+	if vm.refreshTTL != 0 {
+		authOptions = append(authOptions, jwt.WithRefreshTokenTTL(vm.refreshTTL))
+	}
+	auth, err := jwt.NewKeyAuthenticator(vm.signingKeys, authOptions...)
+	if err != nil {
+		log.Fatalln("error creating the authenticator and can't move forward:", err.Error())
+	}
+	vm.auth = auth
+	vm.revoked = jwt.NewMemoryDenylist()
+	var tokens jwt.AnyValidator
+	if !vm.noBuiltinTokens {
+		tokens = append(tokens, vm.auth)
+	}
+	vm.tokens = append(tokens, vm.external...)
 	return vm
 }
 
+// ipExtractor returns how the client address is found, see
+// WithTrustedProxy.
+func (v *VendingMachine) ipExtractor() echo.IPExtractor {
+	if v.trustProxy {
+		return echo.ExtractIPFromXFFHeader()
+	}
+	return echo.ExtractIPDirect()
+}
+
 func (v *VendingMachine) Run() {
 	e := echo.New()
-	fa, err := jwt.NewFakeAuthenticator()
+	e.HTTPErrorHandler = problemErrorHandler
+	e.IPExtractor = v.ipExtractor()
+	mw, err := CreateMiddleware(v.tokens, apiKeyValidator{v.APIKeys}, v.revoked)
 	if err != nil {
-		log.Fatalln("error creating the authenticator and can't move forward:", err.Error())
+		log.Fatalln("error creating the middleware:", err.Error())
 	}
-	mw, err := CreateMiddleware(fa)
+	// Recover comes first so that a panic anywhere below it is answered by
+	// problemErrorHandler as a 500.
+	e.Use(emiddle.Recover())
+	e.Use(emiddle.RequestID())
 	e.Use(emiddle.Logger())
 	e.Use(mw...)
 	e.GET("/openapi.yaml", func(c echo.Context) error {
@@ -167,6 +395,13 @@ func (v *VendingMachine) Run() {
 		f, err := fs.ReadFile(v1.Content, "api.yml")
 		return c.Blob(http.StatusOK, "application/x-yaml", f)
 	})
+	e.GET("/v2/openapi.yaml", func(c echo.Context) error {
+		f, err := fs.ReadFile(v2.Content, "api.yml")
+		if err != nil {
+			return err
+		}
+		return c.Blob(http.StatusOK, "application/x-yaml", f)
+	})
 
 	e.GET("/docs", func(c echo.Context) error {
 		htmlContent, err := fs.ReadFile(v1.Content, "redoc.html")
@@ -176,5 +411,6 @@ func (v *VendingMachine) Run() {
 		return c.HTMLBlob(http.StatusOK, htmlContent)
 	})
 	v1.RegisterHandlers(e, v)
+	v2.RegisterHandlers(e, apiV2{v})
 	e.Logger.Fatal(e.Start(net.JoinHostPort("0.0.0.0", v.port)))
 }
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"colaco-api/internal/api/v1"
+	"colaco-api/svc"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// userErrorStatus extends storageErrorStatus with the errors of the user
+// directory.
+func userErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, svc.ErrUserNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, svc.ErrUserExists):
+		return http.StatusConflict
+	case errors.Is(err, svc.ErrInvalidUser):
+		return http.StatusBadRequest
+	}
+	return storageErrorStatus(err)
+}
+
+// GetUsers lists the users of the directory, without their password hashes.
+func (v *VendingMachine) GetUsers(ctx echo.Context) error {
+	records, err := v.Users.GetUsers(ctx.Request().Context())
+	if err != nil {
+		return problem(ctx, userErrorStatus(err), err.Error())
+	}
+	users := make([]v1.User, 0, len(records))
+	for _, r := range records {
+		users = append(users, r.User)
+	}
+	return ctx.JSON(http.StatusOK, v1.UsersResponse{Users: users})
+}
+
+// CreateUser adds a user to the directory, which is recorded in the audit
+// trail. Without a role the user is a
+// customer, or an admin when the deprecated admin flag is set. A username that
+// is taken returns 409, and an invalid username, role or a password that is too
+// short returns 400.
+func (v *VendingMachine) CreateUser(ctx echo.Context) error {
+	var body v1.CreateUserJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return bindError(ctx, err)
+	}
+	role := v1.Customer
+	if body.Role != nil {
+		role = *body.Role
+	} else if body.Admin != nil && *body.Admin {
+		role = v1.Admin
+	}
+	user, err := svc.NewUser(body.Username, body.Password, role)
+	if err != nil {
+		return problem(ctx, userErrorStatus(err), err.Error())
+	}
+	if err := v.Users.CreateUser(ctx.Request().Context(), user); err != nil {
+		return problem(ctx, userErrorStatus(err), err.Error())
+	}
+	if err := v.audit(ctx, opCreateUser, user.Username, nil, user.User); err != nil {
+		return problem(ctx, http.StatusInternalServerError, err.Error())
+	}
+	return ctx.JSON(http.StatusCreated, user.User)
+}
+
+// DisableUser stops the user from logging in until they are enabled again.
+func (v *VendingMachine) DisableUser(ctx echo.Context, username string) error {
+	return v.updateUser(ctx, opDisableUser, username, func(user *svc.UserRecord) error {
+		user.Disabled = true
+		return nil
+	})
+}
+
+// EnableUser lets a disabled user log in again.
+func (v *VendingMachine) EnableUser(ctx echo.Context, username string) error {
+	return v.updateUser(ctx, opEnableUser, username, func(user *svc.UserRecord) error {
+		user.Disabled = false
+		return nil
+	})
+}
+
+// ResetUserPassword replaces the password of the user.
+func (v *VendingMachine) ResetUserPassword(ctx echo.Context, username string) error {
+	var body v1.ResetUserPasswordJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return bindError(ctx, err)
+	}
+	hash, err := svc.HashPassword(body.Password)
+	if err != nil {
+		return problem(ctx, userErrorStatus(err), err.Error())
+	}
+	return v.updateUser(ctx, opResetUserPassword, username, func(user *svc.UserRecord) error {
+		user.PasswordHash = hash
+		return nil
+	})
+}
+
+// SetUserRole gives the user another role. Tokens already issued keep the
+// scopes of the old role.
+func (v *VendingMachine) SetUserRole(ctx echo.Context, username string) error {
+	var body v1.SetUserRoleJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return bindError(ctx, err)
+	}
+	if err := svc.ValidRole(body.Role); err != nil {
+		return problem(ctx, userErrorStatus(err), err.Error())
+	}
+	return v.updateUser(ctx, opSetUserRole, username, func(user *svc.UserRecord) error {
+		svc.SetRole(user, body.Role)
+		return nil
+	})
+}
+
+// updateUser applies fn to the user and returns the result, or 404 if there
+// is no such user. The change is recorded in the audit trail as operation,
+// without the password hashes.
+func (v *VendingMachine) updateUser(ctx echo.Context, operation, username string, fn func(user *svc.UserRecord) error) error {
+	var before v1.User
+	user, err := v.Users.UpdateUser(ctx.Request().Context(), username, func(user *svc.UserRecord) error {
+		before = user.User
+		return fn(user)
+	})
+	if err != nil {
+		return problem(ctx, userErrorStatus(err), err.Error())
+	}
+	if err := v.audit(ctx, operation, user.Username, before, user.User); err != nil {
+		return problem(ctx, http.StatusInternalServerError, err.Error())
+	}
+	return ctx.JSON(http.StatusOK, user.User)
+}
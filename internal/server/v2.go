@@ -0,0 +1,314 @@
+package server
+
+import (
+	"colaco-api/internal/api/v1"
+	"colaco-api/internal/api/v2"
+	"colaco-api/svc"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// apiV2 serves version 2 of the API, see internal/api/v2, from the machine
+// serving version 1. Both versions share the stores, and the changes made
+// through either are recorded in the same ledger and audit trail.
+type apiV2 struct {
+	*VendingMachine
+}
+
+var _ v2.ServerInterface = apiV2{}
+
+// slotV2 returns slot the way version 2 represents it. A slot without a
+// price costs nothing in svc.DefaultCurrency.
+func slotV2(slot v1.VendingSlot) v2.Slot {
+	s := v2.Slot{
+		Id:      svc.SlotID(slot),
+		Price:   v2.Money{Currency: svc.DefaultCurrency},
+		Version: svc.SlotVersion(slot),
+	}
+	if slot.OccupiedSoda != nil {
+		s.Soda = v2.Soda(*slot.OccupiedSoda)
+	}
+	if price := svc.SlotPrice(slot); price != nil {
+		s.Price = v2.Money(*price)
+	}
+	if slot.Quantity != nil {
+		s.Quantity = *slot.Quantity
+	}
+	if slot.MaxQuantity != nil {
+		s.MaxQuantity = *slot.MaxQuantity
+	}
+	return s
+}
+
+func denominationsV1(ds []v2.Denomination) []v1.Denomination {
+	converted := make([]v1.Denomination, len(ds))
+	for i, d := range ds {
+		converted[i] = v1.Denomination(d)
+	}
+	return converted
+}
+
+func denominationsV2(ds []v1.Denomination) []v2.Denomination {
+	converted := make([]v2.Denomination, len(ds))
+	for i, d := range ds {
+		converted[i] = v2.Denomination(d)
+	}
+	return converted
+}
+
+// ListSlots lists the slots selected by the query parameters, which are
+// the same as for GetVending, with a weak ETag for the listing. An empty
+// machine is an empty list.
+func (v apiV2) ListSlots(ctx echo.Context, params v2.ListSlotsParams) error {
+	q, err := slotQuery(v1.GetVendingParams(params))
+	if err != nil {
+		return problem(ctx, http.StatusBadRequest, err.Error())
+	}
+	slots, next, err := v.querySlots(ctx, q)
+	if err != nil {
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	}
+	resp := v2.SlotsResponse{NextCursor: next, Slots: make([]v2.Slot, len(slots))}
+	for i, slot := range slots {
+		resp.Slots[i] = slotV2(slot)
+	}
+	ctx.Response().Header().Set("ETag", listingETag(slots))
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// GetSlot returns the slot with its version as the ETag.
+func (v apiV2) GetSlot(ctx echo.Context, slotId v2.SlotId) error {
+	slot, err := v.Store.GetSlot(ctx.Request().Context(), slotId)
+	if err != nil {
+		return storageError(ctx, err, fmt.Sprintf("slot '%v' not found", slotId))
+	}
+	setETag(ctx, slot)
+	return ctx.JSON(http.StatusOK, slotV2(slot))
+}
+
+// PutSlot creates the slot or replaces what it holds, keeping its position.
+// Its soda is resolved against the catalog first, see catalogSoda. A slot
+// that exists is replaced as one atomic read-modify-write, if the If-Match
+// header allows, and is recorded in the ledger as the deletion of the old
+// slot and the addition of the new one. With an If-Match header the slot
+// has to exist, so a missing slot is a failed precondition rather than
+// created. A slot created by a concurrent request in between is a conflict.
+func (v apiV2) PutSlot(ctx echo.Context, slotId v2.SlotId, params v2.PutSlotParams) error {
+	var body v2.PutSlotJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return bindError(ctx, err)
+	}
+	if body.Quantity < 0 || body.Price.Amount < 0 {
+		return problem(ctx, http.StatusBadRequest, "quantity and price cannot be negative")
+	}
+	if body.Quantity > body.MaxQuantity {
+		mess := fmt.Sprintf("quantity %d exceeds the maximum quantity %d", body.Quantity, body.MaxQuantity)
+		return problem(ctx, http.StatusBadRequest, mess)
+	}
+	reqCtx := ctx.Request().Context()
+	soda, err := v.catalogSoda(reqCtx, v1.Soda(body.Soda))
+	switch {
+	case errors.Is(err, errUnacceptableSoda):
+		return problem(ctx, http.StatusBadRequest, err.Error())
+	case err != nil:
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	}
+	fill := func(slot *v1.VendingSlot) {
+		qty, maxQty := body.Quantity, body.MaxQuantity
+		slot.OccupiedSoda = &soda
+		slot.Quantity, slot.MaxQuantity = &qty, &maxQty
+		svc.SetPrice(slot, v1.Money(body.Price))
+	}
+
+	var before v1.VendingSlot
+	precondition := ifMatch(params.IfMatch)
+	slot, err := v.Store.UpdateSlot(reqCtx, slotId, func(slot *v1.VendingSlot) error {
+		if err := precondition(*slot); err != nil {
+			return err
+		}
+		before = *slot
+		fill(slot)
+		return nil
+	})
+	switch {
+	case errors.Is(err, svc.ErrNotFound) && params.IfMatch != nil:
+		return problem(ctx, http.StatusPreconditionFailed, fmt.Sprintf("slot '%v' does not exist", slotId))
+	case errors.Is(err, svc.ErrNotFound):
+		return v.createSlot(ctx, slotId, fill)
+	case err != nil:
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	}
+	removed := svc.NewTransaction(v1.Delete, before)
+	removed.QuantityBefore, removed.QuantityAfter = removed.QuantityAfter, i2ptr(0)
+	added := svc.NewTransaction(v1.Add, slot)
+	added.QuantityBefore = i2ptr(0)
+	for _, tx := range []v1.Transaction{removed, added} {
+		if err := v.record(ctx, tx); err != nil {
+			return problem(ctx, http.StatusInternalServerError, err.Error())
+		}
+	}
+	if err := v.audit(ctx, opPutSlot, svc.SlotID(slot), before, slot); err != nil {
+		return problem(ctx, http.StatusInternalServerError, err.Error())
+	}
+	setETag(ctx, slot)
+	return ctx.JSON(http.StatusOK, slotV2(slot))
+}
+
+// createSlot is PutSlot for a slot that does not exist yet.
+func (v apiV2) createSlot(ctx echo.Context, slotId string, fill func(slot *v1.VendingSlot)) error {
+	reqCtx := ctx.Request().Context()
+	slot := v1.VendingSlot{Id: &slotId}
+	fill(&slot)
+	if err := v.Store.AddSlot(reqCtx, slotId, slot); err != nil {
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	}
+	// The store sets the version.
+	slot, err := v.Store.GetSlot(reqCtx, slotId)
+	if err != nil {
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	}
+	tx := svc.NewTransaction(v1.Add, slot)
+	tx.QuantityBefore = i2ptr(0)
+	if err := v.record(ctx, tx); err != nil {
+		return problem(ctx, http.StatusInternalServerError, err.Error())
+	}
+	if err := v.audit(ctx, opPutSlot, slotId, nil, slot); err != nil {
+		return problem(ctx, http.StatusInternalServerError, err.Error())
+	}
+	setETag(ctx, slot)
+	return ctx.JSON(http.StatusCreated, slotV2(slot))
+}
+
+// PatchSlot changes the price of the slot, see reprice.
+func (v apiV2) PatchSlot(ctx echo.Context, slotId v2.SlotId, params v2.PatchSlotParams) error {
+	var body v2.PatchSlotJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return bindError(ctx, err)
+	}
+	if body.Price.Amount < 0 {
+		return problem(ctx, http.StatusBadRequest, "a price cannot be negative")
+	}
+	price := v1.Money(body.Price)
+	slot, _, err := v.reprice(ctx, opPatchSlot, slotId, &price, nil, params.IfMatch)
+	if err != nil {
+		return storageError(ctx, err, fmt.Sprintf("slot '%v' not found", slotId))
+	}
+	setETag(ctx, slot)
+	return ctx.JSON(http.StatusOK, slotV2(slot))
+}
+
+// DeleteSlot deletes the slot, see deleteSlot.
+func (v apiV2) DeleteSlot(ctx echo.Context, slotId v2.SlotId, params v2.DeleteSlotParams) error {
+	if _, err := v.deleteSlot(ctx, opDeleteSlot, slotId, params.IfMatch); err != nil {
+		return storageError(ctx, err, fmt.Sprintf("slot '%v' not found", slotId))
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// RestockSlot loads sodas into the slot, see restock, and reports what did
+// not fit.
+func (v apiV2) RestockSlot(ctx echo.Context, slotId v2.SlotId, params v2.RestockSlotParams) error {
+	var body v2.RestockSlotJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return bindError(ctx, err)
+	}
+	slot, oldQty, leftover, err := v.restock(ctx, opRestockSlot, slotId, body.Quantity, params.IfMatch)
+	if errors.Is(err, errRestockQuantity) {
+		return problem(ctx, http.StatusBadRequest, err.Error())
+	}
+	if err != nil {
+		return storageError(ctx, err, fmt.Sprintf("slot '%v' not found", slotId))
+	}
+	setETag(ctx, slot)
+	return ctx.JSON(http.StatusCreated, v2.RestockResponse{
+		Leftover:    leftover,
+		OldQuantity: oldQty,
+		Slot:        slotV2(slot),
+	})
+}
+
+// PurchaseFromSlot sells one soda from the slot, see sell. It is paid with
+// paid, or with the coins and bills inserted, whose total in the currency of
+// the cash box paid has to agree with when both are sent.
+func (v apiV2) PurchaseFromSlot(ctx echo.Context, slotId v2.SlotId) error {
+	var body v2.PurchaseFromSlotJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return bindError(ctx, err)
+	}
+	var inserted []v1.Denomination
+	if body.Inserted != nil {
+		inserted = denominationsV1(*body.Inserted)
+	}
+	var paid v1.Money
+	switch {
+	case len(inserted) > 0:
+		if err := svc.ValidateInserted(inserted); err != nil {
+			return problem(ctx, http.StatusBadRequest, err.Error())
+		}
+		box, err := v.Store.GetCashBox(ctx.Request().Context())
+		if err != nil {
+			return problem(ctx, storageErrorStatus(err), err.Error())
+		}
+		if paid, err = cashPaid(box, inserted, (*v1.Money)(body.Paid)); err != nil {
+			return problem(ctx, http.StatusBadRequest, err.Error())
+		}
+	case body.Paid != nil:
+		paid = v1.Money(*body.Paid)
+	default:
+		return problem(ctx, http.StatusBadRequest, "a payment is required")
+	}
+	sold, err := v.sell(ctx, "", slotId, paid, inserted)
+	switch {
+	case errors.Is(err, svc.ErrInsufficientFunds):
+		mess := fmt.Sprintf("insufficient funds: the soda costs %v and %v was paid",
+			svc.FormatMoney(*svc.SlotPrice(sold.slot)), svc.FormatMoney(paid))
+		return insufficientFundsProblem.write(ctx, http.StatusPaymentRequired, mess)
+	case errors.Is(err, svc.ErrCurrencyMismatch):
+		return currencyMismatchProblem.write(ctx, http.StatusBadRequest, err.Error())
+	case errors.Is(err, svc.ErrSoldOut):
+		return soldOutProblem.write(ctx, http.StatusConflict, fmt.Sprintf("slot '%v' is sold out", slotId))
+	case errors.Is(err, svc.ErrExactChangeOnly):
+		return exactChangeOnlyProblem.write(ctx, http.StatusUnprocessableEntity, err.Error())
+	case err != nil:
+		return storageError(ctx, err, fmt.Sprintf("slot '%v' not found", slotId))
+	}
+	purchased := slotV2(sold.slot)
+	resp := v2.PurchaseResponse{
+		Change: v2.Money(sold.change),
+		Paid:   v2.Money(sold.paid),
+		Price:  purchased.Price,
+		SlotId: purchased.Id,
+		Soda:   purchased.Soda,
+	}
+	if sold.coins != nil {
+		coins := denominationsV2(*sold.coins)
+		resp.ChangeDenominations = &coins
+	}
+	setETag(ctx, sold.slot)
+	return ctx.JSON(http.StatusCreated, resp)
+}
+
+// ListSodas lists the soda catalog ordered by ID.
+func (v apiV2) ListSodas(ctx echo.Context) error {
+	sodas, err := v.Store.GetSodas(ctx.Request().Context())
+	if err != nil {
+		return problem(ctx, storageErrorStatus(err), err.Error())
+	}
+	resp := v2.SodasResponse{Sodas: make([]v2.Soda, len(sodas))}
+	for i, soda := range sodas {
+		resp.Sodas[i] = v2.Soda(soda)
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// GetSoda returns the soda of the catalog with the ID.
+func (v apiV2) GetSoda(ctx echo.Context, sodaId string) error {
+	soda, err := v.Store.GetSoda(ctx.Request().Context(), sodaId)
+	if err != nil {
+		return storageError(ctx, err, fmt.Sprintf("soda '%v' not found", sodaId))
+	}
+	return ctx.JSON(http.StatusOK, v2.Soda(soda))
+}
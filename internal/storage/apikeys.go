@@ -0,0 +1,154 @@
+package storage
+
+import (
+	"colaco-api/svc"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+)
+
+// APIKeyDirectory is a svc.APIKeyStore that keeps the API keys in memory.
+// When it is opened with NewFileAPIKeyStore every change is also written to a
+// JSON file, which replaces the previous one atomically, so the keys survive
+// restarts.
+type APIKeyDirectory struct {
+	keys map[string]svc.APIKeyRecord
+	path string
+	m    sync.RWMutex
+}
+
+var _ svc.APIKeyStore = (*APIKeyDirectory)(nil)
+
+// NewMemoryAPIKeyStore returns an empty store that only lives as long as the
+// process.
+func NewMemoryAPIKeyStore() *APIKeyDirectory {
+	return &APIKeyDirectory{keys: make(map[string]svc.APIKeyRecord)}
+}
+
+// NewFileAPIKeyStore opens the API keys stored in the JSON file at path, or
+// an empty store if the file does not exist yet.
+func NewFileAPIKeyStore(path string) (*APIKeyDirectory, error) {
+	d := &APIKeyDirectory{keys: make(map[string]svc.APIKeyRecord), path: path}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return d, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading API keys: %w", err)
+	}
+	var keys []svc.APIKeyRecord
+	if err := json.Unmarshal(b, &keys); err != nil {
+		return nil, fmt.Errorf("decoding API keys in %s: %w", path, err)
+	}
+	for _, k := range keys {
+		d.keys[k.Id] = k
+	}
+	return d, nil
+}
+
+// save writes keys to the file of the store, if it has one, before they
+// replace the keys in memory.
+func (d *APIKeyDirectory) save(keys map[string]svc.APIKeyRecord) error {
+	if d.path != "" {
+		b, err := json.MarshalIndent(sortedAPIKeys(keys), "", "  ")
+		if err != nil {
+			return fmt.Errorf("encoding API keys: %w", err)
+		}
+		// The hashes are not secret, but what the keys may do is nobody
+		// else's business either.
+		if err := replaceFile(d.path, b); err != nil {
+			return fmt.Errorf("%w: installing API keys: %w", svc.ErrUnavailable, err)
+		}
+	}
+	d.keys = keys
+	return nil
+}
+
+func sortedAPIKeys(keys map[string]svc.APIKeyRecord) []svc.APIKeyRecord {
+	sorted := make([]svc.APIKeyRecord, 0, len(keys))
+	for _, k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if !sorted[i].CreatedAt.Equal(sorted[j].CreatedAt) {
+			return sorted[i].CreatedAt.Before(sorted[j].CreatedAt)
+		}
+		return sorted[i].Id < sorted[j].Id
+	})
+	return sorted
+}
+
+// GetAPIKey implements svc.APIKeyStore.
+func (d *APIKeyDirectory) GetAPIKey(ctx context.Context, id string) (svc.APIKeyRecord, error) {
+	if err := checkDirectoryContext(ctx); err != nil {
+		return svc.APIKeyRecord{}, err
+	}
+	d.m.RLock()
+	defer d.m.RUnlock()
+	k, ok := d.keys[id]
+	if !ok {
+		return svc.APIKeyRecord{}, fmt.Errorf("%w: %q", svc.ErrAPIKeyNotFound, id)
+	}
+	return k, nil
+}
+
+// GetAPIKeys implements svc.APIKeyStore.
+func (d *APIKeyDirectory) GetAPIKeys(ctx context.Context) ([]svc.APIKeyRecord, error) {
+	if err := checkDirectoryContext(ctx); err != nil {
+		return nil, err
+	}
+	d.m.RLock()
+	defer d.m.RUnlock()
+	return sortedAPIKeys(d.keys), nil
+}
+
+// CreateAPIKey implements svc.APIKeyStore.
+func (d *APIKeyDirectory) CreateAPIKey(ctx context.Context, key svc.APIKeyRecord) error {
+	if err := checkDirectoryContext(ctx); err != nil {
+		return err
+	}
+	d.m.Lock()
+	defer d.m.Unlock()
+	if _, ok := d.keys[key.Id]; ok {
+		return fmt.Errorf("%w: %q", svc.ErrAPIKeyExists, key.Id)
+	}
+	keys := cloneAPIKeys(d.keys)
+	keys[key.Id] = key
+	return d.save(keys)
+}
+
+// UpdateAPIKey implements svc.APIKeyStore.
+func (d *APIKeyDirectory) UpdateAPIKey(ctx context.Context, id string, fn func(key *svc.APIKeyRecord) error) (svc.APIKeyRecord, error) {
+	if err := checkDirectoryContext(ctx); err != nil {
+		return svc.APIKeyRecord{}, err
+	}
+	d.m.Lock()
+	defer d.m.Unlock()
+	k, ok := d.keys[id]
+	if !ok {
+		return svc.APIKeyRecord{}, fmt.Errorf("%w: %q", svc.ErrAPIKeyNotFound, id)
+	}
+	hash := k.KeyHash
+	if err := fn(&k); err != nil {
+		return svc.APIKeyRecord{}, err
+	}
+	k.Id, k.KeyHash = id, hash
+	keys := cloneAPIKeys(d.keys)
+	keys[id] = k
+	if err := d.save(keys); err != nil {
+		return svc.APIKeyRecord{}, err
+	}
+	return k, nil
+}
+
+func cloneAPIKeys(keys map[string]svc.APIKeyRecord) map[string]svc.APIKeyRecord {
+	clone := make(map[string]svc.APIKeyRecord, len(keys))
+	for id, k := range keys {
+		clone[id] = k
+	}
+	return clone
+}
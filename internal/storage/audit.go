@@ -0,0 +1,104 @@
+package storage
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"colaco-api/svc"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// AuditTrail is a svc.AuditStore that keeps the audit trail in memory. When
+// it is opened with NewFileAuditStore every record is also appended to a log
+// of one JSON record per line, and synced before it is acknowledged, so the
+// trail survives restarts. The log is only ever appended to.
+type AuditTrail struct {
+	records []v1.AuditRecord
+	file    *os.File
+	m       sync.RWMutex
+}
+
+var _ svc.AuditStore = (*AuditTrail)(nil)
+
+// NewMemoryAuditStore returns an empty trail that only lives as long as the
+// process.
+func NewMemoryAuditStore() *AuditTrail {
+	return &AuditTrail{}
+}
+
+// NewFileAuditStore opens the audit trail logged in the file at path, which
+// is created if it does not exist yet. The records are read as they are; it
+// is up to svc.VerifyAuditTrail to tell whether they were tampered with.
+func NewFileAuditStore(path string) (*AuditTrail, error) {
+	a := &AuditTrail{}
+	err := readLog(path, "audit trail", func(line []byte) error {
+		var rec v1.AuditRecord
+		if err := json.Unmarshal(line, &rec); err != nil {
+			return err
+		}
+		a.records = append(a.records, rec)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Who changed what is nobody else's business.
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening audit trail: %w", err)
+	}
+	a.file = file
+	return a, nil
+}
+
+// Close closes the log of the trail, if it has one.
+func (a *AuditTrail) Close() error {
+	if a.file == nil {
+		return nil
+	}
+	return a.file.Close()
+}
+
+// AppendAuditRecord implements svc.AuditStore.
+func (a *AuditTrail) AppendAuditRecord(ctx context.Context, rec v1.AuditRecord) (v1.AuditRecord, error) {
+	if err := checkDirectoryContext(ctx); err != nil {
+		return v1.AuditRecord{}, err
+	}
+	a.m.Lock()
+	defer a.m.Unlock()
+	var prev *v1.AuditRecord
+	if len(a.records) > 0 {
+		prev = &a.records[len(a.records)-1]
+	}
+	rec = svc.ChainAuditRecord(prev, rec)
+	if a.file != nil {
+		b, err := json.Marshal(rec)
+		if err != nil {
+			return v1.AuditRecord{}, fmt.Errorf("encoding audit record: %w", err)
+		}
+		if _, err := a.file.Write(append(b, '\n')); err != nil {
+			return v1.AuditRecord{}, fmt.Errorf("%w: appending to audit trail: %w", svc.ErrUnavailable, err)
+		}
+		if err := a.file.Sync(); err != nil {
+			return v1.AuditRecord{}, fmt.Errorf("%w: syncing audit trail: %w", svc.ErrUnavailable, err)
+		}
+	}
+	a.records = append(a.records, rec)
+	return rec, nil
+}
+
+// GetAuditRecords implements svc.AuditStore.
+func (a *AuditTrail) GetAuditRecords(ctx context.Context, offset int64, limit int) ([]v1.AuditRecord, error) {
+	if err := checkDirectoryContext(ctx); err != nil {
+		return nil, err
+	}
+	a.m.RLock()
+	defer a.m.RUnlock()
+	records := a.records[min(offset, int64(len(a.records))):]
+	if limit > 0 && len(records) > limit {
+		records = records[:limit]
+	}
+	return append([]v1.AuditRecord{}, records...), nil
+}
@@ -0,0 +1,63 @@
+package storage
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"colaco-api/svc"
+	"sort"
+)
+
+// sodaCatalog holds the sodas of MemoryStorage and FileStorage keyed by
+// svc.SodaID. Slots keep a copy of their soda, which is only used when the
+// catalog does not know it, for example in data written before there was a
+// catalog.
+type sodaCatalog map[string]v1.Soda
+
+// store records the soda of slot in the catalog, replacing the previous
+// definition, and stamps the soda's ID on slot.
+func (c sodaCatalog) store(slot *v1.VendingSlot) {
+	if slot.OccupiedSoda == nil {
+		return
+	}
+	soda := svc.WithSodaID(*slot.OccupiedSoda)
+	if soda.Id == nil {
+		return
+	}
+	slot.OccupiedSoda = &soda
+	c[*soda.Id] = cloneSoda(soda)
+}
+
+// resolve returns a copy of slot holding the catalog's current definition of
+// its soda.
+func (c sodaCatalog) resolve(slot v1.VendingSlot) v1.VendingSlot {
+	slot = cloneSlot(slot)
+	if slot.OccupiedSoda != nil {
+		if soda, ok := c[svc.SodaID(*slot.OccupiedSoda)]; ok {
+			soda = cloneSoda(soda)
+			slot.OccupiedSoda = &soda
+		}
+	}
+	return slot
+}
+
+func (c sodaCatalog) sorted() []v1.Soda {
+	ids := make([]string, 0, len(c))
+	for id := range c {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	sodas := make([]v1.Soda, 0, len(ids))
+	for _, id := range ids {
+		sodas = append(sodas, cloneSoda(c[id]))
+	}
+	return sodas
+}
+
+func cloneSoda(soda v1.Soda) v1.Soda {
+	soda.Calories = clonePtr(soda.Calories)
+	soda.Description = clonePtr(soda.Description)
+	soda.Id = clonePtr(soda.Id)
+	soda.Name = clonePtr(soda.Name)
+	soda.OriginStory = clonePtr(soda.OriginStory)
+	soda.Ounces = clonePtr(soda.Ounces)
+	return soda
+}
@@ -0,0 +1,788 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	v1 "colaco-api/internal/api/v1"
+	"colaco-api/svc"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	walFileName      = "wal.log"
+	snapshotFileName = "snapshot.json"
+	ledgerFileName   = "ledger.jsonl"
+	closesFileName   = "closes.jsonl"
+
+	// DefaultCompactEvery is the number of log records that are allowed to
+	// accumulate before the log is folded into a new snapshot.
+	DefaultCompactEvery = 1000
+)
+
+// Operations recorded in the write-ahead log. Every operation stores the
+// resulting state rather than a delta so replaying a record more than once
+// is harmless.
+const (
+	opAdd            = "add"
+	opUpsert         = "upsert"
+	opDelete         = "delete"
+	opUpdatePrice    = "update_price"
+	opUpdateQuantity = "update_quantity"
+	opCashBox        = "cash_box"
+	opReplace        = "replace"
+)
+
+type walRecord struct {
+	Op   string          `json:"op"`
+	Name string          `json:"name"`
+	Slot *v1.VendingSlot `json:"slot,omitempty"`
+	// Price is the float price logged before prices were exact; replaying
+	// it keeps the slot's currency. New records log Money instead.
+	Price    *float32    `json:"price,omitempty"`
+	Money    *v1.Money   `json:"money,omitempty"`
+	Quantity *int        `json:"quantity,omitempty"`
+	Version  *int64      `json:"version,omitempty"`
+	CashBox  *v1.CashBox `json:"cashBox,omitempty"`
+	// Slots are every slot after a replace, keyed by lower-cased name.
+	Slots map[string]v1.VendingSlot `json:"slots,omitempty"`
+}
+
+type snapshot struct {
+	Slots map[string]v1.VendingSlot `json:"slots"`
+	Sodas sodaCatalog               `json:"sodas,omitempty"`
+	Cash  *v1.CashBox               `json:"cashBox,omitempty"`
+	// LastVersion is the highest slot version handed out, including those
+	// of slots deleted since, see svc.SlotVersions.
+	LastVersion int64 `json:"lastVersion,omitempty"`
+}
+
+// FileStorage is a durable svc.VendingStore. Like MemoryStorage it maintains
+// slot versions, a soda catalog and a cash box, and slots are served from
+// memory, but every mutation is first appended to a write-ahead log in dir
+// and fsync'd before it is applied. A mutation that cannot be logged is
+// reported as svc.ErrUnavailable and leaves the state untouched. Once the log
+// grows past compactEvery records it is compacted into a snapshot. On boot
+// the snapshot is loaded and the log replayed on top of it.
+//
+// The ledger and the closed periods are each kept in their own append-only
+// file next to the log, one JSON document per line, which is never
+// compacted.
+//
+// Wrap it with svc.NewLegacyStorage where a svc.VendingStorageInterface is
+// expected.
+type FileStorage struct {
+	StorageMap   map[string]v1.VendingSlot
+	sodas        sodaCatalog
+	cash         v1.CashBox
+	versions     svc.SlotVersions
+	m            sync.RWMutex
+	dir          string
+	wal          *os.File
+	walRecords   int
+	ledger       *os.File
+	transactions []v1.Transaction
+	closesFile   *os.File
+	closes       []v1.DayClose
+	compactEvery int
+}
+
+var _ svc.VendingStore = (*FileStorage)(nil)
+
+// WithCompactEvery overrides DefaultCompactEvery.
+func WithCompactEvery(n int) func(*FileStorage) {
+	return func(f *FileStorage) {
+		f.compactEvery = n
+	}
+}
+
+// NewFileStorage opens (or creates) a FileStorage rooted at dir, restoring
+// the state left behind by a previous process.
+func NewFileStorage(dir string, options ...func(*FileStorage)) (*FileStorage, error) {
+	f := &FileStorage{
+		StorageMap:   make(map[string]v1.VendingSlot),
+		sodas:        make(sodaCatalog),
+		cash:         svc.NewCashBox(),
+		dir:          dir,
+		compactEvery: DefaultCompactEvery,
+	}
+	for _, option := range options {
+		option(f)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating data directory: %w", err)
+	}
+	if err := f.loadSnapshot(); err != nil {
+		return nil, err
+	}
+	if err := f.replay(); err != nil {
+		return nil, err
+	}
+	wal, err := os.OpenFile(f.walPath(), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("opening write-ahead log: %w", err)
+	}
+	f.wal = wal
+	if err := f.loadLedger(); err != nil {
+		wal.Close()
+		return nil, err
+	}
+	if err := f.loadCloses(); err != nil {
+		wal.Close()
+		f.ledger.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *FileStorage) walPath() string {
+	return filepath.Join(f.dir, walFileName)
+}
+
+func (f *FileStorage) ledgerPath() string {
+	return filepath.Join(f.dir, ledgerFileName)
+}
+
+func (f *FileStorage) closesPath() string {
+	return filepath.Join(f.dir, closesFileName)
+}
+
+func (f *FileStorage) snapshotPath() string {
+	return filepath.Join(f.dir, snapshotFileName)
+}
+
+func (f *FileStorage) loadSnapshot() error {
+	b, err := os.ReadFile(f.snapshotPath())
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("reading snapshot: %w", err)
+	}
+	var s snapshot
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("decoding snapshot: %w", err)
+	}
+	if s.Slots != nil {
+		f.StorageMap = s.Slots
+	}
+	if s.Sodas != nil {
+		f.sodas = s.Sodas
+	}
+	if s.Cash != nil {
+		f.cash = *s.Cash
+	}
+	f.versions.Observe(s.LastVersion)
+	for _, slot := range f.StorageMap {
+		f.versions.Observe(svc.SlotVersion(slot))
+	}
+	return nil
+}
+
+// replay applies every record of the write-ahead log to StorageMap.
+func (f *FileStorage) replay() error {
+	return readLog(f.walPath(), "write-ahead log", func(line []byte) error {
+		var rec walRecord
+		if err := json.Unmarshal(line, &rec); err != nil {
+			return err
+		}
+		f.apply(rec)
+		f.walRecords++
+		return nil
+	})
+}
+
+// loadLedger reads the transactions recorded in the ledger and opens it for
+// appending.
+func (f *FileStorage) loadLedger() error {
+	err := readLog(f.ledgerPath(), "ledger", func(line []byte) error {
+		var tx v1.Transaction
+		if err := json.Unmarshal(line, &tx); err != nil {
+			return err
+		}
+		f.transactions = append(f.transactions, tx)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	ledger, err := os.OpenFile(f.ledgerPath(), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("opening ledger: %w", err)
+	}
+	f.ledger = ledger
+	return nil
+}
+
+// loadCloses reads the reports of the closed periods and opens their file
+// for appending.
+func (f *FileStorage) loadCloses() error {
+	err := readLog(f.closesPath(), "closed periods", func(line []byte) error {
+		var report v1.DayClose
+		if err := json.Unmarshal(line, &report); err != nil {
+			return err
+		}
+		f.closes = append(f.closes, report)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	closes, err := os.OpenFile(f.closesPath(), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("opening closed periods: %w", err)
+	}
+	f.closesFile = closes
+	return nil
+}
+
+// readLog passes every record of the log at path, one JSON document per
+// line, to decode. A final record without a trailing newline is the result
+// of a crash in the middle of an append; it was never acknowledged so it is
+// truncated away. Any other undecodable record means the log is corrupt and
+// is reported.
+func readLog(path, name string, decode func(line []byte) error) error {
+	file, err := os.OpenFile(path, os.O_RDWR, 0)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", name, err)
+	}
+	defer file.Close()
+
+	r := bufio.NewReader(file)
+	var offset int64
+	for {
+		line, err := r.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			if len(line) > 0 {
+				log.Printf("discarding torn %s record at offset %d", name, offset)
+				if err := file.Truncate(offset); err != nil {
+					return fmt.Errorf("truncating torn %s: %w", name, err)
+				}
+			}
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", name, err)
+		}
+		if err := decode(bytes.TrimSpace(line)); err != nil {
+			return fmt.Errorf("decoding %s record at offset %d: %w", name, offset, err)
+		}
+		offset += int64(len(line))
+	}
+}
+
+// apply mutates StorageMap and the catalog according to rec. The caller must
+// hold the write lock or otherwise have exclusive access.
+func (f *FileStorage) apply(rec walRecord) {
+	key := strings.ToLower(rec.Name)
+	if rec.Version != nil {
+		f.versions.Observe(*rec.Version)
+	}
+	switch rec.Op {
+	case opAdd, opUpsert:
+		if rec.Slot != nil {
+			slot := cloneSlot(*rec.Slot)
+			f.versions.Observe(svc.SlotVersion(slot))
+			f.sodas.store(&slot)
+			f.StorageMap[key] = slot
+		}
+	case opDelete:
+		delete(f.StorageMap, key)
+	case opUpdatePrice:
+		if slot, ok := f.StorageMap[key]; ok && (rec.Money != nil || rec.Price != nil) {
+			if rec.Money != nil {
+				svc.SetPrice(&slot, *rec.Money)
+			} else {
+				svc.SetPrice(&slot, svc.NewMoney(*rec.Price, svc.PriceCurrency(slot)))
+			}
+			slot.Version = clonePtr(rec.Version)
+			f.StorageMap[key] = slot
+		}
+	case opUpdateQuantity:
+		if slot, ok := f.StorageMap[key]; ok && rec.Quantity != nil {
+			qty := *rec.Quantity
+			slot.Quantity = &qty
+			slot.Version = clonePtr(rec.Version)
+			f.StorageMap[key] = slot
+		}
+	case opCashBox:
+		if rec.CashBox != nil {
+			f.cash = cloneCashBox(*rec.CashBox)
+		}
+	case opReplace:
+		f.StorageMap = make(map[string]v1.VendingSlot, len(rec.Slots))
+		for key, slot := range rec.Slots {
+			slot = cloneSlot(slot)
+			f.versions.Observe(svc.SlotVersion(slot))
+			f.sodas.store(&slot)
+			f.StorageMap[key] = slot
+		}
+	}
+}
+
+// commit durably appends rec to the write-ahead log and then applies it. The
+// caller must hold the write lock.
+func (f *FileStorage) commit(rec walRecord) error {
+	b, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("encoding write-ahead log record: %w", err)
+	}
+	b = append(b, '\n')
+	if _, err := f.wal.Write(b); err != nil {
+		return fmt.Errorf("appending to write-ahead log: %w", err)
+	}
+	if err := f.wal.Sync(); err != nil {
+		return fmt.Errorf("syncing write-ahead log: %w", err)
+	}
+	f.apply(rec)
+	f.walRecords++
+	if f.compactEvery > 0 && f.walRecords >= f.compactEvery {
+		if err := f.compact(); err != nil {
+			// The record is already durable in the log so the mutation
+			// succeeded; we will simply try to compact again next time.
+			log.Printf("compacting file storage: %v", err)
+		}
+	}
+	return nil
+}
+
+// Compact writes the current state to a new snapshot and truncates the
+// write-ahead log.
+func (f *FileStorage) Compact() error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	return f.compact()
+}
+
+// compact replaces the snapshot atomically via rename and only then truncates
+// the log. A crash in between leaves a snapshot plus a log whose records are
+// already contained in it, which replays to the same state.
+func (f *FileStorage) compact() error {
+	b, err := json.Marshal(snapshot{Slots: f.StorageMap, Sodas: f.sodas, Cash: &f.cash, LastVersion: f.versions.Last()})
+	if err != nil {
+		return fmt.Errorf("encoding snapshot: %w", err)
+	}
+	tmp := f.snapshotPath() + ".tmp"
+	if err := writeFileSync(tmp, b, 0o644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, f.snapshotPath()); err != nil {
+		return fmt.Errorf("installing snapshot: %w", err)
+	}
+	if err := syncDir(f.dir); err != nil {
+		return err
+	}
+	if err := f.wal.Truncate(0); err != nil {
+		return fmt.Errorf("truncating write-ahead log: %w", err)
+	}
+	if err := f.wal.Sync(); err != nil {
+		return fmt.Errorf("syncing write-ahead log: %w", err)
+	}
+	f.walRecords = 0
+	return nil
+}
+
+// Close releases the write-ahead log. Every acknowledged mutation is already
+// on disk so Close does not need to flush anything.
+func (f *FileStorage) Close() error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	return errors.Join(f.wal.Close(), f.ledger.Close(), f.closesFile.Close())
+}
+
+func writeFileSync(name string, b []byte, perm os.FileMode) error {
+	file, err := os.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return fmt.Errorf("creating %s: %w", name, err)
+	}
+	if _, err := file.Write(b); err != nil {
+		file.Close()
+		return fmt.Errorf("writing %s: %w", name, err)
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return fmt.Errorf("syncing %s: %w", name, err)
+	}
+	return file.Close()
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return fmt.Errorf("opening data directory: %w", err)
+	}
+	defer d.Close()
+	if err := d.Sync(); err != nil {
+		return fmt.Errorf("syncing data directory: %w", err)
+	}
+	return nil
+}
+
+// stamp sets the version slot gets when written under key. The caller must
+// hold the write lock.
+func (f *FileStorage) stamp(key string, slot *v1.VendingSlot) {
+	if prev, ok := f.StorageMap[key]; ok {
+		f.versions.Next(&prev, slot)
+	} else {
+		f.versions.Next(nil, slot)
+	}
+}
+
+// nextVersion returns the version following the one stored under key. The
+// caller must hold the write lock.
+func (f *FileStorage) nextVersion(key string) *int64 {
+	var slot v1.VendingSlot
+	f.stamp(key, &slot)
+	return slot.Version
+}
+
+// get returns a copy of the slot stored under key holding the catalog's
+// definition of its soda, or svc.ErrNotFound. The caller must hold the lock.
+func (f *FileStorage) get(key string) (v1.VendingSlot, error) {
+	slot, ok := f.StorageMap[key]
+	if !ok {
+		return v1.VendingSlot{}, fmt.Errorf("%w: %q", svc.ErrNotFound, key)
+	}
+	return svc.WithPrice(f.sodas.resolve(slot)), nil
+}
+
+// slots returns every slot ordered by key. The caller must hold the lock.
+func (f *FileStorage) slots() []v1.VendingSlot {
+	slots := sortedSlots(f.StorageMap, f.sodas)
+	for i := range slots {
+		slots[i] = svc.WithPrice(slots[i])
+	}
+	return slots
+}
+
+// put durably writes slot under name with the version following the one
+// stored. The caller must hold the write lock.
+func (f *FileStorage) put(op, name string, slot v1.VendingSlot) error {
+	svc.PrepareSlot(name, &slot)
+	f.stamp(strings.ToLower(name), &slot)
+	if err := f.commit(walRecord{Op: op, Name: name, Slot: &slot}); err != nil {
+		return fmt.Errorf("%w: %w", svc.ErrUnavailable, err)
+	}
+	return nil
+}
+
+func (f *FileStorage) GetSlot(ctx context.Context, name string) (v1.VendingSlot, error) {
+	if err := svc.CheckContext(ctx); err != nil {
+		return v1.VendingSlot{}, err
+	}
+	f.m.RLock()
+	defer f.m.RUnlock()
+	return f.get(strings.ToLower(name))
+}
+
+func (f *FileStorage) GetSlots(ctx context.Context) ([]v1.VendingSlot, error) {
+	if err := svc.CheckContext(ctx); err != nil {
+		return nil, err
+	}
+	f.m.RLock()
+	defer f.m.RUnlock()
+	return f.slots(), nil
+}
+
+func (f *FileStorage) QuerySlots(ctx context.Context, q svc.SlotQuery) ([]v1.VendingSlot, error) {
+	slots, err := f.GetSlots(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return svc.QuerySlots(slots, q), nil
+}
+
+func (f *FileStorage) AddSlot(ctx context.Context, name string, slot v1.VendingSlot) error {
+	if err := svc.CheckContext(ctx); err != nil {
+		return err
+	}
+	f.m.Lock()
+	defer f.m.Unlock()
+	if _, ok := f.StorageMap[strings.ToLower(name)]; ok {
+		return fmt.Errorf("%w: %q already exists", svc.ErrConflict, name)
+	}
+	return f.put(opAdd, name, slot)
+}
+
+func (f *FileStorage) UpsertSlot(ctx context.Context, name string, slot v1.VendingSlot) error {
+	if err := svc.CheckContext(ctx); err != nil {
+		return err
+	}
+	f.m.Lock()
+	defer f.m.Unlock()
+	return f.put(opUpsert, name, slot)
+}
+
+func (f *FileStorage) DeleteSlot(ctx context.Context, name string) error {
+	_, err := f.DeleteSlotIf(ctx, name, func(v1.VendingSlot) error { return nil })
+	return err
+}
+
+func (f *FileStorage) UpdatePrice(ctx context.Context, name string, price v1.Money) error {
+	if err := svc.CheckContext(ctx); err != nil {
+		return err
+	}
+	f.m.Lock()
+	defer f.m.Unlock()
+	key := strings.ToLower(name)
+	if _, err := f.get(key); err != nil {
+		return err
+	}
+	rec := walRecord{Op: opUpdatePrice, Name: name, Money: &price, Version: f.nextVersion(key)}
+	if err := f.commit(rec); err != nil {
+		return fmt.Errorf("%w: %w", svc.ErrUnavailable, err)
+	}
+	return nil
+}
+
+func (f *FileStorage) UpdateQuantity(ctx context.Context, name string, qty int) error {
+	if err := svc.CheckContext(ctx); err != nil {
+		return err
+	}
+	f.m.Lock()
+	defer f.m.Unlock()
+	key := strings.ToLower(name)
+	if _, err := f.get(key); err != nil {
+		return err
+	}
+	rec := walRecord{Op: opUpdateQuantity, Name: name, Quantity: &qty, Version: f.nextVersion(key)}
+	if err := f.commit(rec); err != nil {
+		return fmt.Errorf("%w: %w", svc.ErrUnavailable, err)
+	}
+	return nil
+}
+
+// DecrementIfAvailable logs the decrement as an absolute quantity so
+// replaying it stays idempotent.
+func (f *FileStorage) DecrementIfAvailable(ctx context.Context, name string, payment v1.Money) (v1.VendingSlot, error) {
+	if err := svc.CheckContext(ctx); err != nil {
+		return v1.VendingSlot{}, err
+	}
+	f.m.Lock()
+	defer f.m.Unlock()
+	key := strings.ToLower(name)
+	slot, err := f.get(key)
+	if err != nil {
+		return v1.VendingSlot{}, err
+	}
+	if err := svc.CheckPurchase(slot, payment); err != nil {
+		return slot, err
+	}
+	qty := *slot.Quantity - 1
+	rec := walRecord{Op: opUpdateQuantity, Name: name, Quantity: &qty, Version: f.nextVersion(key)}
+	if err := f.commit(rec); err != nil {
+		return v1.VendingSlot{}, fmt.Errorf("%w: %w", svc.ErrUnavailable, err)
+	}
+	return f.get(key)
+}
+
+// UpdateSlot logs the result as an upsert of the whole slot.
+func (f *FileStorage) UpdateSlot(ctx context.Context, name string, fn func(slot *v1.VendingSlot) error) (v1.VendingSlot, error) {
+	if err := svc.CheckContext(ctx); err != nil {
+		return v1.VendingSlot{}, err
+	}
+	f.m.Lock()
+	defer f.m.Unlock()
+	key := strings.ToLower(name)
+	slot, err := f.get(key)
+	if err != nil {
+		return v1.VendingSlot{}, err
+	}
+	id := svc.SlotID(slot)
+	if err := svc.PricedUpdate(fn)(&slot); err != nil {
+		return v1.VendingSlot{}, err
+	}
+	if err := f.put(opUpsert, id, slot); err != nil {
+		return v1.VendingSlot{}, err
+	}
+	return f.get(key)
+}
+
+func (f *FileStorage) DeleteSlotIf(ctx context.Context, name string, check func(slot v1.VendingSlot) error) (v1.VendingSlot, error) {
+	if err := svc.CheckContext(ctx); err != nil {
+		return v1.VendingSlot{}, err
+	}
+	f.m.Lock()
+	defer f.m.Unlock()
+	slot, err := f.get(strings.ToLower(name))
+	if err != nil {
+		return v1.VendingSlot{}, err
+	}
+	if err := check(slot); err != nil {
+		return v1.VendingSlot{}, err
+	}
+	if err := f.commit(walRecord{Op: opDelete, Name: name}); err != nil {
+		return v1.VendingSlot{}, fmt.Errorf("%w: %w", svc.ErrUnavailable, err)
+	}
+	return slot, nil
+}
+
+// ReplaceSlots logs the slots as a single record, so a crash leaves either
+// all of them or none.
+func (f *FileStorage) ReplaceSlots(ctx context.Context, fn func(slots []v1.VendingSlot) ([]v1.VendingSlot, error)) ([]v1.VendingSlot, error) {
+	if err := svc.CheckContext(ctx); err != nil {
+		return nil, err
+	}
+	f.m.Lock()
+	defer f.m.Unlock()
+	next, err := fn(f.slots())
+	if err != nil {
+		return nil, err
+	}
+	rec := walRecord{Op: opReplace, Slots: make(map[string]v1.VendingSlot, len(next))}
+	for _, slot := range next {
+		key := strings.ToLower(svc.SlotID(slot))
+		svc.PrepareSlot(svc.SlotID(slot), &slot)
+		f.stamp(key, &slot)
+		rec.Slots[key] = slot
+	}
+	if err := f.commit(rec); err != nil {
+		return nil, fmt.Errorf("%w: %w", svc.ErrUnavailable, err)
+	}
+	return f.slots(), nil
+}
+
+func (f *FileStorage) GetSoda(ctx context.Context, id string) (v1.Soda, error) {
+	if err := svc.CheckContext(ctx); err != nil {
+		return v1.Soda{}, err
+	}
+	f.m.RLock()
+	defer f.m.RUnlock()
+	soda, ok := f.sodas[strings.ToLower(id)]
+	if !ok {
+		return v1.Soda{}, fmt.Errorf("%w: soda %q", svc.ErrNotFound, id)
+	}
+	return cloneSoda(soda), nil
+}
+
+func (f *FileStorage) GetSodas(ctx context.Context) ([]v1.Soda, error) {
+	if err := svc.CheckContext(ctx); err != nil {
+		return nil, err
+	}
+	f.m.RLock()
+	defer f.m.RUnlock()
+	return f.sodas.sorted(), nil
+}
+
+func (f *FileStorage) GetCashBox(ctx context.Context) (v1.CashBox, error) {
+	if err := svc.CheckContext(ctx); err != nil {
+		return v1.CashBox{}, err
+	}
+	f.m.RLock()
+	defer f.m.RUnlock()
+	return svc.NormalizeCashBox(cloneCashBox(f.cash)), nil
+}
+
+// UpdateCashBox logs the whole resulting cash box.
+func (f *FileStorage) UpdateCashBox(ctx context.Context, fn func(box *v1.CashBox) error) (v1.CashBox, error) {
+	if err := svc.CheckContext(ctx); err != nil {
+		return v1.CashBox{}, err
+	}
+	f.m.Lock()
+	defer f.m.Unlock()
+	box := svc.NormalizeCashBox(cloneCashBox(f.cash))
+	if err := fn(&box); err != nil {
+		return v1.CashBox{}, err
+	}
+	box = svc.NormalizeCashBox(box)
+	if err := f.commit(walRecord{Op: opCashBox, CashBox: &box}); err != nil {
+		return v1.CashBox{}, fmt.Errorf("%w: %w", svc.ErrUnavailable, err)
+	}
+	return box, nil
+}
+
+// AppendTransaction syncs the transaction to the ledger before it is
+// acknowledged.
+func (f *FileStorage) AppendTransaction(ctx context.Context, tx v1.Transaction) (v1.Transaction, error) {
+	if err := svc.CheckContext(ctx); err != nil {
+		return v1.Transaction{}, err
+	}
+	if tx.Timestamp.IsZero() {
+		tx.Timestamp = time.Now().UTC()
+	}
+	f.m.Lock()
+	defer f.m.Unlock()
+	id := int64(len(f.transactions)) + 1
+	tx.Id = &id
+	b, err := json.Marshal(tx)
+	if err != nil {
+		return v1.Transaction{}, fmt.Errorf("encoding transaction: %w", err)
+	}
+	if _, err := f.ledger.Write(append(b, '\n')); err != nil {
+		return v1.Transaction{}, fmt.Errorf("%w: appending to ledger: %w", svc.ErrUnavailable, err)
+	}
+	if err := f.ledger.Sync(); err != nil {
+		return v1.Transaction{}, fmt.Errorf("%w: syncing ledger: %w", svc.ErrUnavailable, err)
+	}
+	f.transactions = append(f.transactions, tx)
+	return tx, nil
+}
+
+func (f *FileStorage) GetTransactions(ctx context.Context, filter svc.TransactionFilter) ([]v1.Transaction, error) {
+	if err := svc.CheckContext(ctx); err != nil {
+		return nil, err
+	}
+	f.m.RLock()
+	defer f.m.RUnlock()
+	return svc.FilterTransactions(f.transactions, filter), nil
+}
+
+// AppendDayClose syncs the report to disk before it is acknowledged.
+func (f *FileStorage) AppendDayClose(ctx context.Context, report v1.DayClose) (v1.DayClose, error) {
+	if err := svc.CheckContext(ctx); err != nil {
+		return v1.DayClose{}, err
+	}
+	f.m.Lock()
+	defer f.m.Unlock()
+	if err := svc.CheckDayClose(f.closes, report); err != nil {
+		return v1.DayClose{}, err
+	}
+	id := int64(len(f.closes)) + 1
+	report.Id = &id
+	b, err := json.Marshal(report)
+	if err != nil {
+		return v1.DayClose{}, fmt.Errorf("encoding closed period: %w", err)
+	}
+	if _, err := f.closesFile.Write(append(b, '\n')); err != nil {
+		return v1.DayClose{}, fmt.Errorf("%w: appending closed period: %w", svc.ErrUnavailable, err)
+	}
+	if err := f.closesFile.Sync(); err != nil {
+		return v1.DayClose{}, fmt.Errorf("%w: syncing closed periods: %w", svc.ErrUnavailable, err)
+	}
+	f.closes = append(f.closes, report)
+	return report, nil
+}
+
+func (f *FileStorage) GetDayCloses(ctx context.Context) ([]v1.DayClose, error) {
+	if err := svc.CheckContext(ctx); err != nil {
+		return nil, err
+	}
+	f.m.RLock()
+	defer f.m.RUnlock()
+	return append([]v1.DayClose{}, f.closes...), nil
+}
+
+func (f *FileStorage) GetDayClose(ctx context.Context, id int64) (v1.DayClose, error) {
+	closes, err := f.GetDayCloses(ctx)
+	if err != nil {
+		return v1.DayClose{}, err
+	}
+	for _, report := range closes {
+		if svc.DayCloseID(report) == id {
+			return report, nil
+		}
+	}
+	return v1.DayClose{}, fmt.Errorf("%w: %d", svc.ErrPeriodNotFound, id)
+}
@@ -0,0 +1,455 @@
+package storage
+
+import (
+	"bytes"
+	v1 "colaco-api/internal/api/v1"
+	"colaco-api/internal/storage/storagetest"
+	"colaco-api/svc"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newTestFileStorage(t *testing.T, dir string, options ...func(*FileStorage)) *FileStorage {
+	t.Helper()
+	fs, err := NewFileStorage(dir, options...)
+	require.NoError(t, err)
+	t.Cleanup(func() { fs.Close() })
+	return fs
+}
+
+func TestNewFileStorage(t *testing.T) {
+	fs := newTestFileStorage(t, t.TempDir())
+	assert.NotNil(t, fs)
+	assert.Empty(t, fs.StorageMap)
+}
+
+func TestFileStorageUpsertAndGetSlot(t *testing.T) {
+	fs := newTestFileStorage(t, t.TempDir())
+	ctx := context.Background()
+	slotName := "coke"
+	slot := v1.VendingSlot{Cost: new(float32), Quantity: new(int)}
+	*slot.Cost = 1.25
+	*slot.Quantity = 20
+
+	require.NoError(t, fs.UpsertSlot(ctx, slotName, slot))
+
+	retSlot, err := fs.GetSlot(ctx, slotName)
+	assert.Nil(t, err)
+	version := int64(1)
+	slot.Id = &slotName
+	slot.Version = &version
+	slot.Price = &v1.Money{Amount: 125, Currency: "USD"}
+	assert.Equal(t, slot, retSlot)
+}
+
+func TestFileStorageGetSlots(t *testing.T) {
+	fs := newTestFileStorage(t, t.TempDir())
+	ctx := context.Background()
+	require.NoError(t, fs.UpsertSlot(ctx, "coke", v1.VendingSlot{Cost: new(float32), Quantity: new(int)}))
+	require.NoError(t, fs.UpsertSlot(ctx, "pepsi", v1.VendingSlot{Cost: new(float32), Quantity: new(int)}))
+
+	slots, err := fs.GetSlots(ctx)
+	require.NoError(t, err)
+	assert.Len(t, slots, 2)
+}
+
+func TestFileStorageDeleteSlot(t *testing.T) {
+	fs := newTestFileStorage(t, t.TempDir())
+	ctx := context.Background()
+	slotName := "coke"
+	require.NoError(t, fs.UpsertSlot(ctx, slotName, v1.VendingSlot{OccupiedSoda: &v1.Soda{Name: &slotName}, Quantity: new(int)}))
+
+	assert.Nil(t, fs.DeleteSlot(ctx, slotName))
+
+	_, err := fs.GetSlot(ctx, slotName)
+	assert.ErrorIs(t, err, svc.ErrNotFound)
+
+	_, err = fs.GetSoda(ctx, slotName)
+	assert.NoError(t, err, "catalog sodas should outlive their slots")
+}
+
+func TestFileStorageUpdatePrice(t *testing.T) {
+	fs := newTestFileStorage(t, t.TempDir())
+	ctx := context.Background()
+	slotName := "coke"
+	initialPrice := float32(1.0)
+	require.NoError(t, fs.UpsertSlot(ctx, slotName, v1.VendingSlot{Cost: &initialPrice, Quantity: new(int)}))
+
+	assert.Nil(t, fs.UpdatePrice(ctx, slotName, svc.NewMoney(1.5, "USD")))
+
+	slot, err := fs.GetSlot(ctx, slotName)
+	require.NoError(t, err)
+	assert.Equal(t, float32(1.5), *slot.Cost)
+}
+
+func TestFileStorageFailedWritesAreReported(t *testing.T) {
+	fs := newTestFileStorage(t, t.TempDir())
+	ctx := context.Background()
+	require.NoError(t, fs.AddSlot(ctx, "coke", v1.VendingSlot{Quantity: new(int)}))
+	require.NoError(t, fs.wal.Close())
+
+	assert.ErrorIs(t, fs.UpsertSlot(ctx, "coke", v1.VendingSlot{Quantity: new(int)}), svc.ErrUnavailable)
+	assert.ErrorIs(t, fs.AddSlot(ctx, "pepsi", v1.VendingSlot{Quantity: new(int)}), svc.ErrUnavailable)
+	slots, err := fs.GetSlots(ctx)
+	require.NoError(t, err)
+	assert.Len(t, slots, 1, "a write that is not logged is not applied")
+}
+
+func TestFileStorageReplay(t *testing.T) {
+	dir := t.TempDir()
+	fs, err := NewFileStorage(dir)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	price := float32(1.0)
+	require.NoError(t, fs.AddSlot(ctx, "Coke", v1.VendingSlot{Cost: &price, Quantity: new(int)}))
+	require.NoError(t, fs.AddSlot(ctx, "Pepsi", v1.VendingSlot{Cost: &price, Quantity: new(int)}))
+	require.NoError(t, fs.UpdatePrice(ctx, "coke", svc.NewMoney(2.5, "USD")))
+	require.NoError(t, fs.UpdateQuantity(ctx, "COKE", 7))
+	require.NoError(t, fs.DeleteSlot(ctx, "pepsi"))
+	require.NoError(t, fs.Close())
+
+	reopened := newTestFileStorage(t, dir)
+	slot, err := reopened.GetSlot(ctx, "coke")
+	if assert.NoError(t, err) {
+		assert.Equal(t, float32(2.5), *slot.Cost)
+		assert.Equal(t, 7, *slot.Quantity)
+	}
+	_, err = reopened.GetSlot(ctx, "pepsi")
+	assert.ErrorIs(t, err, svc.ErrNotFound)
+}
+
+func TestFileStorageReplaysFloatPrices(t *testing.T) {
+	dir := t.TempDir()
+	wal := `{"op":"add","name":"coke","slot":{"cost":1,"price":{"amount":100,"currency":"EUR"},"quantity":0}}
+{"op":"update_price","name":"coke","price":2.5,"version":2}
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, walFileName), []byte(wal), 0o644))
+
+	fs := newTestFileStorage(t, dir)
+	slot, err := fs.GetSlot(context.Background(), "coke")
+	require.NoError(t, err)
+	assert.Equal(t, v1.Money{Amount: 250, Currency: "EUR"}, *slot.Price, "a float price logged before exact prices keeps the currency")
+}
+
+func TestFileStorageReplaceSurvivesRestart(t *testing.T) {
+	dir := t.TempDir()
+	fs, err := NewFileStorage(dir)
+	require.NoError(t, err)
+	ctx := context.Background()
+	price := float32(1.0)
+	require.NoError(t, fs.AddSlot(ctx, "Coke", v1.VendingSlot{Cost: &price, Quantity: new(int)}))
+	_, err = fs.ReplaceSlots(ctx, func([]v1.VendingSlot) ([]v1.VendingSlot, error) {
+		id := "A1"
+		return []v1.VendingSlot{{Id: &id, Cost: &price, Quantity: new(int)}}, nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, fs.Close())
+
+	reopened := newTestFileStorage(t, dir)
+	slots, err := reopened.GetSlots(ctx)
+	require.NoError(t, err)
+	require.Len(t, slots, 1, "the replace is replayed as a whole")
+	assert.Equal(t, "A1", *slots[0].Id)
+}
+
+func TestFileStorageCashBoxSurvivesRestart(t *testing.T) {
+	dir := t.TempDir()
+	fs, err := NewFileStorage(dir, WithCompactEvery(2))
+	require.NoError(t, err)
+	ctx := context.Background()
+	for _, value := range []int64{25, 10, 5} {
+		_, err := fs.UpdateCashBox(ctx, func(box *v1.CashBox) error {
+			return svc.AddCash(box, []v1.Denomination{{Value: value, Count: 2}})
+		})
+		require.NoError(t, err)
+	}
+	require.NoError(t, fs.Close())
+
+	reopened := newTestFileStorage(t, dir)
+	box, err := reopened.GetCashBox(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []v1.Denomination{{Value: 25, Count: 2}, {Value: 10, Count: 2}, {Value: 5, Count: 2}}, box.Denominations,
+		"the snapshot and the log both carry the cash box")
+}
+
+func TestFileStorageLedgerSurvivesRestart(t *testing.T) {
+	dir := t.TempDir()
+	fs, err := NewFileStorage(dir, WithCompactEvery(1))
+	require.NoError(t, err)
+	ctx := context.Background()
+	for _, op := range []v1.TransactionOperation{v1.Add, v1.Purchase} {
+		_, err := fs.AppendTransaction(ctx, v1.Transaction{Operation: op, Actor: "admin", SlotId: "A1", Timestamp: time.Now()})
+		require.NoError(t, err)
+	}
+	require.NoError(t, fs.AddSlot(ctx, "A1", v1.VendingSlot{Quantity: new(int)}))
+	require.NoError(t, fs.Close())
+
+	reopened := newTestFileStorage(t, dir)
+	txs, err := reopened.GetTransactions(ctx, svc.TransactionFilter{})
+	require.NoError(t, err)
+	if assert.Len(t, txs, 2, "compacting the log leaves the ledger alone") {
+		assert.Equal(t, v1.Purchase, txs[1].Operation)
+	}
+	tx, err := reopened.AppendTransaction(ctx, v1.Transaction{Operation: v1.Restock, Actor: "admin", SlotId: "A1", Timestamp: time.Now()})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), *tx.Id, "numbering continues after a restart")
+}
+
+func TestFileStorageDayClosesSurviveRestart(t *testing.T) {
+	dir := t.TempDir()
+	fs, err := NewFileStorage(dir)
+	require.NoError(t, err)
+	ctx := context.Background()
+	_, err = fs.AppendDayClose(ctx, v1.DayClose{ClosedBy: "admin", ThroughTransactionId: 7, Variance: -25})
+	require.NoError(t, err)
+	require.NoError(t, fs.Close())
+
+	reopened := newTestFileStorage(t, dir)
+	closes, err := reopened.GetDayCloses(ctx)
+	require.NoError(t, err)
+	if assert.Len(t, closes, 1) {
+		assert.Equal(t, int64(-25), closes[0].Variance)
+	}
+	_, err = reopened.AppendDayClose(ctx, v1.DayClose{ThroughTransactionId: 9})
+	assert.ErrorIs(t, err, svc.ErrPeriodClosed, "the reopened storage knows where the last period ended")
+	report, err := reopened.AppendDayClose(ctx, v1.DayClose{FromTransactionId: 7, ThroughTransactionId: 9})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), *report.Id)
+}
+
+func TestFileStorageCompaction(t *testing.T) {
+	dir := t.TempDir()
+	fs, err := NewFileStorage(dir, WithCompactEvery(3))
+	require.NoError(t, err)
+	ctx := context.Background()
+	for _, name := range []string{"coke", "pepsi", "fizz", "pop"} {
+		require.NoError(t, fs.AddSlot(ctx, name, v1.VendingSlot{Quantity: new(int)}))
+	}
+	require.NoError(t, fs.Close())
+
+	_, err = os.Stat(filepath.Join(dir, snapshotFileName))
+	assert.NoError(t, err, "snapshot should have been written")
+	wal, err := os.ReadFile(filepath.Join(dir, walFileName))
+	require.NoError(t, err)
+	assert.Equal(t, 1, bytes.Count(wal, []byte("\n")), "log should only hold records after the snapshot")
+
+	reopened := newTestFileStorage(t, dir)
+	slots, err := reopened.GetSlots(ctx)
+	require.NoError(t, err)
+	assert.Len(t, slots, 4)
+}
+
+func TestFileStorageVersionsSurviveRestart(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		options []func(*FileStorage)
+	}{
+		{"replayed", nil},
+		{"compacted", []func(*FileStorage){WithCompactEvery(1)}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			fs, err := NewFileStorage(dir, tt.options...)
+			require.NoError(t, err)
+			ctx := context.Background()
+			require.NoError(t, fs.AddSlot(ctx, "coke", storagetest.NewSlot("Coke", 1, 10, 20)))
+			require.NoError(t, fs.UpdateQuantity(ctx, "coke", 5))
+			deleted, err := fs.GetSlot(ctx, "coke")
+			require.NoError(t, err)
+			require.NoError(t, fs.DeleteSlot(ctx, "coke"))
+			require.NoError(t, fs.Close())
+
+			reopened := newTestFileStorage(t, dir, tt.options...)
+			require.NoError(t, reopened.AddSlot(ctx, "coke", storagetest.NewSlot("Coke", 1, 10, 20)))
+			slot, err := reopened.GetSlot(ctx, "coke")
+			require.NoError(t, err)
+			assert.Greater(t, *slot.Version, *deleted.Version, "a recreated slot does not get back a version of the deleted one")
+		})
+	}
+}
+
+func TestFileStorageTornRecord(t *testing.T) {
+	dir := t.TempDir()
+	fs, err := NewFileStorage(dir)
+	require.NoError(t, err)
+	ctx := context.Background()
+	require.NoError(t, fs.AddSlot(ctx, "coke", v1.VendingSlot{Quantity: new(int)}))
+	require.NoError(t, fs.Close())
+
+	wal, err := os.OpenFile(filepath.Join(dir, walFileName), os.O_WRONLY|os.O_APPEND, 0)
+	require.NoError(t, err)
+	_, err = wal.WriteString(`{"op":"add","name":"pep`)
+	require.NoError(t, err)
+	require.NoError(t, wal.Close())
+
+	reopened := newTestFileStorage(t, dir)
+	slots, err := reopened.GetSlots(ctx)
+	require.NoError(t, err)
+	assert.Len(t, slots, 1)
+	require.NoError(t, reopened.AddSlot(ctx, "pepsi", v1.VendingSlot{Quantity: new(int)}))
+	require.NoError(t, reopened.Close())
+
+	again := newTestFileStorage(t, dir)
+	slots, err = again.GetSlots(ctx)
+	require.NoError(t, err)
+	assert.Len(t, slots, 2)
+}
+
+func TestFileStorageConformance(t *testing.T) {
+	storagetest.RunStore(t, func(t *testing.T) svc.VendingStore {
+		return newTestFileStorage(t, t.TempDir())
+	})
+}
+
+func TestFileStorageLegacyStorageConformance(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) svc.VendingStorageInterface {
+		return svc.NewLegacyStorage(newTestFileStorage(t, t.TempDir()))
+	})
+}
+
+func TestFileUserStoreConformance(t *testing.T) {
+	storagetest.RunUserStore(t, func(t *testing.T) svc.UserStore {
+		users, err := NewFileUserStore(filepath.Join(t.TempDir(), "users.json"))
+		require.NoError(t, err)
+		return users
+	})
+}
+
+func TestFileAPIKeyStoreConformance(t *testing.T) {
+	storagetest.RunAPIKeyStore(t, func(t *testing.T) svc.APIKeyStore {
+		keys, err := NewFileAPIKeyStore(filepath.Join(t.TempDir(), "apikeys.json"))
+		require.NoError(t, err)
+		return keys
+	})
+}
+
+func TestFileAPIKeyStoreSurvivesRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "apikeys.json")
+	keys, err := NewFileAPIKeyStore(path)
+	require.NoError(t, err)
+	ctx := context.Background()
+	created := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, keys.CreateAPIKey(ctx, storagetest.NewAPIKeyRecord("k1", created)))
+	revoked, err := keys.UpdateAPIKey(ctx, "k1", func(key *svc.APIKeyRecord) error {
+		now := created.Add(time.Hour)
+		key.RevokedAt = &now
+		return nil
+	})
+	require.NoError(t, err)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	reopened, err := NewFileAPIKeyStore(path)
+	require.NoError(t, err)
+	key, err := reopened.GetAPIKey(ctx, "k1")
+	require.NoError(t, err)
+	assert.Equal(t, revoked, key)
+}
+
+func TestFileAuditStoreConformance(t *testing.T) {
+	storagetest.RunAuditStore(t, func(t *testing.T) svc.AuditStore {
+		audit, err := NewFileAuditStore(filepath.Join(t.TempDir(), "audit.jsonl"))
+		require.NoError(t, err)
+		t.Cleanup(func() { audit.Close() })
+		return audit
+	})
+}
+
+func TestFileAuditStoreSurvivesRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	audit, err := NewFileAuditStore(path)
+	require.NoError(t, err)
+	ctx := context.Background()
+	first, err := audit.AppendAuditRecord(ctx, storagetest.NewAuditRecord("admin", "A1"))
+	require.NoError(t, err)
+	require.NoError(t, audit.Close())
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	reopened, err := NewFileAuditStore(path)
+	require.NoError(t, err)
+	defer reopened.Close()
+	second, err := reopened.AppendAuditRecord(ctx, storagetest.NewAuditRecord("admin", "A2"))
+	require.NoError(t, err)
+	assert.Equal(t, first.Hash, second.PrevHash, "the chain continues after a restart")
+	records, err := reopened.GetAuditRecords(ctx, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []v1.AuditRecord{first, second}, records)
+	assert.NoError(t, svc.VerifyAuditTrail(records))
+}
+
+func TestFileAuditStoreEditsAreDetected(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	audit, err := NewFileAuditStore(path)
+	require.NoError(t, err)
+	ctx := context.Background()
+	for _, target := range []string{"A1", "A2", "A3"} {
+		_, err := audit.AppendAuditRecord(ctx, storagetest.NewAuditRecord("mallory", target))
+		require.NoError(t, err)
+	}
+	require.NoError(t, audit.Close())
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, bytes.Replace(b, []byte(`"mallory"`), []byte(`"admin"`), 1), 0o600))
+	edited, err := NewFileAuditStore(path)
+	require.NoError(t, err)
+	defer edited.Close()
+	records, err := edited.GetAuditRecords(ctx, 0, 0)
+	require.NoError(t, err)
+	err = svc.VerifyAuditTrail(records)
+	assert.ErrorIs(t, err, svc.ErrAuditTrailBroken)
+	assert.ErrorContains(t, err, "record 1 was changed")
+}
+
+func TestFileUserStoreSurvivesRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "users.json")
+	users, err := NewFileUserStore(path)
+	require.NoError(t, err)
+	ctx := context.Background()
+	require.NoError(t, users.CreateUser(ctx, storagetest.NewUserRecord("admin", v1.Operator)))
+	_, err = users.UpdateUser(ctx, "admin", func(user *svc.UserRecord) error {
+		user.Disabled = true
+		return nil
+	})
+	require.NoError(t, err)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm(), "only the owner may read the password hashes")
+
+	reopened, err := NewFileUserStore(path)
+	require.NoError(t, err)
+	user, err := reopened.GetUser(ctx, "ADMIN")
+	require.NoError(t, err)
+	assert.Equal(t, v1.Operator, user.Role)
+	assert.True(t, user.Disabled)
+	assert.Equal(t, "hash of admin", user.PasswordHash)
+}
+
+func TestFileUserStoreGivesLegacyUsersARole(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "users.json")
+	legacy := `[{"username":"root","admin":true,"disabled":false,"passwordHash":"x"},` +
+		`{"username":"bob","admin":false,"disabled":false,"passwordHash":"y"}]`
+	require.NoError(t, os.WriteFile(path, []byte(legacy), 0o600))
+
+	users, err := NewFileUserStore(path)
+	require.NoError(t, err)
+	root, err := users.GetUser(context.Background(), "root")
+	require.NoError(t, err)
+	assert.Equal(t, v1.Admin, root.Role)
+	bob, err := users.GetUser(context.Background(), "bob")
+	require.NoError(t, err)
+	assert.Equal(t, v1.Customer, bob.Role)
+	assert.False(t, *bob.Admin)
+}
@@ -2,28 +2,112 @@ package storage
 
 import (
 	v1 "colaco-api/internal/api/v1"
+	"colaco-api/svc"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 )
 
+// MemoryStorage keeps the slots in a map and their sodas in a catalog. It
+// maintains slot versions itself and implements svc.AtomicDecrementer,
+// svc.SlotUpdater, svc.SlotReplacer, svc.SodaCatalog, svc.CashBoxStorage,
+// svc.LedgerStorage and svc.DayCloseStorage.
 type MemoryStorage struct {
-	StorageMap map[string]v1.VendingSlot
-	m          sync.RWMutex
+	StorageMap   map[string]v1.VendingSlot
+	sodas        sodaCatalog
+	cash         v1.CashBox
+	transactions []v1.Transaction
+	closes       []v1.DayClose
+	versions     svc.SlotVersions
+	m            sync.RWMutex
 }
 
 func NewMemoryStorage() *MemoryStorage {
 	s := make(map[string]v1.VendingSlot)
 	return &MemoryStorage{
 		StorageMap: s,
+		sodas:      make(sodaCatalog),
+		cash:       svc.NewCashBox(),
 		m:          sync.RWMutex{},
 	}
 }
 
+// cloneSlot deep copies a slot so that callers mutating the pointer fields of
+// a slot they read or wrote cannot change the stored state behind our lock.
+func cloneSlot(slot v1.VendingSlot) v1.VendingSlot {
+	c := slot
+	c.Cost = clonePtr(slot.Cost)
+	c.Price = clonePtr(slot.Price)
+	c.MaxQuantity = clonePtr(slot.MaxQuantity)
+	c.Quantity = clonePtr(slot.Quantity)
+	c.Version = clonePtr(slot.Version)
+	c.Id = clonePtr(slot.Id)
+	c.Position = clonePtr(slot.Position)
+	if slot.OccupiedSoda != nil {
+		soda := cloneSoda(*slot.OccupiedSoda)
+		c.OccupiedSoda = &soda
+	}
+	return c
+}
+
+// cloneCashBox deep copies a cash box for the same reason.
+func cloneCashBox(box v1.CashBox) v1.CashBox {
+	c := box
+	c.Denominations = append([]v1.Denomination{}, box.Denominations...)
+	c.Total = clonePtr(box.Total)
+	return c
+}
+
+func clonePtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+// sortedSlots returns the slots in m ordered by key, resolved against c.
+func sortedSlots(m map[string]v1.VendingSlot, c sodaCatalog) (slots []v1.VendingSlot) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		slots = append(slots, c.resolve(m[k]))
+	}
+	return slots
+}
+
+// get returns a copy of the slot stored under key holding the catalog's
+// definition of its soda. The caller must hold the lock.
+func (m *MemoryStorage) get(key string) (v1.VendingSlot, bool) {
+	slot, ok := m.StorageMap[key]
+	if !ok {
+		return v1.VendingSlot{}, false
+	}
+	return m.sodas.resolve(slot), true
+}
+
+// put stores a copy of slot under key with the version following the one
+// currently stored, and its soda in the catalog. The caller must hold the
+// write lock.
+func (m *MemoryStorage) put(key string, slot v1.VendingSlot) v1.VendingSlot {
+	if prev, ok := m.StorageMap[key]; ok {
+		m.versions.Next(&prev, &slot)
+	} else {
+		m.versions.Next(nil, &slot)
+	}
+	m.sodas.store(&slot)
+	m.StorageMap[key] = cloneSlot(slot)
+	return cloneSlot(slot)
+}
+
 func (m *MemoryStorage) GetSlot(name string) (v1.VendingSlot, bool, error) {
 	m.m.RLock()
 	defer m.m.RUnlock()
-	if val, ok := m.StorageMap[strings.ToLower(name)]; ok {
+	if val, ok := m.get(strings.ToLower(name)); ok {
 		return val, true, nil
 	}
 	return v1.VendingSlot{}, false, nil
@@ -32,21 +116,21 @@ func (m *MemoryStorage) GetSlot(name string) (v1.VendingSlot, bool, error) {
 func (m *MemoryStorage) UpsertSlot(name string, slot v1.VendingSlot) {
 	m.m.Lock()
 	defer m.m.Unlock()
-	m.StorageMap[strings.ToLower(name)] = slot
+	m.put(strings.ToLower(name), slot)
 }
 
 func (m *MemoryStorage) GetSlots() (slots []v1.VendingSlot) {
 	m.m.RLock()
 	defer m.m.RUnlock()
-	for _, v := range m.StorageMap {
-		slots = append(slots, v)
-	}
-	return slots
+	return sortedSlots(m.StorageMap, m.sodas)
 }
 
 func (m *MemoryStorage) DeleteSlot(name string) (bool, error) {
 	m.m.Lock()
 	defer m.m.Unlock()
+	if _, ok := m.StorageMap[strings.ToLower(name)]; !ok {
+		return false, nil
+	}
 	delete(m.StorageMap, strings.ToLower(name))
 	if _, ok := m.StorageMap[strings.ToLower(name)]; ok {
 		return false, fmt.Errorf("still exists")
@@ -57,21 +141,22 @@ func (m *MemoryStorage) DeleteSlot(name string) (bool, error) {
 func (m *MemoryStorage) UpdatePrice(name string, price float32) error {
 	m.m.Lock()
 	defer m.m.Unlock()
-	slot, ok := m.StorageMap[strings.ToLower(name)]
+	slot, ok := m.get(strings.ToLower(name))
 	if !ok {
 		return fmt.Errorf("slot not found")
 	}
-	slot.Cost = &price
-	m.StorageMap[strings.ToLower(name)] = slot
+	svc.SetPrice(&slot, svc.NewMoney(price, svc.PriceCurrency(slot)))
+	m.put(strings.ToLower(name), slot)
 	return nil
 }
 
 func (m *MemoryStorage) UpdateQuantity(name string, qty int) error {
 	m.m.Lock()
 	defer m.m.Unlock()
-	if val, ok := m.StorageMap[strings.ToLower(name)]; ok {
+	if val, ok := m.get(strings.ToLower(name)); ok {
 		val.Quantity = &qty
-		m.StorageMap[strings.ToLower(name)] = val
+		m.put(strings.ToLower(name), val)
+		return nil
 	}
 	return fmt.Errorf("slot does not exist")
 }
@@ -79,5 +164,148 @@ func (m *MemoryStorage) UpdateQuantity(name string, qty int) error {
 func (m *MemoryStorage) AddSlot(name string, slot v1.VendingSlot) {
 	m.m.Lock()
 	defer m.m.Unlock()
-	m.StorageMap[strings.ToLower(name)] = slot
+	m.put(strings.ToLower(name), slot)
+}
+
+// DecrementIfAvailable implements svc.AtomicDecrementer.
+func (m *MemoryStorage) DecrementIfAvailable(name string, payment v1.Money) (v1.VendingSlot, error) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	slot, ok := m.get(strings.ToLower(name))
+	if !ok {
+		return v1.VendingSlot{}, fmt.Errorf("%w: %q", svc.ErrNotFound, name)
+	}
+	if err := svc.CheckPurchase(slot, payment); err != nil {
+		return slot, err
+	}
+	qty := *slot.Quantity - 1
+	slot.Quantity = &qty
+	return m.put(strings.ToLower(name), slot), nil
+}
+
+// UpdateSlot implements svc.SlotUpdater.
+func (m *MemoryStorage) UpdateSlot(name string, fn func(slot *v1.VendingSlot) error) (v1.VendingSlot, error) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	slot, ok := m.get(strings.ToLower(name))
+	if !ok {
+		return v1.VendingSlot{}, fmt.Errorf("%w: %q", svc.ErrNotFound, name)
+	}
+	if err := fn(&slot); err != nil {
+		return v1.VendingSlot{}, err
+	}
+	return m.put(strings.ToLower(name), slot), nil
+}
+
+// DeleteSlotIf implements svc.SlotUpdater.
+func (m *MemoryStorage) DeleteSlotIf(name string, check func(slot v1.VendingSlot) error) (v1.VendingSlot, error) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	slot, ok := m.get(strings.ToLower(name))
+	if !ok {
+		return v1.VendingSlot{}, fmt.Errorf("%w: %q", svc.ErrNotFound, name)
+	}
+	if err := check(cloneSlot(slot)); err != nil {
+		return v1.VendingSlot{}, err
+	}
+	delete(m.StorageMap, strings.ToLower(name))
+	return slot, nil
+}
+
+// ReplaceSlots implements svc.SlotReplacer.
+func (m *MemoryStorage) ReplaceSlots(fn func(slots []v1.VendingSlot) ([]v1.VendingSlot, error)) ([]v1.VendingSlot, error) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	next, err := fn(sortedSlots(m.StorageMap, m.sodas))
+	if err != nil {
+		return nil, err
+	}
+	replaced := make(map[string]v1.VendingSlot, len(next))
+	for _, slot := range next {
+		key := strings.ToLower(svc.SlotID(slot))
+		if prev, ok := m.StorageMap[key]; ok {
+			m.versions.Next(&prev, &slot)
+		} else {
+			m.versions.Next(nil, &slot)
+		}
+		m.sodas.store(&slot)
+		replaced[key] = cloneSlot(slot)
+	}
+	m.StorageMap = replaced
+	return sortedSlots(m.StorageMap, m.sodas), nil
+}
+
+// GetSoda implements svc.SodaCatalog.
+func (m *MemoryStorage) GetSoda(id string) (v1.Soda, bool, error) {
+	m.m.RLock()
+	defer m.m.RUnlock()
+	soda, ok := m.sodas[strings.ToLower(id)]
+	if !ok {
+		return v1.Soda{}, false, nil
+	}
+	return cloneSoda(soda), true, nil
+}
+
+// GetSodas implements svc.SodaCatalog.
+func (m *MemoryStorage) GetSodas() []v1.Soda {
+	m.m.RLock()
+	defer m.m.RUnlock()
+	return m.sodas.sorted()
+}
+
+// GetCashBox implements svc.CashBoxStorage.
+func (m *MemoryStorage) GetCashBox() (v1.CashBox, error) {
+	m.m.RLock()
+	defer m.m.RUnlock()
+	return svc.NormalizeCashBox(cloneCashBox(m.cash)), nil
+}
+
+// UpdateCashBox implements svc.CashBoxStorage.
+func (m *MemoryStorage) UpdateCashBox(fn func(box *v1.CashBox) error) (v1.CashBox, error) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	box := svc.NormalizeCashBox(cloneCashBox(m.cash))
+	if err := fn(&box); err != nil {
+		return v1.CashBox{}, err
+	}
+	box = svc.NormalizeCashBox(box)
+	m.cash = cloneCashBox(box)
+	return box, nil
+}
+
+// AppendTransaction implements svc.LedgerStorage.
+func (m *MemoryStorage) AppendTransaction(tx v1.Transaction) (v1.Transaction, error) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	id := int64(len(m.transactions)) + 1
+	tx.Id = &id
+	m.transactions = append(m.transactions, tx)
+	return tx, nil
+}
+
+// Transactions implements svc.LedgerStorage.
+func (m *MemoryStorage) Transactions(filter svc.TransactionFilter) ([]v1.Transaction, error) {
+	m.m.RLock()
+	defer m.m.RUnlock()
+	return svc.FilterTransactions(m.transactions, filter), nil
+}
+
+// AppendDayClose implements svc.DayCloseStorage.
+func (m *MemoryStorage) AppendDayClose(report v1.DayClose) (v1.DayClose, error) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	if err := svc.CheckDayClose(m.closes, report); err != nil {
+		return v1.DayClose{}, err
+	}
+	id := int64(len(m.closes)) + 1
+	report.Id = &id
+	m.closes = append(m.closes, report)
+	return report, nil
+}
+
+// DayCloses implements svc.DayCloseStorage.
+func (m *MemoryStorage) DayCloses() ([]v1.DayClose, error) {
+	m.m.RLock()
+	defer m.m.RUnlock()
+	return append([]v1.DayClose{}, m.closes...), nil
 }
@@ -2,6 +2,10 @@ package storage
 
 import (
 	v1 "colaco-api/internal/api/v1"
+	"colaco-api/internal/storage/storagetest"
+	"colaco-api/svc"
+	"context"
+	"errors"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -24,6 +28,8 @@ func TestUpsertAndGetSlot(t *testing.T) {
 	retSlot, found, err := ms.GetSlot(slotName)
 	assert.True(t, found)
 	assert.Nil(t, err)
+	version := int64(1)
+	slot.Version = &version
 	assert.Equal(t, slot, retSlot)
 }
 
@@ -63,3 +69,70 @@ func TestUpdatePrice(t *testing.T) {
 	assert.True(t, found)
 	assert.Equal(t, newPrice, *slot.Cost)
 }
+
+func TestMemoryStorageConformance(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) svc.VendingStorageInterface {
+		return NewMemoryStorage()
+	})
+}
+
+func TestMemoryStorageLegacyStoreConformance(t *testing.T) {
+	storagetest.RunStore(t, func(t *testing.T) svc.VendingStore {
+		return svc.NewLegacyStore(NewMemoryStorage())
+	})
+}
+
+func TestMemoryUserStoreConformance(t *testing.T) {
+	storagetest.RunUserStore(t, func(t *testing.T) svc.UserStore {
+		return NewMemoryUserStore()
+	})
+}
+
+func TestMemoryAPIKeyStoreConformance(t *testing.T) {
+	storagetest.RunAPIKeyStore(t, func(t *testing.T) svc.APIKeyStore {
+		return NewMemoryAPIKeyStore()
+	})
+}
+
+func TestMemoryAuditStoreConformance(t *testing.T) {
+	storagetest.RunAuditStore(t, func(t *testing.T) svc.AuditStore {
+		return NewMemoryAuditStore()
+	})
+}
+
+// hiddenDecrementer hides MemoryStorage's native DecrementIfAvailable, and
+// its other optional capabilities, so the LegacyStore falls back to
+// emulating them and to deriving the soda catalog from the slots.
+type hiddenDecrementer struct {
+	svc.VendingStorageInterface
+}
+
+func TestLegacyStoreEmulatedDecrementConformance(t *testing.T) {
+	storagetest.RunStore(t, func(t *testing.T) svc.VendingStore {
+		return svc.NewLegacyStore(hiddenDecrementer{NewMemoryStorage()})
+	})
+}
+
+// brokenCashBox is a MemoryStorage whose cash box cannot be written.
+type brokenCashBox struct {
+	*MemoryStorage
+}
+
+func (brokenCashBox) UpdateCashBox(fn func(box *v1.CashBox) error) (v1.CashBox, error) {
+	return v1.CashBox{}, errors.New("disk full")
+}
+
+func TestLegacyStoreCashBoxErrors(t *testing.T) {
+	ctx := context.Background()
+	_, err := svc.NewLegacyStore(brokenCashBox{NewMemoryStorage()}).UpdateCashBox(ctx, func(*v1.CashBox) error {
+		return nil
+	})
+	assert.ErrorIs(t, err, svc.ErrUnavailable, "a failing backend is unavailable")
+
+	refused := errors.New("not enough change")
+	_, err = svc.NewLegacyStore(NewMemoryStorage()).UpdateCashBox(ctx, func(*v1.CashBox) error {
+		return refused
+	})
+	assert.ErrorIs(t, err, refused)
+	assert.NotErrorIs(t, err, svc.ErrUnavailable, "errors of fn are passed on unchanged")
+}
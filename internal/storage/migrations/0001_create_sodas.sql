@@ -0,0 +1,8 @@
+CREATE TABLE sodas (
+    id           INTEGER PRIMARY KEY AUTOINCREMENT,
+    name         TEXT,
+    description  TEXT,
+    origin_story TEXT,
+    calories     INTEGER,
+    ounces       REAL
+);
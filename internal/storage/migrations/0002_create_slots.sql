@@ -0,0 +1,11 @@
+-- Slots are keyed by the lower-cased slot name so lookups stay
+-- case-insensitive exactly like MemoryStorage.
+CREATE TABLE slots (
+    name         TEXT PRIMARY KEY,
+    soda_id      INTEGER REFERENCES sodas (id) ON DELETE SET NULL,
+    cost         REAL,
+    max_quantity INTEGER,
+    quantity     INTEGER
+);
+
+CREATE INDEX slots_soda_id ON slots (soda_id);
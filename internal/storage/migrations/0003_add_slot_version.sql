@@ -0,0 +1,2 @@
+-- Every write bumps the version, which the API exposes as the slot's ETag.
+ALTER TABLE slots ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
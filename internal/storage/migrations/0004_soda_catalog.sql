@@ -0,0 +1,12 @@
+-- Sodas become a catalog shared by every slot holding them. code is the
+-- soda's catalog ID; existing sodas get the ID derived from their name, the
+-- same way svc.Slug does. Sodas without a name or ID stay private to their
+-- slot and keep a NULL code.
+ALTER TABLE sodas ADD COLUMN code TEXT;
+UPDATE sodas SET code = lower(replace(trim(name), ' ', '-')) WHERE name IS NOT NULL;
+CREATE UNIQUE INDEX sodas_code ON sodas (code);
+
+-- slots.name stays the lower-cased lookup key while slot_id keeps the slot's
+-- ID as it was given.
+ALTER TABLE slots ADD COLUMN slot_id TEXT;
+UPDATE slots SET slot_id = name;
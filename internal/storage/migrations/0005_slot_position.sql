@@ -0,0 +1,4 @@
+-- The physical position of a slot in the machine layout. Slots created
+-- without a position keep NULLs and are not part of the layout.
+ALTER TABLE slots ADD COLUMN position_row TEXT;
+ALTER TABLE slots ADD COLUMN position_column INTEGER;
@@ -0,0 +1,6 @@
+-- Prices become exact amounts in the minor unit of their currency. Existing
+-- float costs were in dollars. cost stays, kept in step with the price.
+ALTER TABLE slots ADD COLUMN price_amount INTEGER;
+ALTER TABLE slots ADD COLUMN price_currency TEXT;
+UPDATE slots SET price_amount = CAST(round(cost * 100) AS INTEGER), price_currency = 'USD'
+    WHERE cost IS NOT NULL;
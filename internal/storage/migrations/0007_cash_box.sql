@@ -0,0 +1,13 @@
+-- The cash box holds the coins and bills used to give change. Denominations
+-- are values in the minor unit of the single currency of the cash box.
+CREATE TABLE cash_box (
+    id       INTEGER PRIMARY KEY CHECK (id = 1),
+    currency TEXT NOT NULL
+);
+
+INSERT INTO cash_box (id, currency) VALUES (1, 'USD');
+
+CREATE TABLE cash_box_denominations (
+    value INTEGER PRIMARY KEY CHECK (value > 0),
+    count INTEGER NOT NULL CHECK (count > 0)
+);
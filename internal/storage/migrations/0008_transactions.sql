@@ -0,0 +1,24 @@
+-- The append-only transaction ledger. Timestamps are UTC RFC 3339 with a
+-- fixed number of fractional digits so that they sort and compare as text.
+-- Money columns are amounts in the minor unit of their currency column.
+CREATE TABLE transactions (
+    id                      INTEGER PRIMARY KEY AUTOINCREMENT,
+    timestamp               TEXT NOT NULL,
+    actor                   TEXT NOT NULL,
+    operation               TEXT NOT NULL,
+    slot_id                 TEXT NOT NULL,
+    soda_id                 TEXT,
+    soda_name               TEXT,
+    price_amount            INTEGER,
+    price_currency          TEXT,
+    previous_price_amount   INTEGER,
+    previous_price_currency TEXT,
+    paid_amount             INTEGER,
+    paid_currency           TEXT,
+    change_amount           INTEGER,
+    change_currency         TEXT,
+    quantity_before         INTEGER,
+    quantity_after          INTEGER
+);
+
+CREATE INDEX transactions_timestamp ON transactions (timestamp);
@@ -0,0 +1,11 @@
+-- Units of a restock that did not fit in the slot.
+ALTER TABLE transactions ADD COLUMN leftover INTEGER;
+
+-- The end-of-day reports of closed periods, stored as the JSON document that
+-- was returned when the period was closed. closed_at is formatted like the
+-- ledger timestamps.
+CREATE TABLE day_closes (
+    id        INTEGER PRIMARY KEY,
+    closed_at TEXT NOT NULL,
+    report    TEXT NOT NULL
+);
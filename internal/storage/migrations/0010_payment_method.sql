@@ -0,0 +1,2 @@
+-- How a purchase was paid: cash or cashless.
+ALTER TABLE transactions ADD COLUMN payment_method TEXT;
@@ -0,0 +1,12 @@
+-- The highest slot version ever handed out. A slot created again after it
+-- was deleted continues from it, so that the ETag of the deleted slot does
+-- not match the new one.
+CREATE TABLE slot_versions (last INTEGER NOT NULL);
+INSERT INTO slot_versions (last) SELECT COALESCE(MAX(version), 0) FROM slots;
+
+CREATE TRIGGER slots_version_inserted AFTER INSERT ON slots BEGIN
+	UPDATE slot_versions SET last = max(last, NEW.version);
+END;
+CREATE TRIGGER slots_version_updated AFTER UPDATE OF version ON slots BEGIN
+	UPDATE slot_versions SET last = max(last, NEW.version);
+END;
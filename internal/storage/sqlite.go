@@ -0,0 +1,1027 @@
+package storage
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"colaco-api/svc"
+	"context"
+	"database/sql"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// SQLiteStorage is a svc.VendingStore backed by an embedded SQLite database.
+// It uses the cgo-free modernc.org/sqlite driver so the server still builds
+// with CGO_ENABLED=0. Sodas and slots live in their own tables so inventory
+// can be queried with plain SQL; the sodas table is the soda catalog. Every
+// statement runs with the caller's context, and failures of the database are
+// reported as svc.ErrUnavailable. Wrap it with svc.NewLegacyStorage where a
+// svc.VendingStorageInterface is expected.
+type SQLiteStorage struct {
+	DB *sql.DB
+}
+
+var _ svc.VendingStore = (*SQLiteStorage)(nil)
+
+// NewSQLiteStorage opens the database described by dsn (a file path or
+// ":memory:") and brings its schema up to date by running any migrations
+// that have not been applied yet.
+func NewSQLiteStorage(dsn string) (*SQLiteStorage, error) {
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("opening sqlite database: %w", err)
+	}
+	// SQLite allows a single writer at a time and every ":memory:" connection
+	// is a separate database, so all access goes through one connection.
+	db.SetMaxOpenConns(1)
+	if _, err := db.Exec("PRAGMA foreign_keys = ON"); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("enabling foreign keys: %w", err)
+	}
+	s := &SQLiteStorage{DB: db}
+	if err := s.Migrate(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// Migrate applies, in order, every embedded migration newer than the version
+// recorded in schema_migrations. Each migration runs in its own transaction.
+func (s *SQLiteStorage) Migrate() error {
+	if _, err := s.DB.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    INTEGER PRIMARY KEY,
+		applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+	)`); err != nil {
+		return fmt.Errorf("creating schema_migrations: %w", err)
+	}
+	var current int
+	if err := s.DB.QueryRow("SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&current); err != nil {
+		return fmt.Errorf("reading schema version: %w", err)
+	}
+
+	names, err := fs.Glob(migrations, "migrations/*.sql")
+	if err != nil {
+		return fmt.Errorf("listing migrations: %w", err)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		base := strings.TrimPrefix(name, "migrations/")
+		version, err := strconv.Atoi(strings.SplitN(base, "_", 2)[0])
+		if err != nil {
+			return fmt.Errorf("migration %s has no version prefix", base)
+		}
+		if version <= current {
+			continue
+		}
+		body, err := migrations.ReadFile(name)
+		if err != nil {
+			return fmt.Errorf("reading migration %s: %w", base, err)
+		}
+		tx, err := s.DB.Begin()
+		if err != nil {
+			return fmt.Errorf("starting migration %s: %w", base, err)
+		}
+		if _, err := tx.Exec(string(body)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("applying migration %s: %w", base, err)
+		}
+		if _, err := tx.Exec("INSERT INTO schema_migrations (version) VALUES (?)", version); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("recording migration %s: %w", base, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("committing migration %s: %w", base, err)
+		}
+	}
+	return nil
+}
+
+// Close closes the underlying database.
+func (s *SQLiteStorage) Close() error {
+	return s.DB.Close()
+}
+
+const selectSlots = `SELECT sl.slot_id, sl.position_row, sl.position_column,
+	sl.cost, sl.price_amount, sl.price_currency, sl.max_quantity, sl.quantity, sl.version, sl.soda_id,
+	so.code, so.name, so.description, so.origin_story, so.calories, so.ounces
+	FROM slots sl LEFT JOIN sodas so ON so.id = sl.soda_id`
+
+const selectSodas = `SELECT code, name, description, origin_story, calories, ounces FROM sodas`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSlot(r rowScanner) (v1.VendingSlot, error) {
+	var (
+		cost, ounces                    sql.NullFloat64
+		maxQty, qty, sodaID             sql.NullInt64
+		slotID, row, currency           sql.NullString
+		amount                          sql.NullInt64
+		column                          sql.NullInt64
+		version                         int64
+		code, name, description, origin sql.NullString
+		calories                        sql.NullInt64
+	)
+	if err := r.Scan(&slotID, &row, &column, &cost, &amount, &currency, &maxQty, &qty, &version, &sodaID,
+		&code, &name, &description, &origin, &calories, &ounces); err != nil {
+		return v1.VendingSlot{}, err
+	}
+	slot := v1.VendingSlot{
+		Id:          nullString(slotID),
+		Cost:        nullFloat32(cost),
+		MaxQuantity: nullInt(maxQty),
+		Quantity:    nullInt(qty),
+		Version:     &version,
+	}
+	if amount.Valid && currency.Valid {
+		slot.Price = &v1.Money{Amount: amount.Int64, Currency: currency.String}
+	}
+	if row.Valid && column.Valid {
+		slot.Position = &v1.SlotPosition{Row: row.String, Column: int(column.Int64)}
+	}
+	if sodaID.Valid {
+		slot.OccupiedSoda = &v1.Soda{
+			Calories:    nullInt(calories),
+			Description: nullString(description),
+			Id:          nullString(code),
+			Name:        nullString(name),
+			OriginStory: nullString(origin),
+			Ounces:      nullFloat32(ounces),
+		}
+	}
+	return slot, nil
+}
+
+func nullFloat32(n sql.NullFloat64) *float32 {
+	if !n.Valid {
+		return nil
+	}
+	f := float32(n.Float64)
+	return &f
+}
+
+func nullInt(n sql.NullInt64) *int {
+	if !n.Valid {
+		return nil
+	}
+	i := int(n.Int64)
+	return &i
+}
+
+func nullString(n sql.NullString) *string {
+	if !n.Valid {
+		return nil
+	}
+	return &n.String
+}
+
+// unavailable wraps an error of the database in svc.ErrUnavailable.
+func unavailable(err error) error {
+	return fmt.Errorf("%w: %w", svc.ErrUnavailable, err)
+}
+
+// getSlot returns the slot stored under key, or svc.ErrNotFound.
+func getSlot(ctx context.Context, q queryer, key string) (v1.VendingSlot, error) {
+	slot, err := scanSlot(q.QueryRowContext(ctx, selectSlots+" WHERE sl.name = ?", key))
+	if errors.Is(err, sql.ErrNoRows) {
+		return v1.VendingSlot{}, fmt.Errorf("%w: %q", svc.ErrNotFound, key)
+	}
+	if err != nil {
+		return v1.VendingSlot{}, unavailable(fmt.Errorf("querying slot: %w", err))
+	}
+	return svc.WithPrice(slot), nil
+}
+
+func (s *SQLiteStorage) GetSlot(ctx context.Context, name string) (v1.VendingSlot, error) {
+	return getSlot(ctx, s.DB, strings.ToLower(name))
+}
+
+// inTx runs fn in a transaction that is committed if fn returns nil and
+// rolled back otherwise. Errors of fn are returned unchanged.
+func (s *SQLiteStorage) inTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return unavailable(err)
+	}
+	defer tx.Rollback()
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return unavailable(err)
+	}
+	return nil
+}
+
+// writeSoda stores soda in the catalog, replacing the previous definition
+// with the same ID, and returns its row id. A soda without a name or ID cannot
+// be shared, so it gets a private row, reusing the slot's current one, which
+// is private when it has no code.
+func writeSoda(ctx context.Context, tx *sql.Tx, soda v1.Soda, current sql.NullInt64, currentCode sql.NullString) (int64, error) {
+	code := svc.SodaID(soda)
+	if code == "" {
+		if current.Valid && !currentCode.Valid {
+			_, err := tx.ExecContext(ctx, `UPDATE sodas SET name = ?, description = ?, origin_story = ?,
+				calories = ?, ounces = ? WHERE id = ?`,
+				soda.Name, soda.Description, soda.OriginStory, soda.Calories, soda.Ounces, current.Int64)
+			return current.Int64, err
+		}
+		res, err := tx.ExecContext(ctx, `INSERT INTO sodas (name, description, origin_story, calories, ounces)
+			VALUES (?, ?, ?, ?, ?)`,
+			soda.Name, soda.Description, soda.OriginStory, soda.Calories, soda.Ounces)
+		if err != nil {
+			return 0, err
+		}
+		return res.LastInsertId()
+	}
+	if _, err := tx.ExecContext(ctx, `INSERT INTO sodas (code, name, description, origin_story, calories, ounces)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT (code) DO UPDATE SET name = excluded.name, description = excluded.description,
+			origin_story = excluded.origin_story, calories = excluded.calories, ounces = excluded.ounces`,
+		code, soda.Name, soda.Description, soda.OriginStory, soda.Calories, soda.Ounces); err != nil {
+		return 0, err
+	}
+	var id int64
+	err := tx.QueryRowContext(ctx, "SELECT id FROM sodas WHERE code = ?", code).Scan(&id)
+	return id, err
+}
+
+// deletePrivateSoda deletes the soda of the slot stored under key if it is
+// private to the slot. Catalog sodas outlive their slots.
+func deletePrivateSoda(ctx context.Context, tx *sql.Tx, key string) error {
+	_, err := tx.ExecContext(ctx, `DELETE FROM sodas WHERE code IS NULL
+		AND id = (SELECT soda_id FROM slots WHERE name = ?)`, key)
+	return err
+}
+
+// writeSlot writes the slot and its soda and bumps the slot's version. A new
+// slot gets a version above any handed out before, see slot_versions. When
+// ifVersion is not zero the slot is only overwritten if it still has that
+// version, otherwise svc.ErrVersionMismatch is returned and the caller must
+// roll back.
+func writeSlot(ctx context.Context, tx *sql.Tx, key string, slot v1.VendingSlot, ifVersion int64) error {
+	var sodaID sql.NullInt64
+	var code sql.NullString
+	err := tx.QueryRowContext(ctx, `SELECT sl.soda_id, so.code FROM slots sl
+		LEFT JOIN sodas so ON so.id = sl.soda_id WHERE sl.name = ?`, key).Scan(&sodaID, &code)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	if slot.OccupiedSoda == nil {
+		if err := deletePrivateSoda(ctx, tx, key); err != nil {
+			return err
+		}
+		sodaID = sql.NullInt64{}
+	} else {
+		id, err := writeSoda(ctx, tx, *slot.OccupiedSoda, sodaID, code)
+		if err != nil {
+			return err
+		}
+		sodaID = sql.NullInt64{Int64: id, Valid: true}
+	}
+
+	// The deprecated cost column is kept in step with the price for readers
+	// of the database that predate exact prices.
+	var cost sql.NullFloat64
+	var amount sql.NullInt64
+	var currency sql.NullString
+	if price := svc.SlotPrice(slot); price != nil {
+		cost = sql.NullFloat64{Float64: float64(svc.MoneyFloat(*price)), Valid: true}
+		amount = sql.NullInt64{Int64: price.Amount, Valid: true}
+		currency = sql.NullString{String: price.Currency, Valid: true}
+	}
+	var row sql.NullString
+	var column sql.NullInt64
+	if slot.Position != nil {
+		row = sql.NullString{String: slot.Position.Row, Valid: true}
+		column = sql.NullInt64{Int64: int64(slot.Position.Column), Valid: true}
+	}
+
+	res, err := tx.ExecContext(ctx, `INSERT INTO slots (name, slot_id, position_row, position_column, soda_id,
+			cost, price_amount, price_currency, max_quantity, quantity, version)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, (SELECT last + 1 FROM slot_versions))
+		ON CONFLICT (name) DO UPDATE SET slot_id = excluded.slot_id,
+			position_row = excluded.position_row, position_column = excluded.position_column,
+			soda_id = excluded.soda_id, cost = excluded.cost, price_amount = excluded.price_amount,
+			price_currency = excluded.price_currency, max_quantity = excluded.max_quantity,
+			quantity = excluded.quantity, version = slots.version + 1
+		WHERE ? = 0 OR slots.version = ?`,
+		key, slot.Id, row, column, sodaID, cost, amount, currency, slot.MaxQuantity, slot.Quantity,
+		ifVersion, ifVersion)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return svc.ErrVersionMismatch
+	}
+	return nil
+}
+
+func (s *SQLiteStorage) GetSlots(ctx context.Context) ([]v1.VendingSlot, error) {
+	slots, err := readSlots(ctx, s.DB)
+	if err != nil {
+		return nil, unavailable(err)
+	}
+	return slots, nil
+}
+
+// slotOrderColumns are the expressions slots are ordered by for each
+// svc.SlotOrder, besides the lower-cased slot ID that breaks ties.
+var slotOrderColumns = map[svc.SlotOrder]string{
+	svc.OrderByName:     "lower(so.name)",
+	svc.OrderByPrice:    "sl.price_amount",
+	svc.OrderByCalories: "so.calories",
+	svc.OrderByOunces:   "so.ounces",
+	svc.OrderByQuantity: "sl.quantity",
+}
+
+// QuerySlots applies the query in SQL,
+// with NULLs sorting first like the slots lacking a value do in
+// svc.SlotQuery.Compare, and q.After continues with a keyset condition on
+// the order rather than an offset.
+func (s *SQLiteStorage) QuerySlots(ctx context.Context, q svc.SlotQuery) ([]v1.VendingSlot, error) {
+	var (
+		where []string
+		args  []any
+	)
+	if q.Name != "" {
+		where, args = append(where, "instr(lower(so.name), lower(?)) > 0"), append(args, q.Name)
+	}
+	if q.MinPrice != nil {
+		where = append(where, "sl.price_currency = ? COLLATE NOCASE AND sl.price_amount >= ?")
+		args = append(args, q.MinPrice.Currency, q.MinPrice.Amount)
+	}
+	if q.MaxPrice != nil {
+		where = append(where, "sl.price_currency = ? COLLATE NOCASE AND sl.price_amount <= ?")
+		args = append(args, q.MaxPrice.Currency, q.MaxPrice.Amount)
+	}
+	if q.MinCalories != nil {
+		where, args = append(where, "so.calories >= ?"), append(args, *q.MinCalories)
+	}
+	if q.MaxCalories != nil {
+		where, args = append(where, "so.calories <= ?"), append(args, *q.MaxCalories)
+	}
+	if q.MinOunces != nil {
+		where, args = append(where, "so.ounces >= ?"), append(args, float64(*q.MinOunces))
+	}
+	if q.MaxOunces != nil {
+		where, args = append(where, "so.ounces <= ?"), append(args, float64(*q.MaxOunces))
+	}
+	switch q.Stock {
+	case svc.InStock:
+		where = append(where, "sl.quantity > 0")
+	case svc.LowStock:
+		where = append(where, "sl.quantity > 0 AND sl.quantity * 5 <= sl.max_quantity")
+	case svc.SoldOut:
+		where = append(where, "COALESCE(sl.quantity, 0) <= 0")
+	}
+
+	column, ordered := slotOrderColumns[q.OrderBy]
+	direction, after := "ASC", ">"
+	if q.Descending {
+		direction, after = "DESC", "<"
+	}
+	if q.After != nil {
+		id := strings.ToLower(svc.SlotID(*q.After))
+		value := q.OrderValue(*q.After)
+		switch {
+		case !ordered:
+			where, args = append(where, "sl.name "+after+" ?"), append(args, id)
+		case value == nil && !q.Descending:
+			where = append(where, "("+column+" IS NOT NULL OR sl.name > ?)")
+			args = append(args, id)
+		case value == nil:
+			where = append(where, "("+column+" IS NULL AND sl.name < ?)")
+			args = append(args, id)
+		default:
+			cond := "(" + column + " " + after + " ? OR (" + column + " = ? AND sl.name " + after + " ?)"
+			if q.Descending {
+				cond += " OR " + column + " IS NULL"
+			}
+			where, args = append(where, cond+")"), append(args, value, value, id)
+		}
+	}
+
+	query := selectSlots
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	if ordered {
+		query += " ORDER BY " + column + " " + direction + ", sl.name " + direction
+	} else {
+		query += " ORDER BY sl.name " + direction
+	}
+	if q.Limit > 0 {
+		query, args = query+" LIMIT ?", append(args, q.Limit)
+	}
+
+	slots, err := querySlots(ctx, s.DB, query, args...)
+	if err != nil {
+		return nil, unavailable(err)
+	}
+	return slots, nil
+}
+
+func (s *SQLiteStorage) AddSlot(ctx context.Context, name string, slot v1.VendingSlot) error {
+	key := strings.ToLower(name)
+	svc.PrepareSlot(name, &slot)
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		var exists bool
+		if err := tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM slots WHERE name = ?)", key).Scan(&exists); err != nil {
+			return unavailable(err)
+		}
+		if exists {
+			return fmt.Errorf("%w: %q already exists", svc.ErrConflict, name)
+		}
+		if err := writeSlot(ctx, tx, key, slot, 0); err != nil {
+			return unavailable(err)
+		}
+		return nil
+	})
+}
+
+func (s *SQLiteStorage) UpsertSlot(ctx context.Context, name string, slot v1.VendingSlot) error {
+	svc.PrepareSlot(name, &slot)
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		if err := writeSlot(ctx, tx, strings.ToLower(name), slot, 0); err != nil {
+			return unavailable(err)
+		}
+		return nil
+	})
+}
+
+func (s *SQLiteStorage) DeleteSlot(ctx context.Context, name string) error {
+	_, err := s.DeleteSlotIf(ctx, name, func(v1.VendingSlot) error { return nil })
+	return err
+}
+
+// UpdatePrice sets the price through UpdateSlot, which keeps the deprecated
+// cost column in step.
+func (s *SQLiteStorage) UpdatePrice(ctx context.Context, name string, price v1.Money) error {
+	_, err := s.UpdateSlot(ctx, name, func(slot *v1.VendingSlot) error {
+		svc.SetPrice(slot, price)
+		return nil
+	})
+	return err
+}
+
+func (s *SQLiteStorage) UpdateQuantity(ctx context.Context, name string, qty int) error {
+	res, err := s.DB.ExecContext(ctx, "UPDATE slots SET quantity = ?, version = version + 1 WHERE name = ?", qty, strings.ToLower(name))
+	if err != nil {
+		return unavailable(err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return unavailable(err)
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: %q", svc.ErrNotFound, name)
+	}
+	return nil
+}
+
+// DecrementIfAvailable checks the stock and price as part of the UPDATE
+// statement itself, so processes sharing the database file cannot oversell a
+// slot.
+func (s *SQLiteStorage) DecrementIfAvailable(ctx context.Context, name string, payment v1.Money) (v1.VendingSlot, error) {
+	key := strings.ToLower(name)
+	var slot v1.VendingSlot
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx, `UPDATE slots SET quantity = quantity - 1, version = version + 1
+			WHERE name = ? AND quantity > 0 AND price_currency = ? AND price_amount <= ?`,
+			key, strings.ToUpper(payment.Currency), payment.Amount)
+		if err != nil {
+			return unavailable(err)
+		}
+		updated, err := res.RowsAffected()
+		if err != nil {
+			return unavailable(err)
+		}
+		if slot, err = getSlot(ctx, tx, key); err != nil {
+			return err
+		}
+		if updated == 0 {
+			// Nothing was sold; report why using the same rules as the other
+			// backends.
+			if err := svc.CheckPurchase(slot, payment); err != nil {
+				return err
+			}
+			return svc.ErrSoldOut
+		}
+		return nil
+	})
+	switch {
+	case errors.Is(err, svc.ErrSoldOut), errors.Is(err, svc.ErrCurrencyMismatch), errors.Is(err, svc.ErrInsufficientFunds):
+		return slot, err
+	case err != nil:
+		return v1.VendingSlot{}, err
+	}
+	return slot, nil
+}
+
+// UpdateSlot guards the write by the version that was read, so a concurrent
+// change by another process sharing the database is reported as
+// svc.ErrVersionMismatch instead of being lost.
+func (s *SQLiteStorage) UpdateSlot(ctx context.Context, name string, fn func(slot *v1.VendingSlot) error) (v1.VendingSlot, error) {
+	key := strings.ToLower(name)
+	var written v1.VendingSlot
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		slot, err := getSlot(ctx, tx, key)
+		if err != nil {
+			return err
+		}
+		read := svc.SlotVersion(slot)
+		if err := svc.PricedUpdate(fn)(&slot); err != nil {
+			return err
+		}
+		if err := writeSlot(ctx, tx, key, slot, read); err != nil {
+			if errors.Is(err, svc.ErrVersionMismatch) {
+				return err
+			}
+			return unavailable(err)
+		}
+		written, err = getSlot(ctx, tx, key)
+		return err
+	})
+	if err != nil {
+		return v1.VendingSlot{}, err
+	}
+	return written, nil
+}
+
+func (s *SQLiteStorage) DeleteSlotIf(ctx context.Context, name string, check func(slot v1.VendingSlot) error) (v1.VendingSlot, error) {
+	key := strings.ToLower(name)
+	var slot v1.VendingSlot
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		if slot, err = getSlot(ctx, tx, key); err != nil {
+			return err
+		}
+		if err := check(slot); err != nil {
+			return err
+		}
+		if err := deletePrivateSoda(ctx, tx, key); err != nil {
+			return unavailable(err)
+		}
+		res, err := tx.ExecContext(ctx, "DELETE FROM slots WHERE name = ? AND version = ?", key, svc.SlotVersion(slot))
+		if err != nil {
+			return unavailable(err)
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return unavailable(err)
+		} else if n == 0 {
+			return svc.ErrVersionMismatch
+		}
+		return nil
+	})
+	if err != nil {
+		return v1.VendingSlot{}, err
+	}
+	return slot, nil
+}
+
+// ReplaceSlots reads and rewrites the slots in one transaction.
+func (s *SQLiteStorage) ReplaceSlots(ctx context.Context, fn func(slots []v1.VendingSlot) ([]v1.VendingSlot, error)) ([]v1.VendingSlot, error) {
+	var slots []v1.VendingSlot
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		current, err := readSlots(ctx, tx)
+		if err != nil {
+			return unavailable(err)
+		}
+		next, err := fn(current)
+		if err != nil {
+			return err
+		}
+		keep := map[string]bool{}
+		for i := range next {
+			svc.PrepareSlot(svc.SlotID(next[i]), &next[i])
+			keep[strings.ToLower(svc.SlotID(next[i]))] = true
+		}
+		for _, slot := range current {
+			key := strings.ToLower(svc.SlotID(slot))
+			if keep[key] {
+				continue
+			}
+			if err := deletePrivateSoda(ctx, tx, key); err != nil {
+				return unavailable(err)
+			}
+			if _, err := tx.ExecContext(ctx, "DELETE FROM slots WHERE name = ?", key); err != nil {
+				return unavailable(err)
+			}
+		}
+		for _, slot := range next {
+			if err := writeSlot(ctx, tx, strings.ToLower(svc.SlotID(slot)), slot, 0); err != nil {
+				return unavailable(err)
+			}
+		}
+		if slots, err = readSlots(ctx, tx); err != nil {
+			return unavailable(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return slots, nil
+}
+
+// readSlots returns every slot ordered by name.
+func readSlots(ctx context.Context, q queryer) ([]v1.VendingSlot, error) {
+	return querySlots(ctx, q, selectSlots+" ORDER BY sl.name")
+}
+
+// querySlots returns the slots query selects.
+func querySlots(ctx context.Context, q queryer, query string, args ...any) ([]v1.VendingSlot, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying slots: %w", err)
+	}
+	defer rows.Close()
+	slots := []v1.VendingSlot{}
+	for rows.Next() {
+		slot, err := scanSlot(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning slot: %w", err)
+		}
+		slots = append(slots, svc.WithPrice(slot))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating slots: %w", err)
+	}
+	return slots, nil
+}
+
+func scanSoda(row rowScanner) (v1.Soda, error) {
+	var (
+		ounces                          sql.NullFloat64
+		calories                        sql.NullInt64
+		code, name, description, origin sql.NullString
+	)
+	if err := row.Scan(&code, &name, &description, &origin, &calories, &ounces); err != nil {
+		return v1.Soda{}, err
+	}
+	return v1.Soda{
+		Calories:    nullInt(calories),
+		Description: nullString(description),
+		Id:          nullString(code),
+		Name:        nullString(name),
+		OriginStory: nullString(origin),
+		Ounces:      nullFloat32(ounces),
+	}, nil
+}
+
+func (s *SQLiteStorage) GetSoda(ctx context.Context, id string) (v1.Soda, error) {
+	soda, err := scanSoda(s.DB.QueryRowContext(ctx, selectSodas+" WHERE code = ?", strings.ToLower(id)))
+	if errors.Is(err, sql.ErrNoRows) {
+		return v1.Soda{}, fmt.Errorf("%w: soda %q", svc.ErrNotFound, id)
+	}
+	if err != nil {
+		return v1.Soda{}, unavailable(fmt.Errorf("querying soda: %w", err))
+	}
+	return soda, nil
+}
+
+// GetSodas leaves out the sodas private to a slot, which are not part of the
+// catalog.
+func (s *SQLiteStorage) GetSodas(ctx context.Context) ([]v1.Soda, error) {
+	rows, err := s.DB.QueryContext(ctx, selectSodas+" WHERE code IS NOT NULL ORDER BY code")
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("querying sodas: %w", err))
+	}
+	defer rows.Close()
+	sodas := []v1.Soda{}
+	for rows.Next() {
+		soda, err := scanSoda(rows)
+		if err != nil {
+			return nil, unavailable(fmt.Errorf("scanning soda: %w", err))
+		}
+		sodas = append(sodas, soda)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, unavailable(fmt.Errorf("iterating sodas: %w", err))
+	}
+	return sodas, nil
+}
+
+// queryer is implemented by *sql.DB and *sql.Tx.
+type queryer interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+func readCashBox(ctx context.Context, q queryer) (v1.CashBox, error) {
+	box := svc.NewCashBox()
+	if err := q.QueryRowContext(ctx, "SELECT currency FROM cash_box WHERE id = 1").Scan(&box.Currency); err != nil {
+		return v1.CashBox{}, fmt.Errorf("querying cash box: %w", err)
+	}
+	rows, err := q.QueryContext(ctx, "SELECT value, count FROM cash_box_denominations")
+	if err != nil {
+		return v1.CashBox{}, fmt.Errorf("querying cash box: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var d v1.Denomination
+		if err := rows.Scan(&d.Value, &d.Count); err != nil {
+			return v1.CashBox{}, fmt.Errorf("scanning cash box: %w", err)
+		}
+		box.Denominations = append(box.Denominations, d)
+	}
+	if err := rows.Err(); err != nil {
+		return v1.CashBox{}, fmt.Errorf("iterating cash box: %w", err)
+	}
+	return svc.NormalizeCashBox(box), nil
+}
+
+func (s *SQLiteStorage) GetCashBox(ctx context.Context) (v1.CashBox, error) {
+	box, err := readCashBox(ctx, s.DB)
+	if err != nil {
+		return v1.CashBox{}, unavailable(err)
+	}
+	return box, nil
+}
+
+// UpdateCashBox reads and rewrites the cash box in one transaction.
+func (s *SQLiteStorage) UpdateCashBox(ctx context.Context, fn func(box *v1.CashBox) error) (v1.CashBox, error) {
+	var box v1.CashBox
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		if box, err = readCashBox(ctx, tx); err != nil {
+			return unavailable(err)
+		}
+		if err := fn(&box); err != nil {
+			return err
+		}
+		box = svc.NormalizeCashBox(box)
+		if _, err := tx.ExecContext(ctx, "UPDATE cash_box SET currency = ? WHERE id = 1", box.Currency); err != nil {
+			return unavailable(err)
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM cash_box_denominations"); err != nil {
+			return unavailable(err)
+		}
+		for _, d := range box.Denominations {
+			if _, err := tx.ExecContext(ctx, "INSERT INTO cash_box_denominations (value, count) VALUES (?, ?)", d.Value, d.Count); err != nil {
+				return unavailable(err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return v1.CashBox{}, err
+	}
+	return box, nil
+}
+
+// timestampLayout formats ledger timestamps so that they sort as text.
+const timestampLayout = "2006-01-02T15:04:05.000000000Z07:00"
+
+const selectTransactions = `SELECT id, timestamp, actor, operation, slot_id, soda_id, soda_name,
+	price_amount, price_currency, previous_price_amount, previous_price_currency,
+	paid_amount, paid_currency, change_amount, change_currency,
+	quantity_before, quantity_after, leftover, payment_method
+	FROM transactions`
+
+func moneyColumns(m *v1.Money) (sql.NullInt64, sql.NullString) {
+	if m == nil {
+		return sql.NullInt64{}, sql.NullString{}
+	}
+	return sql.NullInt64{Int64: m.Amount, Valid: true}, sql.NullString{String: m.Currency, Valid: true}
+}
+
+func nullMoney(amount sql.NullInt64, currency sql.NullString) *v1.Money {
+	if !amount.Valid || !currency.Valid {
+		return nil
+	}
+	return &v1.Money{Amount: amount.Int64, Currency: currency.String}
+}
+
+func nullIntColumn(i *int) sql.NullInt64 {
+	if i == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: int64(*i), Valid: true}
+}
+
+func nullStringColumn(s *string) sql.NullString {
+	if s == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: *s, Valid: true}
+}
+
+func scanTransaction(r rowScanner) (v1.Transaction, error) {
+	var (
+		tx                            v1.Transaction
+		id                            int64
+		timestamp, operation          string
+		sodaID, sodaName, method      sql.NullString
+		amounts                       [4]sql.NullInt64
+		currencies                    [4]sql.NullString
+		quantityBefore, quantityAfter sql.NullInt64
+		leftover                      sql.NullInt64
+	)
+	if err := r.Scan(&id, &timestamp, &tx.Actor, &operation, &tx.SlotId, &sodaID, &sodaName,
+		&amounts[0], &currencies[0], &amounts[1], &currencies[1],
+		&amounts[2], &currencies[2], &amounts[3], &currencies[3],
+		&quantityBefore, &quantityAfter, &leftover, &method); err != nil {
+		return v1.Transaction{}, err
+	}
+	at, err := time.Parse(timestampLayout, timestamp)
+	if err != nil {
+		return v1.Transaction{}, fmt.Errorf("parsing timestamp of transaction %d: %w", id, err)
+	}
+	tx.Id = &id
+	tx.Timestamp = at
+	tx.Operation = v1.TransactionOperation(operation)
+	tx.SodaId = nullString(sodaID)
+	tx.SodaName = nullString(sodaName)
+	tx.Price = nullMoney(amounts[0], currencies[0])
+	tx.PreviousPrice = nullMoney(amounts[1], currencies[1])
+	tx.Paid = nullMoney(amounts[2], currencies[2])
+	tx.Change = nullMoney(amounts[3], currencies[3])
+	tx.QuantityBefore = nullInt(quantityBefore)
+	tx.QuantityAfter = nullInt(quantityAfter)
+	tx.Leftover = nullInt(leftover)
+	if method.Valid {
+		m := v1.PaymentMethod(method.String)
+		tx.PaymentMethod = &m
+	}
+	return tx, nil
+}
+
+func (s *SQLiteStorage) AppendTransaction(ctx context.Context, tx v1.Transaction) (v1.Transaction, error) {
+	if tx.Timestamp.IsZero() {
+		tx.Timestamp = time.Now()
+	}
+	tx.Timestamp = tx.Timestamp.UTC()
+	price, priceCurrency := moneyColumns(tx.Price)
+	previous, previousCurrency := moneyColumns(tx.PreviousPrice)
+	paid, paidCurrency := moneyColumns(tx.Paid)
+	change, changeCurrency := moneyColumns(tx.Change)
+	res, err := s.DB.ExecContext(ctx, `INSERT INTO transactions (timestamp, actor, operation, slot_id, soda_id, soda_name,
+		price_amount, price_currency, previous_price_amount, previous_price_currency,
+		paid_amount, paid_currency, change_amount, change_currency,
+		quantity_before, quantity_after, leftover, payment_method) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		tx.Timestamp.Format(timestampLayout), tx.Actor, string(tx.Operation), tx.SlotId,
+		nullStringColumn(tx.SodaId), nullStringColumn(tx.SodaName),
+		price, priceCurrency, previous, previousCurrency, paid, paidCurrency, change, changeCurrency,
+		nullIntColumn(tx.QuantityBefore), nullIntColumn(tx.QuantityAfter), nullIntColumn(tx.Leftover),
+		nullStringColumn((*string)(tx.PaymentMethod)))
+	if err != nil {
+		return v1.Transaction{}, unavailable(fmt.Errorf("inserting transaction: %w", err))
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return v1.Transaction{}, unavailable(fmt.Errorf("reading transaction ID: %w", err))
+	}
+	tx.Id = &id
+	return tx, nil
+}
+
+// GetTransactions applies the filter in SQL.
+func (s *SQLiteStorage) GetTransactions(ctx context.Context, filter svc.TransactionFilter) ([]v1.Transaction, error) {
+	var (
+		where []string
+		args  []any
+	)
+	if filter.Operation != "" {
+		where, args = append(where, "operation = ?"), append(args, string(filter.Operation))
+	}
+	if filter.SlotID != "" {
+		where, args = append(where, "slot_id = ? COLLATE NOCASE"), append(args, filter.SlotID)
+	}
+	if filter.SodaID != "" {
+		where, args = append(where, "soda_id = ? COLLATE NOCASE"), append(args, filter.SodaID)
+	}
+	if filter.Actor != "" {
+		where, args = append(where, "actor = ? COLLATE NOCASE"), append(args, filter.Actor)
+	}
+	if !filter.Since.IsZero() {
+		where, args = append(where, "timestamp >= ?"), append(args, filter.Since.UTC().Format(timestampLayout))
+	}
+	if !filter.Until.IsZero() {
+		where, args = append(where, "timestamp < ?"), append(args, filter.Until.UTC().Format(timestampLayout))
+	}
+	if filter.After > 0 {
+		where, args = append(where, "id > ?"), append(args, filter.After)
+	}
+	query := selectTransactions
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY id"
+	if filter.Limit > 0 {
+		query, args = query+" LIMIT ?", append(args, filter.Limit)
+	}
+
+	rows, err := s.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("querying transactions: %w", err))
+	}
+	defer rows.Close()
+	txs := []v1.Transaction{}
+	for rows.Next() {
+		tx, err := scanTransaction(rows)
+		if err != nil {
+			return nil, unavailable(fmt.Errorf("scanning transaction: %w", err))
+		}
+		txs = append(txs, tx)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, unavailable(fmt.Errorf("iterating transactions: %w", err))
+	}
+	return txs, nil
+}
+
+// AppendDayClose checks the report against the last close and inserts it in
+// one transaction.
+func (s *SQLiteStorage) AppendDayClose(ctx context.Context, report v1.DayClose) (v1.DayClose, error) {
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		var (
+			id   int64
+			last []byte
+		)
+		err := tx.QueryRowContext(ctx, "SELECT id, report FROM day_closes ORDER BY id DESC LIMIT 1").Scan(&id, &last)
+		switch {
+		case err == nil:
+			var previous v1.DayClose
+			if err := json.Unmarshal(last, &previous); err != nil {
+				return unavailable(fmt.Errorf("decoding close %d: %w", id, err))
+			}
+			if err := svc.CheckDayClose([]v1.DayClose{previous}, report); err != nil {
+				return err
+			}
+		case !errors.Is(err, sql.ErrNoRows):
+			return unavailable(fmt.Errorf("querying last close: %w", err))
+		}
+		id++
+		report.Id = &id
+		b, err := json.Marshal(report)
+		if err != nil {
+			return fmt.Errorf("encoding close: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, "INSERT INTO day_closes (id, closed_at, report) VALUES (?, ?, ?)",
+			id, report.ClosedAt.UTC().Format(timestampLayout), string(b)); err != nil {
+			return unavailable(fmt.Errorf("inserting close: %w", err))
+		}
+		return nil
+	})
+	if err != nil {
+		return v1.DayClose{}, err
+	}
+	return report, nil
+}
+
+func (s *SQLiteStorage) GetDayCloses(ctx context.Context) ([]v1.DayClose, error) {
+	rows, err := s.DB.QueryContext(ctx, "SELECT report FROM day_closes ORDER BY id")
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("querying closes: %w", err))
+	}
+	defer rows.Close()
+	closes := []v1.DayClose{}
+	for rows.Next() {
+		var b []byte
+		if err := rows.Scan(&b); err != nil {
+			return nil, unavailable(fmt.Errorf("scanning close: %w", err))
+		}
+		var report v1.DayClose
+		if err := json.Unmarshal(b, &report); err != nil {
+			return nil, unavailable(fmt.Errorf("decoding close: %w", err))
+		}
+		closes = append(closes, report)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, unavailable(fmt.Errorf("iterating closes: %w", err))
+	}
+	return closes, nil
+}
+
+func (s *SQLiteStorage) GetDayClose(ctx context.Context, id int64) (v1.DayClose, error) {
+	var b []byte
+	err := s.DB.QueryRowContext(ctx, "SELECT report FROM day_closes WHERE id = ?", id).Scan(&b)
+	if errors.Is(err, sql.ErrNoRows) {
+		return v1.DayClose{}, fmt.Errorf("%w: %d", svc.ErrPeriodNotFound, id)
+	}
+	if err != nil {
+		return v1.DayClose{}, unavailable(fmt.Errorf("querying close %d: %w", id, err))
+	}
+	var report v1.DayClose
+	if err := json.Unmarshal(b, &report); err != nil {
+		return v1.DayClose{}, unavailable(fmt.Errorf("decoding close %d: %w", id, err))
+	}
+	return report, nil
+}
@@ -0,0 +1,227 @@
+package storage
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"colaco-api/internal/storage/storagetest"
+	"colaco-api/svc"
+	"context"
+	"database/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"path/filepath"
+	"testing"
+)
+
+func newTestSQLiteStorage(t *testing.T, dsn string) *SQLiteStorage {
+	t.Helper()
+	s, err := NewSQLiteStorage(dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+func TestSQLiteStorageUpsertAndGetSlot(t *testing.T) {
+	s := newTestSQLiteStorage(t, ":memory:")
+	ctx := context.Background()
+	name := "Coke"
+	id := "coke"
+	calories := 140
+	slot := v1.VendingSlot{
+		Cost:         new(float32),
+		Quantity:     new(int),
+		OccupiedSoda: &v1.Soda{Id: &id, Name: &name, Calories: &calories},
+	}
+	*slot.Cost = 1.25
+	*slot.Quantity = 20
+
+	require.NoError(t, s.UpsertSlot(ctx, name, slot))
+
+	retSlot, err := s.GetSlot(ctx, "COKE")
+	assert.Nil(t, err)
+	version := int64(1)
+	slot.Id = &name
+	slot.Version = &version
+	// A slot written with only the deprecated cost is stored with its exact
+	// price.
+	slot.Price = &v1.Money{Amount: 125, Currency: "USD"}
+	assert.Equal(t, slot, retSlot)
+}
+
+func TestSQLiteStorageGetSlots(t *testing.T) {
+	s := newTestSQLiteStorage(t, ":memory:")
+	ctx := context.Background()
+	require.NoError(t, s.UpsertSlot(ctx, "pepsi", v1.VendingSlot{Cost: new(float32), Quantity: new(int)}))
+	require.NoError(t, s.UpsertSlot(ctx, "coke", v1.VendingSlot{Cost: new(float32), Quantity: new(int)}))
+
+	slots, err := s.GetSlots(ctx)
+	require.NoError(t, err)
+	assert.Len(t, slots, 2)
+}
+
+func TestSQLiteStorageDeleteSlot(t *testing.T) {
+	s := newTestSQLiteStorage(t, ":memory:")
+	ctx := context.Background()
+	name := "coke"
+	require.NoError(t, s.UpsertSlot(ctx, name, v1.VendingSlot{OccupiedSoda: &v1.Soda{Name: &name}, Quantity: new(int)}))
+
+	assert.Nil(t, s.DeleteSlot(ctx, name))
+
+	_, err := s.GetSlot(ctx, name)
+	assert.ErrorIs(t, err, svc.ErrNotFound)
+
+	_, err = s.GetSoda(ctx, name)
+	assert.NoError(t, err, "catalog sodas should outlive their slots")
+}
+
+func TestSQLiteStorageDeleteSlotRemovesPrivateSoda(t *testing.T) {
+	s := newTestSQLiteStorage(t, ":memory:")
+	ctx := context.Background()
+	calories := 10
+	require.NoError(t, s.UpsertSlot(ctx, "a1", v1.VendingSlot{OccupiedSoda: &v1.Soda{Calories: &calories}, Quantity: new(int)}))
+
+	require.NoError(t, s.DeleteSlot(ctx, "a1"))
+
+	var sodas int
+	require.NoError(t, s.DB.QueryRow("SELECT COUNT(*) FROM sodas").Scan(&sodas))
+	assert.Zero(t, sodas, "a soda without name or ID is private to its slot")
+}
+
+func TestSQLiteStorageMigratesSodasIntoCatalog(t *testing.T) {
+	dsn := filepath.Join(t.TempDir(), "colaco.db")
+	db, err := sql.Open("sqlite", dsn)
+	require.NoError(t, err)
+	body, err := migrations.ReadFile("migrations/0001_create_sodas.sql")
+	require.NoError(t, err)
+	_, err = db.Exec(string(body))
+	require.NoError(t, err)
+	body, err = migrations.ReadFile("migrations/0002_create_slots.sql")
+	require.NoError(t, err)
+	_, err = db.Exec(string(body))
+	require.NoError(t, err)
+	_, err = db.Exec(`CREATE TABLE schema_migrations (
+		version    INTEGER PRIMARY KEY,
+		applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+	INSERT INTO schema_migrations (version) VALUES (1), (2);
+	INSERT INTO sodas (id, name) VALUES (7, 'Mega Pop');
+	INSERT INTO slots (name, soda_id, cost, quantity) VALUES ('mega pop', 7, 1.1, 3);`)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	s := newTestSQLiteStorage(t, dsn)
+	ctx := context.Background()
+	slot, err := s.GetSlot(ctx, "Mega Pop")
+	require.NoError(t, err)
+	assert.Equal(t, "mega pop", *slot.Id)
+	assert.Equal(t, "mega-pop", *slot.OccupiedSoda.Id)
+	assert.Equal(t, int64(1), *slot.Version)
+	assert.Equal(t, &v1.Money{Amount: 110, Currency: "USD"}, slot.Price, "float costs become exact dollar prices")
+
+	soda, err := s.GetSoda(ctx, "mega-pop")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Mega Pop", *soda.Name)
+	}
+}
+
+func TestSQLiteStorageUpdatePriceAndQuantity(t *testing.T) {
+	s := newTestSQLiteStorage(t, ":memory:")
+	ctx := context.Background()
+	initialPrice := float32(1.0)
+	require.NoError(t, s.UpsertSlot(ctx, "coke", v1.VendingSlot{Cost: &initialPrice, Quantity: new(int)}))
+
+	assert.NoError(t, s.UpdatePrice(ctx, "Coke", svc.NewMoney(1.5, "USD")))
+	assert.NoError(t, s.UpdateQuantity(ctx, "Coke", 9))
+	assert.ErrorIs(t, s.UpdatePrice(ctx, "pepsi", svc.NewMoney(1.5, "USD")), svc.ErrNotFound)
+	assert.ErrorIs(t, s.UpdateQuantity(ctx, "pepsi", 9), svc.ErrNotFound)
+
+	slot, err := s.GetSlot(ctx, "coke")
+	if assert.NoError(t, err) {
+		assert.Equal(t, float32(1.5), *slot.Cost)
+		assert.Equal(t, 9, *slot.Quantity)
+	}
+}
+
+func TestSQLiteStorageFailuresAreReported(t *testing.T) {
+	s := newTestSQLiteStorage(t, ":memory:")
+	ctx := context.Background()
+	require.NoError(t, s.AddSlot(ctx, "coke", v1.VendingSlot{Quantity: new(int)}))
+	_, err := s.DB.Exec("DROP TABLE slots")
+	require.NoError(t, err)
+
+	_, err = s.GetSlots(ctx)
+	assert.ErrorIs(t, err, svc.ErrUnavailable, "a failing query is not an empty machine")
+	assert.ErrorIs(t, s.UpsertSlot(ctx, "coke", v1.VendingSlot{Quantity: new(int)}), svc.ErrUnavailable)
+	assert.ErrorIs(t, s.AddSlot(ctx, "pepsi", v1.VendingSlot{Quantity: new(int)}), svc.ErrUnavailable)
+	_, err = s.QuerySlots(ctx, svc.SlotQuery{})
+	assert.ErrorIs(t, err, svc.ErrUnavailable)
+}
+
+func TestSQLiteStoragePersistsAndMigratesOnce(t *testing.T) {
+	dsn := filepath.Join(t.TempDir(), "colaco.db")
+	s, err := NewSQLiteStorage(dsn)
+	require.NoError(t, err)
+	ctx := context.Background()
+	name := "Fizz"
+	require.NoError(t, s.AddSlot(ctx, name, v1.VendingSlot{OccupiedSoda: &v1.Soda{Name: &name}, Quantity: new(int)}))
+	require.NoError(t, s.Close())
+
+	reopened := newTestSQLiteStorage(t, dsn)
+	var applied int
+	require.NoError(t, reopened.DB.QueryRow("SELECT COUNT(*) FROM schema_migrations").Scan(&applied))
+	assert.Equal(t, 11, applied)
+
+	slot, err := reopened.GetSlot(ctx, "fizz")
+	if assert.NoError(t, err) {
+		assert.Equal(t, name, *slot.OccupiedSoda.Name)
+	}
+}
+
+func TestSQLiteStorageCashBoxSurvivesRestart(t *testing.T) {
+	dsn := filepath.Join(t.TempDir(), "colaco.db")
+	s, err := NewSQLiteStorage(dsn)
+	require.NoError(t, err)
+	ctx := context.Background()
+	_, err = s.UpdateCashBox(ctx, func(box *v1.CashBox) error {
+		box.Currency = "EUR"
+		return svc.AddCash(box, []v1.Denomination{{Value: 200, Count: 1}, {Value: 50, Count: 3}})
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+
+	box, err := newTestSQLiteStorage(t, dsn).GetCashBox(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", box.Currency)
+	assert.Equal(t, []v1.Denomination{{Value: 200, Count: 1}, {Value: 50, Count: 3}}, box.Denominations)
+	assert.Equal(t, v1.Money{Amount: 350, Currency: "EUR"}, *box.Total)
+}
+
+func TestSQLiteStorageVersionsSurviveRestart(t *testing.T) {
+	dsn := filepath.Join(t.TempDir(), "colaco.db")
+	s, err := NewSQLiteStorage(dsn)
+	require.NoError(t, err)
+	ctx := context.Background()
+	require.NoError(t, s.AddSlot(ctx, "coke", storagetest.NewSlot("Coke", 1, 10, 20)))
+	require.NoError(t, s.UpdateQuantity(ctx, "coke", 5))
+	deleted, err := s.GetSlot(ctx, "coke")
+	require.NoError(t, err)
+	require.NoError(t, s.DeleteSlot(ctx, "coke"))
+	require.NoError(t, s.Close())
+
+	reopened := newTestSQLiteStorage(t, dsn)
+	require.NoError(t, reopened.AddSlot(ctx, "coke", storagetest.NewSlot("Coke", 1, 10, 20)))
+	slot, err := reopened.GetSlot(ctx, "coke")
+	require.NoError(t, err)
+	assert.Greater(t, *slot.Version, *deleted.Version, "a recreated slot does not get back a version of the deleted one")
+}
+
+func TestSQLiteStorageConformance(t *testing.T) {
+	storagetest.RunStore(t, func(t *testing.T) svc.VendingStore {
+		return newTestSQLiteStorage(t, ":memory:")
+	})
+}
+
+func TestSQLiteStorageLegacyStorageConformance(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) svc.VendingStorageInterface {
+		return svc.NewLegacyStorage(newTestSQLiteStorage(t, ":memory:"))
+	})
+}
@@ -0,0 +1,145 @@
+package storagetest
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"colaco-api/svc"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// APIKeyStoreFactory returns a new, empty svc.APIKeyStore for a single
+// subtest.
+type APIKeyStoreFactory func(t *testing.T) svc.APIKeyStore
+
+// RunAPIKeyStore executes the svc.APIKeyStore checks against stores built by
+// newKeys: the sentinel errors for missing and duplicate keys, the ordering
+// of GetAPIKeys and atomic updates.
+func RunAPIKeyStore(t *testing.T, newKeys APIKeyStoreFactory) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, keys svc.APIKeyStore)
+	}{
+		{"RoundTrip", testAPIKeysRoundTrip},
+		{"NotFound", testAPIKeysNotFound},
+		{"Conflict", testAPIKeysConflict},
+		{"Ordering", testAPIKeysOrdering},
+		{"Update", testAPIKeysUpdate},
+		{"CancelledContext", testAPIKeysCancelledContext},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newKeys(t))
+		})
+	}
+}
+
+// NewAPIKeyRecord returns an API key with the ID id created at createdAt and
+// a placeholder hash, which is enough for a store that only keeps it.
+func NewAPIKeyRecord(id string, createdAt time.Time) svc.APIKeyRecord {
+	return svc.APIKeyRecord{
+		APIKey: v1.APIKey{
+			Id:        id,
+			Name:      "key " + id,
+			Scopes:    []string{svc.ScopeVendingRead},
+			CreatedAt: createdAt.UTC(),
+			CreatedBy: "admin",
+		},
+		KeyHash: "hash of " + id,
+	}
+}
+
+var keyEpoch = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func testAPIKeysRoundTrip(t *testing.T, keys svc.APIKeyStore) {
+	ctx := context.Background()
+	want := NewAPIKeyRecord("k1", keyEpoch)
+	expires := keyEpoch.Add(time.Hour)
+	want.ExpiresAt = &expires
+	require.NoError(t, keys.CreateAPIKey(ctx, want))
+	got, err := keys.GetAPIKey(ctx, "k1")
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func testAPIKeysNotFound(t *testing.T, keys svc.APIKeyStore) {
+	ctx := context.Background()
+	_, err := keys.GetAPIKey(ctx, "nope")
+	assert.ErrorIs(t, err, svc.ErrAPIKeyNotFound)
+	_, err = keys.UpdateAPIKey(ctx, "nope", func(*svc.APIKeyRecord) error { return nil })
+	assert.ErrorIs(t, err, svc.ErrAPIKeyNotFound)
+}
+
+func testAPIKeysConflict(t *testing.T, keys svc.APIKeyStore) {
+	ctx := context.Background()
+	require.NoError(t, keys.CreateAPIKey(ctx, NewAPIKeyRecord("k1", keyEpoch)))
+	other := NewAPIKeyRecord("k1", keyEpoch)
+	other.Name = "other"
+	assert.ErrorIs(t, keys.CreateAPIKey(ctx, other), svc.ErrAPIKeyExists)
+	got, err := keys.GetAPIKey(ctx, "k1")
+	require.NoError(t, err)
+	assert.Equal(t, "key k1", got.Name, "the existing key is kept")
+}
+
+func testAPIKeysOrdering(t *testing.T, keys svc.APIKeyStore) {
+	ctx := context.Background()
+	list, err := keys.GetAPIKeys(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+	require.NoError(t, keys.CreateAPIKey(ctx, NewAPIKeyRecord("c", keyEpoch)))
+	require.NoError(t, keys.CreateAPIKey(ctx, NewAPIKeyRecord("a", keyEpoch.Add(time.Minute))))
+	require.NoError(t, keys.CreateAPIKey(ctx, NewAPIKeyRecord("b", keyEpoch)))
+	list, err = keys.GetAPIKeys(ctx)
+	require.NoError(t, err)
+	var ids []string
+	for _, k := range list {
+		ids = append(ids, k.Id)
+	}
+	assert.Equal(t, []string{"b", "c", "a"}, ids, "oldest first, then by ID")
+}
+
+func testAPIKeysUpdate(t *testing.T, keys svc.APIKeyStore) {
+	ctx := context.Background()
+	require.NoError(t, keys.CreateAPIKey(ctx, NewAPIKeyRecord("k1", keyEpoch)))
+	revokedAt := keyEpoch.Add(time.Hour)
+
+	failure := errors.New("rejected")
+	_, err := keys.UpdateAPIKey(ctx, "k1", func(key *svc.APIKeyRecord) error {
+		key.RevokedAt = &revokedAt
+		return failure
+	})
+	assert.ErrorIs(t, err, failure)
+	got, err := keys.GetAPIKey(ctx, "k1")
+	require.NoError(t, err)
+	assert.Nil(t, got.RevokedAt, "a failed update writes nothing")
+
+	updated, err := keys.UpdateAPIKey(ctx, "k1", func(key *svc.APIKeyRecord) error {
+		key.Id = "k2"
+		key.KeyHash = "another hash"
+		key.RevokedAt = &revokedAt
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "k1", updated.Id, "the ID cannot be changed")
+	assert.Equal(t, "hash of k1", updated.KeyHash, "the hash cannot be changed")
+	require.NotNil(t, updated.RevokedAt)
+	assert.True(t, revokedAt.Equal(*updated.RevokedAt))
+	got, err = keys.GetAPIKey(ctx, "k1")
+	require.NoError(t, err)
+	assert.Equal(t, updated, got)
+	_, err = keys.GetAPIKey(ctx, "k2")
+	assert.ErrorIs(t, err, svc.ErrAPIKeyNotFound)
+}
+
+func testAPIKeysCancelledContext(t *testing.T, keys svc.APIKeyStore) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := keys.GetAPIKeys(ctx)
+	assert.ErrorIs(t, err, svc.ErrUnavailable)
+	err = keys.CreateAPIKey(ctx, NewAPIKeyRecord("k1", keyEpoch))
+	assert.ErrorIs(t, err, svc.ErrUnavailable)
+}
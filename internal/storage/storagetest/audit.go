@@ -0,0 +1,104 @@
+package storagetest
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"colaco-api/svc"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// AuditStoreFactory returns a new, empty svc.AuditStore for a single subtest.
+type AuditStoreFactory func(t *testing.T) svc.AuditStore
+
+// RunAuditStore executes the svc.AuditStore checks against stores built by
+// newAudit: the chaining of appended records, paging through them and
+// cancelled contexts.
+func RunAuditStore(t *testing.T, newAudit AuditStoreFactory) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, audit svc.AuditStore)
+	}{
+		{"Chain", testAuditChain},
+		{"Paging", testAuditPaging},
+		{"CancelledContext", testAuditCancelledContext},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newAudit(t))
+		})
+	}
+}
+
+// NewAuditRecord returns an unchained record of a change to the slot called
+// target by subject.
+func NewAuditRecord(subject, target string) v1.AuditRecord {
+	rec := svc.NewAuditRecord("updatePrice", target,
+		v1.VendingSlot{Id: &target, Cost: f32p(1)}, v1.VendingSlot{Id: &target, Cost: f32p(1.5)})
+	rec.Subject, rec.SourceIp, rec.RequestId = subject, "192.0.2.1", "req-"+target
+	return rec
+}
+
+func f32p(f float32) *float32 {
+	return &f
+}
+
+// appendAuditRecords appends n records and returns them as stored.
+func appendAuditRecords(t *testing.T, audit svc.AuditStore, n int) []v1.AuditRecord {
+	t.Helper()
+	var stored []v1.AuditRecord
+	for i := 0; i < n; i++ {
+		rec, err := audit.AppendAuditRecord(context.Background(), NewAuditRecord("admin", fmt.Sprintf("A%d", i+1)))
+		require.NoError(t, err)
+		stored = append(stored, rec)
+	}
+	return stored
+}
+
+func testAuditChain(t *testing.T, audit svc.AuditStore) {
+	stored := appendAuditRecords(t, audit, 3)
+	for i, rec := range stored {
+		assert.Equal(t, int64(i+1), rec.Seq)
+		assert.Equal(t, svc.AuditRecordHash(rec), rec.Hash)
+		if i > 0 {
+			assert.Equal(t, stored[i-1].Hash, rec.PrevHash)
+		}
+	}
+	assert.Empty(t, stored[0].PrevHash)
+	all, err := audit.GetAuditRecords(context.Background(), 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, stored, all)
+	assert.NoError(t, svc.VerifyAuditTrail(all))
+}
+
+func testAuditPaging(t *testing.T, audit svc.AuditStore) {
+	ctx := context.Background()
+	empty, err := audit.GetAuditRecords(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+	stored := appendAuditRecords(t, audit, 5)
+	page, err := audit.GetAuditRecords(ctx, 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, stored[:2], page)
+	page, err = audit.GetAuditRecords(ctx, 2, 10)
+	require.NoError(t, err)
+	assert.Equal(t, stored[2:], page)
+	page, err = audit.GetAuditRecords(ctx, 5, 10)
+	require.NoError(t, err)
+	assert.Empty(t, page)
+	page, err = audit.GetAuditRecords(ctx, 50, 10)
+	require.NoError(t, err)
+	assert.Empty(t, page)
+}
+
+func testAuditCancelledContext(t *testing.T, audit svc.AuditStore) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := audit.AppendAuditRecord(ctx, NewAuditRecord("admin", "A1"))
+	assert.ErrorIs(t, err, svc.ErrUnavailable)
+	_, err = audit.GetAuditRecords(ctx, 0, 0)
+	assert.ErrorIs(t, err, svc.ErrUnavailable)
+}
@@ -0,0 +1,383 @@
+// Package storagetest is a conformance suite for implementations of
+// svc.VendingStorageInterface. A backend proves it behaves like the bundled
+// ones by calling Run from one of its own tests:
+//
+//	func TestConformance(t *testing.T) {
+//		storagetest.Run(t, func(t *testing.T) svc.VendingStorageInterface {
+//			return NewMyStorage()
+//		})
+//	}
+//
+// The concurrency checks are most useful when the tests run with -race. The
+// soda catalog checks are skipped for backends that do not implement
+// svc.SodaCatalog.
+package storagetest
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"colaco-api/svc"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Factory returns a new, empty storage for a single subtest. Any cleanup
+// should be registered with t.Cleanup.
+type Factory func(t *testing.T) svc.VendingStorageInterface
+
+// Run executes every conformance check against storages built by newStorage.
+func Run(t *testing.T, newStorage Factory) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, s svc.VendingStorageInterface)
+	}{
+		{"GetSlotNotFound", testGetSlotNotFound},
+		{"AddAndGetSlot", testAddAndGetSlot},
+		{"UpsertReplacesSlot", testUpsertReplacesSlot},
+		{"CaseInsensitiveNames", testCaseInsensitiveNames},
+		{"GetSlotsEmpty", testGetSlotsEmpty},
+		{"GetSlotsOrdering", testGetSlotsOrdering},
+		{"DeleteSlot", testDeleteSlot},
+		{"DeleteSlotNotFound", testDeleteSlotNotFound},
+		{"UpdatePrice", testUpdatePrice},
+		{"UpdatePriceNotFound", testUpdatePriceNotFound},
+		{"UpdateQuantity", testUpdateQuantity},
+		{"UpdateQuantityNotFound", testUpdateQuantityNotFound},
+		{"ReturnedSlotsAreCopies", testReturnedSlotsAreCopies},
+		{"ConcurrentReadersAndWriters", testConcurrentReadersAndWriters},
+		{"VersionsIncrease", testVersionsIncrease},
+		{"SlotIDs", testSlotIDs},
+		{"SlotPositions", testSlotPositions},
+		{"SodaCatalogSharedBySlots", testSodaCatalogSharedBySlots},
+		{"SodaCatalogOutlivesSlots", testSodaCatalogOutlivesSlots},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newStorage(t))
+		})
+	}
+}
+
+// NewSlot returns a fully populated slot with the ID name holding a soda
+// called name, whose catalog ID is derived from the name. cost is in
+// dollars and sets both the price and the deprecated cost.
+func NewSlot(name string, cost float32, quantity, maxQuantity int) v1.VendingSlot {
+	id := svc.Slug(name)
+	calories := 150
+	description := name + " description"
+	origin := name + " origin story"
+	ounces := float32(12)
+	slot := v1.VendingSlot{
+		Id:          &name,
+		MaxQuantity: &maxQuantity,
+		Quantity:    &quantity,
+		OccupiedSoda: &v1.Soda{
+			Calories:    &calories,
+			Description: &description,
+			Id:          &id,
+			Name:        &name,
+			OriginStory: &origin,
+			Ounces:      &ounces,
+		},
+	}
+	svc.SetPrice(&slot, svc.NewMoney(cost, "USD"))
+	return slot
+}
+
+// unversioned returns slot without its storage-managed version, so a slot
+// read back can be compared with the one that was written.
+func unversioned(slot v1.VendingSlot) v1.VendingSlot {
+	slot.Version = nil
+	return slot
+}
+
+func testGetSlotNotFound(t *testing.T, s svc.VendingStorageInterface) {
+	_, found, err := s.GetSlot("missing")
+	assert.NoError(t, err)
+	assert.False(t, found)
+}
+
+func testAddAndGetSlot(t *testing.T, s svc.VendingStorageInterface) {
+	slot := NewSlot("Coke", 1.25, 10, 20)
+	s.AddSlot("Coke", slot)
+
+	got, found, err := s.GetSlot("Coke")
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, slot, unversioned(got))
+}
+
+func testUpsertReplacesSlot(t *testing.T, s svc.VendingStorageInterface) {
+	s.UpsertSlot("coke", NewSlot("Coke", 1.25, 10, 20))
+	replacement := NewSlot("Coke", 2.00, 5, 30)
+	s.UpsertSlot("coke", replacement)
+
+	got, found, err := s.GetSlot("coke")
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, replacement, unversioned(got))
+	assert.Len(t, s.GetSlots(), 1)
+}
+
+func testCaseInsensitiveNames(t *testing.T, s svc.VendingStorageInterface) {
+	s.AddSlot("Mega Pop", NewSlot("Mega Pop", 1, 10, 20))
+
+	_, found, err := s.GetSlot("MEGA POP")
+	require.NoError(t, err)
+	assert.True(t, found)
+
+	require.NoError(t, s.UpdatePrice("mega pop", 3))
+	require.NoError(t, s.UpdateQuantity("mEgA pOp", 4))
+	s.UpsertSlot("MEGA pop", NewSlot("Mega Pop", 3, 4, 25))
+	assert.Len(t, s.GetSlots(), 1, "differently cased names must address the same slot")
+
+	deleted, err := s.DeleteSlot("Mega POP")
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	_, found, _ = s.GetSlot("mega pop")
+	assert.False(t, found)
+}
+
+func testGetSlotsEmpty(t *testing.T, s svc.VendingStorageInterface) {
+	assert.Empty(t, s.GetSlots())
+}
+
+func testGetSlotsOrdering(t *testing.T, s svc.VendingStorageInterface) {
+	for _, name := range []string{"pop", "Cola", "fizz", "Mega Pop"} {
+		s.AddSlot(name, NewSlot(name, 1, 1, 1))
+	}
+	want := []string{"Cola", "fizz", "Mega Pop", "pop"}
+	for i := 0; i < 3; i++ {
+		var got []string
+		for _, slot := range s.GetSlots() {
+			got = append(got, *slot.OccupiedSoda.Name)
+		}
+		assert.Equal(t, want, got, "slots should be ordered by lower-cased name")
+	}
+}
+
+func testDeleteSlot(t *testing.T, s svc.VendingStorageInterface) {
+	s.AddSlot("coke", NewSlot("Coke", 1, 1, 1))
+	s.AddSlot("pepsi", NewSlot("Pepsi", 1, 1, 1))
+
+	deleted, err := s.DeleteSlot("coke")
+	require.NoError(t, err)
+	assert.True(t, deleted)
+
+	_, found, _ := s.GetSlot("coke")
+	assert.False(t, found)
+	assert.Len(t, s.GetSlots(), 1)
+}
+
+func testDeleteSlotNotFound(t *testing.T, s svc.VendingStorageInterface) {
+	deleted, err := s.DeleteSlot("missing")
+	assert.NoError(t, err)
+	assert.False(t, deleted)
+}
+
+func testUpdatePrice(t *testing.T, s svc.VendingStorageInterface) {
+	s.AddSlot("coke", NewSlot("Coke", 1, 10, 20))
+	require.NoError(t, s.UpdatePrice("coke", 1.75))
+
+	got, _, _ := s.GetSlot("coke")
+	assert.Equal(t, float32(1.75), *got.Cost)
+	if assert.NotNil(t, got.Price, "the float price must also set the exact price") {
+		assert.Equal(t, v1.Money{Amount: 175, Currency: "USD"}, *got.Price)
+	}
+	assert.Equal(t, 10, *got.Quantity, "updating the price must not touch the quantity")
+}
+
+func testUpdatePriceNotFound(t *testing.T, s svc.VendingStorageInterface) {
+	assert.Error(t, s.UpdatePrice("missing", 1))
+	assert.Empty(t, s.GetSlots())
+}
+
+func testUpdateQuantity(t *testing.T, s svc.VendingStorageInterface) {
+	s.AddSlot("coke", NewSlot("Coke", 1.5, 10, 20))
+	require.NoError(t, s.UpdateQuantity("coke", 3))
+
+	got, _, _ := s.GetSlot("coke")
+	assert.Equal(t, 3, *got.Quantity)
+	assert.Equal(t, float32(1.5), *got.Cost, "updating the quantity must not touch the price")
+}
+
+func testUpdateQuantityNotFound(t *testing.T, s svc.VendingStorageInterface) {
+	assert.Error(t, s.UpdateQuantity("missing", 1))
+	assert.Empty(t, s.GetSlots())
+}
+
+func testReturnedSlotsAreCopies(t *testing.T, s svc.VendingStorageInterface) {
+	slot := NewSlot("Coke", 1, 10, 20)
+	s.AddSlot("coke", slot)
+	*slot.Quantity = 99
+
+	got, _, _ := s.GetSlot("coke")
+	require.Equal(t, 10, *got.Quantity, "mutating a slot after writing it must not change storage")
+	*got.Quantity = 42
+	*got.OccupiedSoda.Name = "Pepsi"
+	for _, listed := range s.GetSlots() {
+		*listed.Quantity = 42
+	}
+
+	again, _, _ := s.GetSlot("coke")
+	assert.Equal(t, 10, *again.Quantity, "mutating a returned slot must not change storage")
+	assert.Equal(t, "Coke", *again.OccupiedSoda.Name)
+}
+
+func testConcurrentReadersAndWriters(t *testing.T, s svc.VendingStorageInterface) {
+	const workers = 8
+	const iterations = 25
+	for i := 0; i < workers; i++ {
+		s.AddSlot(fmt.Sprintf("soda-%d", i), NewSlot(fmt.Sprintf("soda-%d", i), 1, 0, 100))
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		name := fmt.Sprintf("soda-%d", i)
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 1; j <= iterations; j++ {
+				assert.NoError(t, s.UpdateQuantity(name, j))
+				assert.NoError(t, s.UpdatePrice(name, float32(j)))
+				s.UpsertSlot("shared", NewSlot("shared", float32(j), j, 100))
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < iterations; j++ {
+				if slot, found, err := s.GetSlot(name); assert.NoError(t, err) && assert.True(t, found) {
+					assert.Equal(t, name, *slot.OccupiedSoda.Name)
+				}
+				for _, slot := range s.GetSlots() {
+					assert.NotNil(t, slot.Quantity)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i := 0; i < workers; i++ {
+		slot, _, _ := s.GetSlot(fmt.Sprintf("soda-%d", i))
+		assert.Equal(t, iterations, *slot.Quantity)
+		assert.Equal(t, float32(iterations), *slot.Cost)
+	}
+	assert.Len(t, s.GetSlots(), workers+1)
+}
+
+func testVersionsIncrease(t *testing.T, s svc.VendingStorageInterface) {
+	version := func() int64 {
+		slot, found, err := s.GetSlot("coke")
+		require.NoError(t, err)
+		require.True(t, found)
+		require.NotNil(t, slot.Version, "slots must carry a version")
+		return *slot.Version
+	}
+
+	s.AddSlot("coke", NewSlot("Coke", 1, 10, 20))
+	first := version()
+	assert.Positive(t, first, "a new slot has a version")
+
+	stale := NewSlot("Coke", 2, 10, 20)
+	stale.Version = new(int64)
+	s.UpsertSlot("coke", stale)
+	assert.Equal(t, first+1, version(), "versions are assigned by the storage, not the caller")
+
+	require.NoError(t, s.UpdatePrice("coke", 3))
+	assert.Equal(t, first+2, version())
+	require.NoError(t, s.UpdateQuantity("coke", 4))
+	assert.Equal(t, first+3, version())
+
+	s.DeleteSlot("coke")
+	s.AddSlot("coke", NewSlot("Coke", 1, 10, 20))
+	assert.Greater(t, version(), first+3, "a recreated slot does not get back a version of the deleted one")
+}
+
+func testSlotIDs(t *testing.T, s svc.VendingStorageInterface) {
+	s.AddSlot("A1", NewSlot("A1", 1, 1, 1))
+	got, found, err := s.GetSlot("a1")
+	require.NoError(t, err)
+	require.True(t, found)
+	if assert.NotNil(t, got.Id) {
+		assert.Equal(t, "A1", *got.Id, "the slot ID must be kept as given")
+	}
+}
+
+func testSlotPositions(t *testing.T, s svc.VendingStorageInterface) {
+	placed := NewSlot("B3", 1, 2, 8)
+	placed.Position = &v1.SlotPosition{Row: "B", Column: 3}
+	id, capacity := "B4", 8
+	empty := v1.VendingSlot{Id: &id, MaxQuantity: &capacity, Position: &v1.SlotPosition{Row: "B", Column: 4}}
+	s.AddSlot("B3", placed)
+	s.AddSlot("B4", empty)
+
+	got, found, err := s.GetSlot("b3")
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, placed.Position, got.Position)
+
+	got, found, err = s.GetSlot("b4")
+	require.NoError(t, err)
+	require.True(t, found, "a slot without a soda is an empty coil and must be kept")
+	assert.Nil(t, got.OccupiedSoda)
+	assert.Equal(t, empty.Position, got.Position)
+	assert.Equal(t, 8, *got.MaxQuantity)
+}
+
+// catalog returns s as a svc.SodaCatalog or skips the test.
+func catalog(t *testing.T, s svc.VendingStorageInterface) svc.SodaCatalog {
+	c, ok := s.(svc.SodaCatalog)
+	if !ok {
+		t.Skip("storage does not implement svc.SodaCatalog")
+	}
+	return c
+}
+
+func testSodaCatalogSharedBySlots(t *testing.T, s svc.VendingStorageInterface) {
+	c := catalog(t, s)
+	s.AddSlot("a1", NewSlot("Cola", 1, 5, 10))
+	s.AddSlot("a2", NewSlot("Cola", 1.25, 3, 10))
+	s.AddSlot("b1", NewSlot("Fizz", 1, 1, 10))
+
+	sodas := c.GetSodas()
+	var ids []string
+	for _, soda := range sodas {
+		ids = append(ids, *soda.Id)
+	}
+	assert.Equal(t, []string{"cola", "fizz"}, ids, "a soda in two slots is one catalog entry")
+
+	renamed := NewSlot("Cola", 1.25, 3, 10)
+	*renamed.OccupiedSoda.Description = "new recipe"
+	s.UpsertSlot("a2", renamed)
+	for _, name := range []string{"a1", "a2"} {
+		slot, _, err := s.GetSlot(name)
+		require.NoError(t, err)
+		assert.Equal(t, "new recipe", *slot.OccupiedSoda.Description,
+			"every slot must read the catalog's current definition")
+	}
+	slot, _, _ := s.GetSlot("a1")
+	assert.Equal(t, 5, *slot.Quantity, "slots holding the same soda keep their own stock")
+
+	soda, found, err := c.GetSoda("COLA")
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, "new recipe", *soda.Description)
+	_, found, err = c.GetSoda("missing")
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func testSodaCatalogOutlivesSlots(t *testing.T, s svc.VendingStorageInterface) {
+	c := catalog(t, s)
+	s.AddSlot("a1", NewSlot("Cola", 1, 5, 10))
+	_, err := s.DeleteSlot("a1")
+	require.NoError(t, err)
+
+	_, found, err := c.GetSoda("cola")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Len(t, c.GetSodas(), 1)
+}
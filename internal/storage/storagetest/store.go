@@ -0,0 +1,722 @@
+package storagetest
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"colaco-api/svc"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// StoreFactory returns a new, empty svc.VendingStore for a single subtest.
+type StoreFactory func(t *testing.T) svc.VendingStore
+
+// RunStore executes the svc.VendingStore checks against stores built by
+// newStore: the sentinel errors for missing and duplicate slots, the
+// handling of cancelled contexts, the atomic purchase primitive and the
+// versioned read-modify-write operations, replacing every slot, the naming,
+// ordering and copy semantics of slots, slot versions, IDs and positions,
+// the soda catalog, the cash box, the transaction ledger and inventory
+// queries.
+func RunStore(t *testing.T, newStore StoreFactory) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, s svc.VendingStore)
+	}{
+		{"RoundTrip", testStoreRoundTrip},
+		{"NotFound", testStoreNotFound},
+		{"AddConflict", testStoreAddConflict},
+		{"CancelledContext", testStoreCancelledContext},
+		{"DecrementIfAvailable", testStoreDecrementIfAvailable},
+		{"DecrementRejections", testStoreDecrementRejections},
+		{"ConcurrentDecrementsDoNotOversell", testStoreConcurrentDecrements},
+		{"UpdateSlot", testStoreUpdateSlot},
+		{"DeleteSlotIf", testStoreDeleteSlotIf},
+		{"ReplaceSlots", testStoreReplaceSlots},
+		{"ConcurrentUpdatesKeepVersionsUnique", testStoreConcurrentUpdates},
+		{"UpsertReplacesSlot", testStoreUpsertReplacesSlot},
+		{"CaseInsensitiveNames", testStoreCaseInsensitiveNames},
+		{"GetSlotsOrdering", testStoreGetSlotsOrdering},
+		{"ReturnedSlotsAreCopies", testStoreReturnedSlotsAreCopies},
+		{"VersionsIncrease", testStoreVersionsIncrease},
+		{"SlotIDs", testStoreSlotIDs},
+		{"SlotPositions", testStoreSlotPositions},
+		{"SodaCatalog", testStoreSodaCatalog},
+		{"SodaCatalogSharedBySlots", testStoreSodaCatalogSharedBySlots},
+		{"CashBox", testStoreCashBox},
+		{"Ledger", testStoreLedger},
+		{"DayCloses", testStoreDayCloses},
+		{"QuerySlots", testStoreQuerySlots},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newStore(t))
+		})
+	}
+}
+
+// usd returns amount dollars as Money.
+func usd(amount float32) v1.Money {
+	return svc.NewMoney(amount, "USD")
+}
+
+func testStoreRoundTrip(t *testing.T, s svc.VendingStore) {
+	ctx := context.Background()
+	require.NoError(t, s.AddSlot(ctx, "Coke", NewSlot("Coke", 1, 10, 20)))
+	require.NoError(t, s.UpdatePrice(ctx, "coke", usd(2)))
+	require.NoError(t, s.UpdateQuantity(ctx, "COKE", 5))
+
+	slot, err := s.GetSlot(ctx, "coke")
+	require.NoError(t, err)
+	assert.Equal(t, usd(2), *slot.Price)
+	assert.Equal(t, float32(2), *slot.Cost, "the deprecated cost follows the price")
+	assert.Equal(t, 5, *slot.Quantity)
+
+	require.NoError(t, s.UpsertSlot(ctx, "pepsi", NewSlot("Pepsi", 1, 1, 1)))
+	slots, err := s.GetSlots(ctx)
+	require.NoError(t, err)
+	assert.Len(t, slots, 2)
+
+	require.NoError(t, s.DeleteSlot(ctx, "coke"))
+	_, err = s.GetSlot(ctx, "coke")
+	assert.ErrorIs(t, err, svc.ErrNotFound)
+}
+
+func testStoreNotFound(t *testing.T, s svc.VendingStore) {
+	ctx := context.Background()
+	_, err := s.GetSlot(ctx, "missing")
+	assert.ErrorIs(t, err, svc.ErrNotFound)
+	assert.ErrorIs(t, s.DeleteSlot(ctx, "missing"), svc.ErrNotFound)
+	assert.ErrorIs(t, s.UpdatePrice(ctx, "missing", usd(1)), svc.ErrNotFound)
+	assert.ErrorIs(t, s.UpdateQuantity(ctx, "missing", 1), svc.ErrNotFound)
+}
+
+func testStoreAddConflict(t *testing.T, s svc.VendingStore) {
+	ctx := context.Background()
+	require.NoError(t, s.AddSlot(ctx, "Coke", NewSlot("Coke", 1, 10, 20)))
+	err := s.AddSlot(ctx, "COKE", NewSlot("Coke", 5, 0, 20))
+	assert.ErrorIs(t, err, svc.ErrConflict)
+
+	slot, err := s.GetSlot(ctx, "coke")
+	require.NoError(t, err)
+	assert.Equal(t, float32(1), *slot.Cost, "a conflicting add must not overwrite the slot")
+}
+
+func testStoreCancelledContext(t *testing.T, s svc.VendingStore) {
+	require.NoError(t, s.AddSlot(context.Background(), "coke", NewSlot("Coke", 1, 10, 20)))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := s.GetSlot(ctx, "coke")
+	assert.ErrorIs(t, err, svc.ErrUnavailable)
+	assert.ErrorIs(t, err, context.Canceled)
+	_, err = s.GetSlots(ctx)
+	assert.ErrorIs(t, err, svc.ErrUnavailable)
+	assert.ErrorIs(t, s.AddSlot(ctx, "pepsi", NewSlot("Pepsi", 1, 1, 1)), svc.ErrUnavailable)
+	assert.ErrorIs(t, s.UpsertSlot(ctx, "coke", NewSlot("Coke", 9, 9, 9)), svc.ErrUnavailable)
+	assert.ErrorIs(t, s.UpdatePrice(ctx, "coke", usd(9)), svc.ErrUnavailable)
+	assert.ErrorIs(t, s.UpdateQuantity(ctx, "coke", 9), svc.ErrUnavailable)
+	assert.ErrorIs(t, s.DeleteSlot(ctx, "coke"), svc.ErrUnavailable)
+
+	slot, err := s.GetSlot(context.Background(), "coke")
+	require.NoError(t, err)
+	assert.Equal(t, float32(1), *slot.Cost, "cancelled writes must not be applied")
+}
+
+func testStoreDecrementIfAvailable(t *testing.T, s svc.VendingStore) {
+	ctx := context.Background()
+	require.NoError(t, s.AddSlot(ctx, "coke", NewSlot("Coke", 1.5, 2, 20)))
+
+	slot, err := s.DecrementIfAvailable(ctx, "COKE", usd(2))
+	require.NoError(t, err)
+	assert.Equal(t, 1, *slot.Quantity)
+	slot, err = s.DecrementIfAvailable(ctx, "coke", usd(1.5))
+	require.NoError(t, err)
+	assert.Equal(t, 0, *slot.Quantity)
+
+	stored, err := s.GetSlot(ctx, "coke")
+	require.NoError(t, err)
+	assert.Equal(t, 0, *stored.Quantity)
+}
+
+func testStoreDecrementRejections(t *testing.T, s svc.VendingStore) {
+	ctx := context.Background()
+	require.NoError(t, s.AddSlot(ctx, "coke", NewSlot("Coke", 1.5, 1, 20)))
+	require.NoError(t, s.AddSlot(ctx, "empty", NewSlot("Empty", 1, 0, 20)))
+
+	_, err := s.DecrementIfAvailable(ctx, "missing", usd(5))
+	assert.ErrorIs(t, err, svc.ErrNotFound)
+
+	slot, err := s.DecrementIfAvailable(ctx, "coke", usd(1))
+	assert.ErrorIs(t, err, svc.ErrInsufficientFunds)
+	if assert.NotNil(t, slot.Cost, "the slot should be returned with the error") {
+		assert.Equal(t, float32(1.5), *slot.Cost)
+	}
+
+	_, err = s.DecrementIfAvailable(ctx, "coke", v1.Money{Amount: 500, Currency: "EUR"})
+	assert.ErrorIs(t, err, svc.ErrCurrencyMismatch)
+
+	_, err = s.DecrementIfAvailable(ctx, "empty", usd(5))
+	assert.ErrorIs(t, err, svc.ErrSoldOut)
+
+	stored, err := s.GetSlot(ctx, "coke")
+	require.NoError(t, err)
+	assert.Equal(t, 1, *stored.Quantity, "rejected purchases must not change the stock")
+}
+
+func testStoreConcurrentDecrements(t *testing.T, s svc.VendingStore) {
+	const stock = 5
+	const buyers = 20
+	ctx := context.Background()
+	require.NoError(t, s.AddSlot(ctx, "coke", NewSlot("Coke", 1, stock, 20)))
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	sold, soldOut := 0, 0
+	for i := 0; i < buyers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.DecrementIfAvailable(ctx, "coke", usd(1))
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				sold++
+			case errors.Is(err, svc.ErrSoldOut):
+				soldOut++
+			default:
+				t.Errorf("unexpected purchase error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, stock, sold)
+	assert.Equal(t, buyers-stock, soldOut)
+	stored, err := s.GetSlot(ctx, "coke")
+	require.NoError(t, err)
+	assert.Equal(t, 0, *stored.Quantity)
+}
+
+func testStoreUpdateSlot(t *testing.T, s svc.VendingStore) {
+	ctx := context.Background()
+	require.NoError(t, s.AddSlot(ctx, "coke", NewSlot("Coke", 1, 10, 20)))
+	stored, err := s.GetSlot(ctx, "coke")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), svc.SlotVersion(stored))
+
+	updated, err := s.UpdateSlot(ctx, "COKE", func(slot *v1.VendingSlot) error {
+		assert.Equal(t, int64(1), svc.SlotVersion(*slot), "fn should see the current version")
+		price := float32(2)
+		slot.Cost = &price
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, float32(2), *updated.Cost)
+	assert.Equal(t, int64(2), svc.SlotVersion(updated))
+
+	_, err = s.UpdateSlot(ctx, "coke", func(slot *v1.VendingSlot) error {
+		price := float32(9)
+		slot.Cost = &price
+		return svc.ErrVersionMismatch
+	})
+	assert.ErrorIs(t, err, svc.ErrVersionMismatch)
+	stored, err = s.GetSlot(ctx, "coke")
+	require.NoError(t, err)
+	assert.Equal(t, float32(2), *stored.Cost, "a rejected update must not be written")
+	assert.Equal(t, int64(2), svc.SlotVersion(stored))
+
+	_, err = s.UpdateSlot(ctx, "missing", func(*v1.VendingSlot) error { return nil })
+	assert.ErrorIs(t, err, svc.ErrNotFound)
+}
+
+func testStoreDeleteSlotIf(t *testing.T, s svc.VendingStore) {
+	ctx := context.Background()
+	require.NoError(t, s.AddSlot(ctx, "coke", NewSlot("Coke", 1, 10, 20)))
+
+	_, err := s.DeleteSlotIf(ctx, "coke", func(v1.VendingSlot) error { return svc.ErrVersionMismatch })
+	assert.ErrorIs(t, err, svc.ErrVersionMismatch)
+	_, err = s.GetSlot(ctx, "coke")
+	require.NoError(t, err, "a rejected delete must keep the slot")
+
+	deleted, err := s.DeleteSlotIf(ctx, "COKE", func(slot v1.VendingSlot) error {
+		assert.Equal(t, int64(1), svc.SlotVersion(slot))
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Coke", *deleted.OccupiedSoda.Name)
+	_, err = s.GetSlot(ctx, "coke")
+	assert.ErrorIs(t, err, svc.ErrNotFound)
+
+	_, err = s.DeleteSlotIf(ctx, "coke", func(v1.VendingSlot) error { return nil })
+	assert.ErrorIs(t, err, svc.ErrNotFound)
+}
+
+func testStoreReplaceSlots(t *testing.T, s svc.VendingStore) {
+	ctx := context.Background()
+	require.NoError(t, s.AddSlot(ctx, "A1", NewSlot("Coke", 1, 10, 20)))
+	require.NoError(t, s.AddSlot(ctx, "A2", NewSlot("Fizz", 1, 5, 20)))
+
+	_, err := s.ReplaceSlots(ctx, func([]v1.VendingSlot) ([]v1.VendingSlot, error) {
+		return nil, svc.ErrVersionMismatch
+	})
+	assert.ErrorIs(t, err, svc.ErrVersionMismatch)
+	slots, err := s.GetSlots(ctx)
+	require.NoError(t, err)
+	assert.Len(t, slots, 2, "a rejected replace must not be written")
+
+	b1 := NewSlot("Pop", 2, 3, 6)
+	b1.Id = nil
+	var a2Version, lastVersion int64
+	slots, err = s.ReplaceSlots(ctx, func(current []v1.VendingSlot) ([]v1.VendingSlot, error) {
+		require.Len(t, current, 2)
+		a2 := current[1]
+		a2Version = svc.SlotVersion(a2)
+		lastVersion = max(svc.SlotVersion(current[0]), a2Version)
+		qty := 7
+		a2.Quantity = &qty
+		id := "B1"
+		b1.Id = &id
+		return []v1.VendingSlot{a2, b1}, nil
+	})
+	require.NoError(t, err)
+	require.Len(t, slots, 2)
+	assert.Equal(t, "A2", svc.SlotID(slots[0]))
+	assert.Equal(t, 7, *slots[0].Quantity)
+	assert.Equal(t, a2Version+1, svc.SlotVersion(slots[0]))
+	assert.Equal(t, "B1", svc.SlotID(slots[1]))
+	assert.Greater(t, svc.SlotVersion(slots[1]), lastVersion, "a new slot gets a version not handed out before")
+	assert.Equal(t, usd(2), *slots[1].Price)
+
+	_, err = s.GetSlot(ctx, "A1")
+	assert.ErrorIs(t, err, svc.ErrNotFound, "slots left out are deleted")
+	stored, err := s.GetSlot(ctx, "b1")
+	require.NoError(t, err)
+	assert.Equal(t, "Pop", *stored.OccupiedSoda.Name)
+}
+
+func testStoreConcurrentUpdates(t *testing.T, s svc.VendingStore) {
+	const writers = 10
+	ctx := context.Background()
+	require.NoError(t, s.AddSlot(ctx, "coke", NewSlot("Coke", 1, 0, 20)))
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	seen := map[int64]bool{}
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			slot, err := s.UpdateSlot(ctx, "coke", func(slot *v1.VendingSlot) error {
+				qty := *slot.Quantity + 1
+				slot.Quantity = &qty
+				return nil
+			})
+			if !assert.NoError(t, err) {
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			assert.False(t, seen[svc.SlotVersion(slot)], "two updates were given the same version")
+			seen[svc.SlotVersion(slot)] = true
+		}()
+	}
+	wg.Wait()
+
+	stored, err := s.GetSlot(ctx, "coke")
+	require.NoError(t, err)
+	assert.Equal(t, writers, *stored.Quantity, "no update may be lost")
+	assert.Equal(t, int64(writers+1), svc.SlotVersion(stored))
+}
+
+func testStoreSlotIDs(t *testing.T, s svc.VendingStore) {
+	ctx := context.Background()
+	slot := NewSlot("Cola", 1, 1, 1)
+	slot.Id = nil
+	require.NoError(t, s.AddSlot(ctx, "B3", slot))
+
+	got, err := s.GetSlot(ctx, "b3")
+	require.NoError(t, err)
+	if assert.NotNil(t, got.Id) {
+		assert.Equal(t, "B3", *got.Id, "slots are identified by the name they were written under")
+	}
+}
+
+func testStoreUpsertReplacesSlot(t *testing.T, s svc.VendingStore) {
+	ctx := context.Background()
+	require.NoError(t, s.UpsertSlot(ctx, "Coke", NewSlot("Coke", 1.25, 10, 20)))
+	replacement := NewSlot("Coke", 2.00, 5, 30)
+	require.NoError(t, s.UpsertSlot(ctx, "Coke", replacement))
+
+	got, err := s.GetSlot(ctx, "coke")
+	require.NoError(t, err)
+	assert.Equal(t, replacement, unversioned(got))
+	slots, err := s.GetSlots(ctx)
+	require.NoError(t, err)
+	assert.Len(t, slots, 1)
+}
+
+func testStoreCaseInsensitiveNames(t *testing.T, s svc.VendingStore) {
+	ctx := context.Background()
+	require.NoError(t, s.AddSlot(ctx, "Mega Pop", NewSlot("Mega Pop", 1, 10, 20)))
+
+	_, err := s.GetSlot(ctx, "MEGA POP")
+	require.NoError(t, err)
+	require.NoError(t, s.UpdatePrice(ctx, "mega pop", usd(3)))
+	require.NoError(t, s.UpdateQuantity(ctx, "mEgA pOp", 4))
+	require.NoError(t, s.UpsertSlot(ctx, "MEGA pop", NewSlot("Mega Pop", 3, 4, 25)))
+	slots, err := s.GetSlots(ctx)
+	require.NoError(t, err)
+	assert.Len(t, slots, 1, "differently cased names must address the same slot")
+
+	require.NoError(t, s.DeleteSlot(ctx, "Mega POP"))
+	_, err = s.GetSlot(ctx, "mega pop")
+	assert.ErrorIs(t, err, svc.ErrNotFound)
+}
+
+func testStoreGetSlotsOrdering(t *testing.T, s svc.VendingStore) {
+	ctx := context.Background()
+	slots, err := s.GetSlots(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, slots)
+	for _, name := range []string{"pop", "Cola", "fizz", "Mega Pop"} {
+		require.NoError(t, s.AddSlot(ctx, name, NewSlot(name, 1, 1, 1)))
+	}
+	want := []string{"Cola", "fizz", "Mega Pop", "pop"}
+	for i := 0; i < 3; i++ {
+		slots, err := s.GetSlots(ctx)
+		require.NoError(t, err)
+		var got []string
+		for _, slot := range slots {
+			got = append(got, *slot.OccupiedSoda.Name)
+		}
+		assert.Equal(t, want, got, "slots should be ordered by lower-cased name")
+	}
+}
+
+func testStoreReturnedSlotsAreCopies(t *testing.T, s svc.VendingStore) {
+	ctx := context.Background()
+	slot := NewSlot("Coke", 1, 10, 20)
+	require.NoError(t, s.AddSlot(ctx, "coke", slot))
+	*slot.Quantity = 99
+
+	got, err := s.GetSlot(ctx, "coke")
+	require.NoError(t, err)
+	require.Equal(t, 10, *got.Quantity, "mutating a slot after writing it must not change the store")
+	*got.Quantity = 42
+	*got.OccupiedSoda.Name = "Pepsi"
+	slots, err := s.GetSlots(ctx)
+	require.NoError(t, err)
+	for _, listed := range slots {
+		*listed.Quantity = 42
+	}
+
+	again, err := s.GetSlot(ctx, "coke")
+	require.NoError(t, err)
+	assert.Equal(t, 10, *again.Quantity, "mutating a returned slot must not change the store")
+	assert.Equal(t, "Coke", *again.OccupiedSoda.Name)
+}
+
+func testStoreVersionsIncrease(t *testing.T, s svc.VendingStore) {
+	ctx := context.Background()
+	version := func() int64 {
+		slot, err := s.GetSlot(ctx, "coke")
+		require.NoError(t, err)
+		require.NotNil(t, slot.Version, "slots must carry a version")
+		return *slot.Version
+	}
+
+	require.NoError(t, s.AddSlot(ctx, "coke", NewSlot("Coke", 1, 10, 20)))
+	first := version()
+	assert.Positive(t, first, "a new slot has a version")
+
+	stale := NewSlot("Coke", 2, 10, 20)
+	stale.Version = new(int64)
+	require.NoError(t, s.UpsertSlot(ctx, "coke", stale))
+	assert.Equal(t, first+1, version(), "versions are assigned by the store, not the caller")
+
+	require.NoError(t, s.UpdatePrice(ctx, "coke", usd(3)))
+	assert.Equal(t, first+2, version())
+	require.NoError(t, s.UpdateQuantity(ctx, "coke", 4))
+	assert.Equal(t, first+3, version())
+
+	require.NoError(t, s.DeleteSlot(ctx, "coke"))
+	require.NoError(t, s.AddSlot(ctx, "coke", NewSlot("Coke", 1, 10, 20)))
+	assert.Greater(t, version(), first+3, "a recreated slot does not get back a version of the deleted one")
+}
+
+func testStoreSlotPositions(t *testing.T, s svc.VendingStore) {
+	ctx := context.Background()
+	placed := NewSlot("B3", 1, 2, 8)
+	placed.Position = &v1.SlotPosition{Row: "B", Column: 3}
+	id, capacity := "B4", 8
+	empty := v1.VendingSlot{Id: &id, MaxQuantity: &capacity, Position: &v1.SlotPosition{Row: "B", Column: 4}}
+	require.NoError(t, s.AddSlot(ctx, "B3", placed))
+	require.NoError(t, s.AddSlot(ctx, "B4", empty))
+
+	got, err := s.GetSlot(ctx, "b3")
+	require.NoError(t, err)
+	assert.Equal(t, placed.Position, got.Position)
+
+	got, err = s.GetSlot(ctx, "b4")
+	require.NoError(t, err, "a slot without a soda is an empty coil and must be kept")
+	assert.Nil(t, got.OccupiedSoda)
+	assert.Equal(t, empty.Position, got.Position)
+	assert.Equal(t, 8, *got.MaxQuantity)
+}
+
+func testStoreSodaCatalog(t *testing.T, s svc.VendingStore) {
+	ctx := context.Background()
+	require.NoError(t, s.AddSlot(ctx, "A1", NewSlot("Mega Pop", 1, 5, 10)))
+	unnamed := NewSlot("Mega Pop", 1, 2, 10)
+	unnamed.OccupiedSoda.Id = nil
+	require.NoError(t, s.AddSlot(ctx, "A2", unnamed))
+
+	sodas, err := s.GetSodas(ctx)
+	require.NoError(t, err)
+	if assert.Len(t, sodas, 1, "both slots hold the same soda") {
+		assert.Equal(t, "mega-pop", *sodas[0].Id)
+	}
+	slot, err := s.GetSlot(ctx, "a2")
+	require.NoError(t, err)
+	assert.Equal(t, "mega-pop", *slot.OccupiedSoda.Id, "a soda without ID is identified by its name")
+
+	soda, err := s.GetSoda(ctx, "Mega-Pop")
+	require.NoError(t, err)
+	assert.Equal(t, "Mega Pop", *soda.Name)
+	_, err = s.GetSoda(ctx, "missing")
+	assert.ErrorIs(t, err, svc.ErrNotFound)
+}
+
+func testStoreCashBox(t *testing.T, s svc.VendingStore) {
+	ctx := context.Background()
+	box, err := s.GetCashBox(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "USD", box.Currency)
+	assert.Empty(t, box.Denominations, "the cash box starts empty")
+
+	box, err = s.UpdateCashBox(ctx, func(box *v1.CashBox) error {
+		return svc.AddCash(box, []v1.Denomination{{Value: 25, Count: 4}, {Value: 100, Count: 2}})
+	})
+	require.NoError(t, err)
+	want := []v1.Denomination{{Value: 100, Count: 2}, {Value: 25, Count: 4}}
+	assert.Equal(t, want, box.Denominations)
+	assert.Equal(t, usd(3), *box.Total)
+
+	boom := errors.New("boom")
+	_, err = s.UpdateCashBox(ctx, func(box *v1.CashBox) error {
+		box.Denominations = nil
+		return boom
+	})
+	assert.ErrorIs(t, err, boom)
+	_, err = s.UpdateCashBox(ctx, func(box *v1.CashBox) error {
+		return svc.RemoveCash(box, []v1.Denomination{{Value: 25, Count: 5}})
+	})
+	assert.ErrorIs(t, err, svc.ErrInsufficientCash)
+
+	box, err = s.GetCashBox(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, want, box.Denominations, "failed updates must not change the cash box")
+}
+
+func testStoreLedger(t *testing.T, s svc.VendingStore) {
+	ctx := context.Background()
+	start := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	price := usd(1.5)
+	entries := []v1.Transaction{
+		{Operation: v1.Add, Actor: "admin", SlotId: "A1", Timestamp: start},
+		{Operation: v1.Purchase, Actor: "alice", SlotId: "A1", Timestamp: start.Add(time.Hour), Price: &price},
+		{Operation: v1.Purchase, Actor: "bob", SlotId: "B2", Timestamp: start.Add(2 * time.Hour)},
+		{Operation: v1.Restock, Actor: "admin", SlotId: "a1", Timestamp: start.Add(3 * time.Hour), Leftover: new(int)},
+	}
+	cash := v1.Cash
+	entries[1].PaymentMethod = &cash
+	for i, tx := range entries {
+		soda := "cola"
+		qty := i
+		tx.SodaId, tx.QuantityAfter = &soda, &qty
+		stored, err := s.AppendTransaction(ctx, tx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(i+1), svc.TransactionID(stored), "transactions are numbered in order")
+	}
+
+	all, err := s.GetTransactions(ctx, svc.TransactionFilter{})
+	require.NoError(t, err)
+	if assert.Len(t, all, 4) {
+		assert.Equal(t, "alice", all[1].Actor)
+		assert.Equal(t, usd(1.5), *all[1].Price)
+		assert.Equal(t, 1, *all[1].QuantityAfter)
+		assert.True(t, start.Add(time.Hour).Equal(all[1].Timestamp))
+		assert.Nil(t, all[0].Price)
+		assert.Equal(t, 0, *all[3].Leftover)
+		assert.Equal(t, v1.Cash, *all[1].PaymentMethod)
+		assert.Nil(t, all[2].PaymentMethod)
+	}
+
+	ids := func(filter svc.TransactionFilter) []int64 {
+		txs, err := s.GetTransactions(ctx, filter)
+		require.NoError(t, err)
+		ids := []int64{}
+		for _, tx := range txs {
+			ids = append(ids, svc.TransactionID(tx))
+		}
+		return ids
+	}
+	assert.Equal(t, []int64{2, 3}, ids(svc.TransactionFilter{Operation: v1.Purchase}))
+	assert.Equal(t, []int64{1, 2, 4}, ids(svc.TransactionFilter{SlotID: "A1"}), "slot IDs are case-insensitive")
+	assert.Equal(t, []int64{1, 4}, ids(svc.TransactionFilter{Actor: "ADMIN"}))
+	assert.Equal(t, []int64{2, 3}, ids(svc.TransactionFilter{Since: start.Add(time.Hour), Until: start.Add(3 * time.Hour)}))
+	assert.Equal(t, []int64{3, 4}, ids(svc.TransactionFilter{After: 2, Limit: 2}))
+	assert.Equal(t, []int64{2}, ids(svc.TransactionFilter{SodaID: "Cola", Operation: v1.Purchase, Limit: 1}))
+	assert.Equal(t, []int64{}, ids(svc.TransactionFilter{SodaID: "fizz"}))
+}
+
+func testStoreDayCloses(t *testing.T, s svc.VendingStore) {
+	ctx := context.Background()
+	closedAt := time.Date(2024, 3, 1, 22, 0, 0, 0, time.UTC)
+	closes, err := s.GetDayCloses(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, closes)
+
+	first := v1.DayClose{
+		OpenedAt:             closedAt.Add(-12 * time.Hour),
+		ClosedAt:             closedAt,
+		ClosedBy:             "admin",
+		ThroughTransactionId: 4,
+		Slots:                []v1.DayCloseSlot{{SlotId: "A1", OpeningStock: 5, ClosingStock: 3, UnitsSold: 2}},
+		Revenue:              []v1.Money{usd(3)},
+		ExpectedCash:         usd(10),
+		CountedCash:          usd(9.5),
+		Variance:             -50,
+	}
+	stored, err := s.AppendDayClose(ctx, first)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), svc.DayCloseID(stored))
+
+	_, err = s.AppendDayClose(ctx, first)
+	assert.ErrorIs(t, err, svc.ErrPeriodClosed, "a period is only closed once")
+	gap := first
+	gap.FromTransactionId, gap.OpenedAt = 6, closedAt
+	_, err = s.AppendDayClose(ctx, gap)
+	assert.ErrorIs(t, err, svc.ErrPeriodClosed, "the next period starts at the transaction the last ended at")
+	overlap := first
+	overlap.FromTransactionId = 4
+	_, err = s.AppendDayClose(ctx, overlap)
+	assert.ErrorIs(t, err, svc.ErrPeriodClosed, "the next period opens when the last closed")
+
+	second := first
+	second.FromTransactionId, second.ThroughTransactionId = 4, 4
+	second.OpenedAt, second.ClosedAt = closedAt, closedAt.Add(24*time.Hour)
+	stored, err = s.AppendDayClose(ctx, second)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), svc.DayCloseID(stored))
+
+	closes, err = s.GetDayCloses(ctx)
+	require.NoError(t, err)
+	require.Len(t, closes, 2)
+	assert.Equal(t, first.Slots, closes[0].Slots)
+	assert.Equal(t, usd(9.5), closes[0].CountedCash)
+	assert.Equal(t, int64(-50), closes[0].Variance)
+	assert.True(t, closedAt.Equal(closes[0].ClosedAt))
+
+	report, err := s.GetDayClose(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), report.FromTransactionId)
+	_, err = s.GetDayClose(ctx, 3)
+	assert.ErrorIs(t, err, svc.ErrPeriodNotFound)
+}
+
+func testStoreQuerySlots(t *testing.T, s svc.VendingStore) {
+	ctx := context.Background()
+	require.NoError(t, s.AddSlot(ctx, "A1", NewSlot("Cola", 1, 5, 10)))
+	lemon := NewSlot("Lemon Cola", 2, 1, 10)
+	lemon.OccupiedSoda.Calories = nil
+	require.NoError(t, s.AddSlot(ctx, "A2", lemon))
+	fizz := NewSlot("Fizz", 1.5, 0, 10)
+	calories, ounces := 90, float32(16)
+	fizz.OccupiedSoda.Calories, fizz.OccupiedSoda.Ounces = &calories, &ounces
+	require.NoError(t, s.AddSlot(ctx, "B1", fizz))
+
+	ids := func(q svc.SlotQuery) []string {
+		t.Helper()
+		slots, err := s.QuerySlots(ctx, q)
+		require.NoError(t, err)
+		ids := []string{}
+		for _, slot := range slots {
+			ids = append(ids, svc.SlotID(slot))
+		}
+		return ids
+	}
+	min, max := usd(1.5), v1.Money{Amount: 500, Currency: "EUR"}
+	minCalories, minOunces := 100, float32(14)
+
+	assert.Equal(t, []string{"A1", "A2", "B1"}, ids(svc.SlotQuery{}), "the zero query lists every slot by ID")
+	assert.Equal(t, []string{"A1", "A2"}, ids(svc.SlotQuery{Name: "COLA"}))
+	assert.Equal(t, []string{"A2", "B1"}, ids(svc.SlotQuery{MinPrice: &min}))
+	assert.Empty(t, ids(svc.SlotQuery{MaxPrice: &max}), "prices in another currency are not selected")
+	assert.Equal(t, []string{"A1"}, ids(svc.SlotQuery{MinCalories: &minCalories}), "sodas without calories are not selected")
+	assert.Equal(t, []string{"B1"}, ids(svc.SlotQuery{MinOunces: &minOunces}))
+	assert.Equal(t, []string{"A1", "A2"}, ids(svc.SlotQuery{Stock: svc.InStock}))
+	assert.Equal(t, []string{"A2"}, ids(svc.SlotQuery{Stock: svc.LowStock}))
+	assert.Equal(t, []string{"B1"}, ids(svc.SlotQuery{Stock: svc.SoldOut}))
+	assert.Equal(t, []string{"A2", "B1"}, ids(svc.SlotQuery{OrderBy: svc.OrderByPrice, Descending: true, Limit: 2}))
+	assert.Equal(t, []string{"A1", "B1", "A2"}, ids(svc.SlotQuery{OrderBy: svc.OrderByName}))
+
+	for _, order := range []svc.SlotOrder{svc.OrderByID, svc.OrderByCalories, svc.OrderByQuantity} {
+		for _, descending := range []bool{false, true} {
+			q := svc.SlotQuery{OrderBy: order, Descending: descending}
+			all := ids(q)
+			var paged []string
+			q.Limit = 1
+			for {
+				page, err := s.QuerySlots(ctx, q)
+				require.NoError(t, err)
+				if len(page) == 0 {
+					break
+				}
+				paged = append(paged, svc.SlotID(page[0]))
+				q.After = &page[0]
+			}
+			assert.Equal(t, all, paged, "paging by %s, descending %v", order, descending)
+		}
+	}
+	assert.Equal(t, []string{"A2", "B1", "A1"}, ids(svc.SlotQuery{OrderBy: svc.OrderByCalories}), "slots without the value come first")
+}
+
+func testStoreSodaCatalogSharedBySlots(t *testing.T, s svc.VendingStore) {
+	ctx := context.Background()
+	require.NoError(t, s.AddSlot(ctx, "a1", NewSlot("Cola", 1, 5, 10)))
+	require.NoError(t, s.AddSlot(ctx, "a2", NewSlot("Cola", 1.25, 3, 10)))
+	require.NoError(t, s.AddSlot(ctx, "b1", NewSlot("Fizz", 1, 1, 10)))
+
+	sodas, err := s.GetSodas(ctx)
+	require.NoError(t, err)
+	var ids []string
+	for _, soda := range sodas {
+		ids = append(ids, *soda.Id)
+	}
+	assert.Equal(t, []string{"cola", "fizz"}, ids, "a soda in two slots is one catalog entry")
+
+	renamed := NewSlot("Cola", 1.25, 3, 10)
+	*renamed.OccupiedSoda.Description = "new recipe"
+	require.NoError(t, s.UpsertSlot(ctx, "a2", renamed))
+	for _, name := range []string{"a1", "a2"} {
+		slot, err := s.GetSlot(ctx, name)
+		require.NoError(t, err)
+		assert.Equal(t, "new recipe", *slot.OccupiedSoda.Description,
+			"every slot must read the catalog's current definition")
+	}
+	slot, err := s.GetSlot(ctx, "a1")
+	require.NoError(t, err)
+	assert.Equal(t, 5, *slot.Quantity, "slots holding the same soda keep their own stock")
+
+	soda, err := s.GetSoda(ctx, "COLA")
+	require.NoError(t, err)
+	assert.Equal(t, "new recipe", *soda.Description)
+}
@@ -0,0 +1,129 @@
+package storagetest
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"colaco-api/svc"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// UserStoreFactory returns a new, empty svc.UserStore for a single subtest.
+type UserStoreFactory func(t *testing.T) svc.UserStore
+
+// RunUserStore executes the svc.UserStore checks against directories built
+// by newUsers: the sentinel errors for missing and duplicate users,
+// case-insensitive usernames, the ordering of GetUsers and atomic updates.
+func RunUserStore(t *testing.T, newUsers UserStoreFactory) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, users svc.UserStore)
+	}{
+		{"RoundTrip", testUsersRoundTrip},
+		{"NotFound", testUsersNotFound},
+		{"Conflict", testUsersConflict},
+		{"Ordering", testUsersOrdering},
+		{"Update", testUsersUpdate},
+		{"CancelledContext", testUsersCancelledContext},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newUsers(t))
+		})
+	}
+}
+
+// NewUserRecord returns a user with role and a placeholder password hash,
+// which is enough for a directory that only stores it.
+func NewUserRecord(username string, role v1.Role) svc.UserRecord {
+	user := svc.UserRecord{
+		User:         v1.User{Username: username},
+		PasswordHash: "hash of " + username,
+	}
+	svc.SetRole(&user, role)
+	return user
+}
+
+func testUsersRoundTrip(t *testing.T, users svc.UserStore) {
+	ctx := context.Background()
+	require.NoError(t, users.CreateUser(ctx, NewUserRecord("Alice", v1.Admin)))
+	user, err := users.GetUser(ctx, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, NewUserRecord("Alice", v1.Admin), user, "the username keeps its case")
+}
+
+func testUsersNotFound(t *testing.T, users svc.UserStore) {
+	ctx := context.Background()
+	_, err := users.GetUser(ctx, "nobody")
+	assert.ErrorIs(t, err, svc.ErrUserNotFound)
+	_, err = users.UpdateUser(ctx, "nobody", func(*svc.UserRecord) error { return nil })
+	assert.ErrorIs(t, err, svc.ErrUserNotFound)
+}
+
+func testUsersConflict(t *testing.T, users svc.UserStore) {
+	ctx := context.Background()
+	require.NoError(t, users.CreateUser(ctx, NewUserRecord("alice", v1.Customer)))
+	err := users.CreateUser(ctx, NewUserRecord("ALICE", v1.Admin))
+	assert.ErrorIs(t, err, svc.ErrUserExists)
+	user, err := users.GetUser(ctx, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, v1.Customer, user.Role, "the existing user is kept")
+}
+
+func testUsersOrdering(t *testing.T, users svc.UserStore) {
+	ctx := context.Background()
+	list, err := users.GetUsers(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+	for _, name := range []string{"carol", "Alice", "bob"} {
+		require.NoError(t, users.CreateUser(ctx, NewUserRecord(name, v1.Customer)))
+	}
+	list, err = users.GetUsers(ctx)
+	require.NoError(t, err)
+	var names []string
+	for _, u := range list {
+		names = append(names, u.Username)
+	}
+	assert.Equal(t, []string{"Alice", "bob", "carol"}, names)
+}
+
+func testUsersUpdate(t *testing.T, users svc.UserStore) {
+	ctx := context.Background()
+	require.NoError(t, users.CreateUser(ctx, NewUserRecord("alice", v1.Customer)))
+
+	failure := errors.New("rejected")
+	_, err := users.UpdateUser(ctx, "alice", func(user *svc.UserRecord) error {
+		user.Disabled = true
+		return failure
+	})
+	assert.ErrorIs(t, err, failure)
+	user, err := users.GetUser(ctx, "alice")
+	require.NoError(t, err)
+	assert.False(t, user.Disabled, "a failed update writes nothing")
+
+	updated, err := users.UpdateUser(ctx, "ALICE", func(user *svc.UserRecord) error {
+		user.Username = "mallory"
+		user.Disabled = true
+		user.PasswordHash = "new hash"
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "alice", updated.Username, "the username cannot be changed")
+	assert.True(t, updated.Disabled)
+	assert.NotNil(t, updated.UpdatedAt)
+	user, err = users.GetUser(ctx, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, updated, user)
+}
+
+func testUsersCancelledContext(t *testing.T, users svc.UserStore) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := users.GetUsers(ctx)
+	assert.ErrorIs(t, err, svc.ErrUnavailable)
+	err = users.CreateUser(ctx, NewUserRecord("alice", v1.Customer))
+	assert.ErrorIs(t, err, svc.ErrUnavailable)
+}
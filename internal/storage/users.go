@@ -0,0 +1,178 @@
+package storage
+
+import (
+	"colaco-api/svc"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// UserDirectory is a svc.UserStore that keeps the users in memory. When it is
+// opened with NewFileUserStore every change is also written to a JSON file,
+// which replaces the previous one atomically, so the directory survives
+// restarts.
+type UserDirectory struct {
+	users map[string]svc.UserRecord
+	path  string
+	m     sync.RWMutex
+}
+
+var _ svc.UserStore = (*UserDirectory)(nil)
+
+// NewMemoryUserStore returns an empty directory that only lives as long as
+// the process.
+func NewMemoryUserStore() *UserDirectory {
+	return &UserDirectory{users: make(map[string]svc.UserRecord)}
+}
+
+// NewFileUserStore opens the directory stored in the JSON file at path, or an
+// empty one if the file does not exist yet. Users stored before roles existed
+// are given one, see svc.UserRole.
+func NewFileUserStore(path string) (*UserDirectory, error) {
+	d := &UserDirectory{users: make(map[string]svc.UserRecord), path: path}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return d, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading users: %w", err)
+	}
+	var users []svc.UserRecord
+	if err := json.Unmarshal(b, &users); err != nil {
+		return nil, fmt.Errorf("decoding users in %s: %w", path, err)
+	}
+	for _, u := range users {
+		svc.SetRole(&u, svc.UserRole(u.User))
+		d.users[strings.ToLower(u.Username)] = u
+	}
+	return d, nil
+}
+
+// save writes users to the file of the directory, if it has one, before they
+// replace the users in memory.
+func (d *UserDirectory) save(users map[string]svc.UserRecord) error {
+	if d.path != "" {
+		b, err := json.MarshalIndent(sortedUsers(users), "", "  ")
+		if err != nil {
+			return fmt.Errorf("encoding users: %w", err)
+		}
+		// The file holds password hashes, so only the owner may read it.
+		if err := replaceFile(d.path, b); err != nil {
+			return fmt.Errorf("%w: installing users: %w", svc.ErrUnavailable, err)
+		}
+	}
+	d.users = users
+	return nil
+}
+
+// replaceFile atomically replaces the file at path with one holding b that
+// only its owner may read.
+func replaceFile(path string, b []byte) error {
+	tmp := path + ".tmp"
+	if err := writeFileSync(tmp, b, 0o600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return err
+	}
+	return syncDir(filepath.Dir(path))
+}
+
+func sortedUsers(users map[string]svc.UserRecord) []svc.UserRecord {
+	sorted := make([]svc.UserRecord, 0, len(users))
+	for _, u := range users {
+		sorted = append(sorted, u)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return strings.ToLower(sorted[i].Username) < strings.ToLower(sorted[j].Username)
+	})
+	return sorted
+}
+
+func checkDirectoryContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("%w: %w", svc.ErrUnavailable, err)
+	}
+	return nil
+}
+
+// GetUser implements svc.UserStore.
+func (d *UserDirectory) GetUser(ctx context.Context, username string) (svc.UserRecord, error) {
+	if err := checkDirectoryContext(ctx); err != nil {
+		return svc.UserRecord{}, err
+	}
+	d.m.RLock()
+	defer d.m.RUnlock()
+	u, ok := d.users[strings.ToLower(username)]
+	if !ok {
+		return svc.UserRecord{}, fmt.Errorf("%w: %q", svc.ErrUserNotFound, username)
+	}
+	return u, nil
+}
+
+// GetUsers implements svc.UserStore.
+func (d *UserDirectory) GetUsers(ctx context.Context) ([]svc.UserRecord, error) {
+	if err := checkDirectoryContext(ctx); err != nil {
+		return nil, err
+	}
+	d.m.RLock()
+	defer d.m.RUnlock()
+	return sortedUsers(d.users), nil
+}
+
+// CreateUser implements svc.UserStore.
+func (d *UserDirectory) CreateUser(ctx context.Context, user svc.UserRecord) error {
+	if err := checkDirectoryContext(ctx); err != nil {
+		return err
+	}
+	d.m.Lock()
+	defer d.m.Unlock()
+	key := strings.ToLower(user.Username)
+	if _, ok := d.users[key]; ok {
+		return fmt.Errorf("%w: %q", svc.ErrUserExists, user.Username)
+	}
+	users := cloneUsers(d.users)
+	users[key] = user
+	return d.save(users)
+}
+
+// UpdateUser implements svc.UserStore. The username cannot be changed.
+func (d *UserDirectory) UpdateUser(ctx context.Context, username string, fn func(user *svc.UserRecord) error) (svc.UserRecord, error) {
+	if err := checkDirectoryContext(ctx); err != nil {
+		return svc.UserRecord{}, err
+	}
+	d.m.Lock()
+	defer d.m.Unlock()
+	key := strings.ToLower(username)
+	u, ok := d.users[key]
+	if !ok {
+		return svc.UserRecord{}, fmt.Errorf("%w: %q", svc.ErrUserNotFound, username)
+	}
+	name := u.Username
+	if err := fn(&u); err != nil {
+		return svc.UserRecord{}, err
+	}
+	now := time.Now().UTC()
+	u.Username, u.UpdatedAt = name, &now
+	users := cloneUsers(d.users)
+	users[key] = u
+	if err := d.save(users); err != nil {
+		return svc.UserRecord{}, err
+	}
+	return u, nil
+}
+
+func cloneUsers(users map[string]svc.UserRecord) map[string]svc.UserRecord {
+	clone := make(map[string]svc.UserRecord, len(users))
+	for k, u := range users {
+		clone[k] = u
+	}
+	return clone
+}
@@ -0,0 +1,143 @@
+package svc
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrAPIKeyNotFound is returned for an API key ID that is not in the
+	// store.
+	ErrAPIKeyNotFound = errors.New("API key not found")
+	// ErrAPIKeyExists is returned when creating an API key whose ID is taken.
+	ErrAPIKeyExists = errors.New("API key already exists")
+	// ErrInvalidAPIKey is returned for a name, scopes or expiry an API key
+	// cannot be created with.
+	ErrInvalidAPIKey = errors.New("invalid API key")
+	// ErrAPIKeyRejected is returned by CheckAPIKey for a key that is
+	// malformed, unknown, revoked or expired, without telling which.
+	ErrAPIKeyRejected = errors.New("API key is not valid")
+)
+
+// APIKeyPrefix starts every API key, so that leaked keys are easy to spot.
+// It is followed by the ID of the key, an underscore and the secret.
+const APIKeyPrefix = "colaco_"
+
+// apiKeyActorPrefix is what the ledger records API keys as, followed by their
+// ID.
+const apiKeyActorPrefix = "apikey:"
+
+// APIKeyRecord is an API key together with the SHA-256 hash of the key. The
+// key is only known to whoever created it; the hash is never returned by the
+// API. The keys are random, so unlike passwords they need no slow hash.
+type APIKeyRecord struct {
+	v1.APIKey
+	KeyHash string `json:"keyHash"`
+}
+
+// APIKeyStore keeps the API keys.
+type APIKeyStore interface {
+	// GetAPIKey returns ErrAPIKeyNotFound when there is no such key.
+	GetAPIKey(ctx context.Context, id string) (APIKeyRecord, error)
+	// GetAPIKeys returns every key, revoked ones included, oldest first.
+	GetAPIKeys(ctx context.Context) ([]APIKeyRecord, error)
+	// CreateAPIKey returns ErrAPIKeyExists when the ID is taken.
+	CreateAPIKey(ctx context.Context, key APIKeyRecord) error
+	// UpdateAPIKey atomically applies fn to the key and returns the stored
+	// result. If fn returns an error nothing is written and the error is
+	// returned unchanged. The ID and hash cannot be changed.
+	UpdateAPIKey(ctx context.Context, id string, fn func(key *APIKeyRecord) error) (APIKeyRecord, error)
+}
+
+// ValidScope reports whether scope is one of the scopes operations require.
+func ValidScope(scope string) bool {
+	return slices.Contains(RoleScopes(v1.Admin), scope)
+}
+
+// APIKeyActor returns who the ledger records the API key with the ID id as.
+func APIKeyActor(id string) string {
+	return apiKeyActorPrefix + id
+}
+
+// hashAPIKey returns the hex SHA-256 hash of key.
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// NewAPIKey returns a new API key granting scopes until expiresAt, or until
+// it is revoked when expiresAt is nil, and its record naming createdBy as its
+// creator. The key is only returned here; the record holds its hash.
+func NewAPIKey(name string, scopes []string, createdBy string, expiresAt *time.Time) (string, APIKeyRecord, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", APIKeyRecord{}, fmt.Errorf("%w: a name is required", ErrInvalidAPIKey)
+	}
+	if len(scopes) == 0 {
+		return "", APIKeyRecord{}, fmt.Errorf("%w: at least one scope is required", ErrInvalidAPIKey)
+	}
+	for _, scope := range scopes {
+		if !ValidScope(scope) {
+			return "", APIKeyRecord{}, fmt.Errorf("%w: unknown scope %q", ErrInvalidAPIKey, scope)
+		}
+	}
+	now := time.Now().UTC()
+	if expiresAt != nil && !expiresAt.After(now) {
+		return "", APIKeyRecord{}, fmt.Errorf("%w: it would already be expired", ErrInvalidAPIKey)
+	}
+	id := make([]byte, 8)
+	secret := make([]byte, 32)
+	if _, err := rand.Read(id); err != nil {
+		return "", APIKeyRecord{}, fmt.Errorf("generating API key: %w", err)
+	}
+	if _, err := rand.Read(secret); err != nil {
+		return "", APIKeyRecord{}, fmt.Errorf("generating API key: %w", err)
+	}
+	scopes = slices.Clone(scopes)
+	slices.Sort(scopes)
+	record := APIKeyRecord{APIKey: v1.APIKey{
+		Id:        hex.EncodeToString(id),
+		Name:      name,
+		Scopes:    slices.Compact(scopes),
+		CreatedAt: now,
+		CreatedBy: createdBy,
+		ExpiresAt: expiresAt,
+	}}
+	key := APIKeyPrefix + record.Id + "_" + base64.RawURLEncoding.EncodeToString(secret)
+	record.KeyHash = hashAPIKey(key)
+	return key, record, nil
+}
+
+// CheckAPIKey returns the record of key unless it is malformed, unknown,
+// revoked or expired, which are all ErrAPIKeyRejected. Other store errors
+// are returned unchanged.
+func CheckAPIKey(ctx context.Context, keys APIKeyStore, key string) (APIKeyRecord, error) {
+	id, _, ok := strings.Cut(strings.TrimPrefix(key, APIKeyPrefix), "_")
+	if !ok || !strings.HasPrefix(key, APIKeyPrefix) || id == "" {
+		return APIKeyRecord{}, ErrAPIKeyRejected
+	}
+	record, err := keys.GetAPIKey(ctx, id)
+	if errors.Is(err, ErrAPIKeyNotFound) {
+		return APIKeyRecord{}, ErrAPIKeyRejected
+	}
+	if err != nil {
+		return APIKeyRecord{}, err
+	}
+	if subtle.ConstantTimeCompare([]byte(hashAPIKey(key)), []byte(record.KeyHash)) != 1 {
+		return APIKeyRecord{}, ErrAPIKeyRejected
+	}
+	if record.RevokedAt != nil || (record.ExpiresAt != nil && !time.Now().Before(*record.ExpiresAt)) {
+		return APIKeyRecord{}, ErrAPIKeyRejected
+	}
+	return record, nil
+}
@@ -0,0 +1,122 @@
+package svc
+
+import (
+	"bytes"
+	v1 "colaco-api/internal/api/v1"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrAuditTrailBroken is returned by VerifyAuditTrail for every record that
+// does not follow the one before it or was changed after it was recorded.
+var ErrAuditTrailBroken = errors.New("audit trail is broken")
+
+// AuditStore keeps the audit trail of privileged calls.
+type AuditStore interface {
+	// AppendAuditRecord chains rec to the last record, see ChainAuditRecord,
+	// stores it and returns it. Stored records are never changed.
+	AppendAuditRecord(ctx context.Context, rec v1.AuditRecord) (v1.AuditRecord, error)
+	// GetAuditRecords returns up to limit records in the order they were
+	// appended, skipping the first offset of them. A limit of zero returns
+	// all of them. The records are returned as stored, even when they do not
+	// follow each other, so that VerifyAuditTrail notices.
+	GetAuditRecords(ctx context.Context, offset int64, limit int) ([]v1.AuditRecord, error)
+}
+
+// NewAuditRecord returns a record of operation on target, made now, whose
+// before and after states are those of the values before and after, either
+// of which may be nil. The states are taken as the API encodes the values,
+// at the time of the call.
+func NewAuditRecord(operation, target string, before, after any) v1.AuditRecord {
+	return v1.AuditRecord{
+		Timestamp: time.Now().UTC(),
+		Operation: operation,
+		Target:    target,
+		Before:    auditState(before),
+		After:     auditState(after),
+	}
+}
+
+// auditState returns v as a JSON object, the way it is stored and returned
+// by the API, or nil for nil. The values recorded are API types, which always
+// encode; one that does not is recorded as the error.
+func auditState(v any) *map[string]interface{} {
+	if v == nil {
+		return nil
+	}
+	var state map[string]interface{}
+	b, err := json.Marshal(v)
+	if err == nil {
+		err = json.Unmarshal(b, &state)
+	}
+	if err != nil {
+		state = map[string]interface{}{"error": err.Error()}
+	}
+	return &state
+}
+
+// ChainAuditRecord returns rec as it follows prev in the trail, or as the
+// first record when prev is nil, with its Seq, PrevHash and Hash set.
+func ChainAuditRecord(prev *v1.AuditRecord, rec v1.AuditRecord) v1.AuditRecord {
+	rec.Seq, rec.PrevHash = 1, ""
+	if prev != nil {
+		rec.Seq, rec.PrevHash = prev.Seq+1, prev.Hash
+	}
+	rec.Hash = AuditRecordHash(rec)
+	return rec
+}
+
+// AuditRecordHash returns the hex SHA-256 of rec encoded as JSON with an
+// empty Hash, its keys sorted and without whitespace, which does not depend
+// on the order of the fields of v1.AuditRecord or on how numbers in the
+// states were decoded.
+func AuditRecordHash(rec v1.AuditRecord) string {
+	rec.Hash = ""
+	// The record always encodes, as its states are decoded JSON, and what
+	// json.Marshal encodes decodes.
+	b, _ := json.Marshal(rec)
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var canonical map[string]interface{}
+	_ = d.Decode(&canonical)
+	b, _ = json.Marshal(canonical)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifyAuditTrail checks that records, the whole trail oldest first, are
+// numbered from 1 without gaps, that each one carries the hash of the one
+// before it and that its own hash matches its contents. It returns an
+// ErrAuditTrailBroken for every record that fails, joined, or nil when the
+// trail is intact.
+func VerifyAuditTrail(records []v1.AuditRecord) error {
+	var errs []error
+	var prev *v1.AuditRecord
+	for i := range records {
+		rec := &records[i]
+		wantSeq, wantPrev := int64(1), ""
+		if prev != nil {
+			wantSeq, wantPrev = prev.Seq+1, prev.Hash
+		}
+		switch {
+		case rec.Seq == wantSeq+1:
+			errs = append(errs, fmt.Errorf("%w: record %d is missing", ErrAuditTrailBroken, wantSeq))
+		case rec.Seq > wantSeq:
+			errs = append(errs, fmt.Errorf("%w: records %d to %d are missing", ErrAuditTrailBroken, wantSeq, rec.Seq-1))
+		case rec.Seq < wantSeq:
+			errs = append(errs, fmt.Errorf("%w: record %d is out of order after record %d", ErrAuditTrailBroken, rec.Seq, wantSeq-1))
+		case rec.PrevHash != wantPrev:
+			errs = append(errs, fmt.Errorf("%w: record %d does not follow record %d", ErrAuditTrailBroken, rec.Seq, rec.Seq-1))
+		}
+		if AuditRecordHash(*rec) != rec.Hash {
+			errs = append(errs, fmt.Errorf("%w: record %d was changed", ErrAuditTrailBroken, rec.Seq))
+		}
+		prev = rec
+	}
+	return errors.Join(errs...)
+}
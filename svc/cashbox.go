@@ -0,0 +1,221 @@
+package svc
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrExactChangeOnly is returned when the cash box does not hold the coins
+// and bills to give the change a purchase needs.
+var ErrExactChangeOnly = errors.New("exact change only")
+
+// ErrInsufficientCash is returned when more coins or bills are taken out of
+// the cash box than it holds.
+var ErrInsufficientCash = errors.New("insufficient cash")
+
+const (
+	// MaxDenominationValue and MaxDenominationCount bound the coins and bills
+	// of a denomination the API accepts, in the minor unit of the currency.
+	MaxDenominationValue = 100_000
+	MaxDenominationCount = 10_000
+	// MaxCashBoxDenominations is the most distinct denominations a cash box
+	// holds.
+	MaxCashBoxDenominations = 32
+	// MaxInsertedCash is the most a purchase can insert, in minor units.
+	MaxInsertedCash = 100_000
+	// MaxChange is the most change MakeChange makes, in minor units. The
+	// search for change takes time and memory in proportion to the amount.
+	MaxChange = MaxInsertedCash
+)
+
+// CashBoxStorage is implemented by VendingStorageInterface backends that
+// persist the cash box. NewLegacyStore keeps the cash box in memory for
+// backends that do not.
+type CashBoxStorage interface {
+	// GetCashBox returns the cash box, which is empty and in DefaultCurrency
+	// until it is first filled.
+	GetCashBox() (v1.CashBox, error)
+	// UpdateCashBox atomically applies fn to the cash box and stores the
+	// result, normalized by NormalizeCashBox. If fn returns an error nothing
+	// is written and the error is returned.
+	UpdateCashBox(fn func(box *v1.CashBox) error) (v1.CashBox, error)
+}
+
+// NewCashBox returns an empty cash box in DefaultCurrency.
+func NewCashBox() v1.CashBox {
+	return v1.CashBox{Currency: DefaultCurrency, Denominations: []v1.Denomination{}}
+}
+
+// NormalizeCashBox merges the counts of equal denominations, drops the ones
+// with nothing left, orders them largest first and sets the total.
+func NormalizeCashBox(box v1.CashBox) v1.CashBox {
+	if box.Currency == "" {
+		box.Currency = DefaultCurrency
+	}
+	box.Currency = strings.ToUpper(box.Currency)
+	box.Denominations = mergeDenominations(box.Denominations)
+	box.Total = &v1.Money{Amount: CashTotal(box.Denominations), Currency: box.Currency}
+	return box
+}
+
+func mergeDenominations(ds []v1.Denomination) []v1.Denomination {
+	counts := map[int64]int{}
+	for _, d := range ds {
+		counts[d.Value] += d.Count
+	}
+	merged := []v1.Denomination{}
+	for value, count := range counts {
+		if count > 0 {
+			merged = append(merged, v1.Denomination{Value: value, Count: count})
+		}
+	}
+	sort.Slice(merged, func(i, j int) bool { return merged[i].Value > merged[j].Value })
+	return merged
+}
+
+// CashTotal returns the value of ds in minor units.
+func CashTotal(ds []v1.Denomination) int64 {
+	var total int64
+	for _, d := range ds {
+		total += d.Value * int64(d.Count)
+	}
+	return total
+}
+
+// ValidateDenominations reports the first denomination in ds without a
+// positive value and count, or with more than MaxDenominationValue and
+// MaxDenominationCount.
+func ValidateDenominations(ds []v1.Denomination) error {
+	for _, d := range ds {
+		if d.Value < 1 || d.Count < 1 {
+			return fmt.Errorf("denomination %d x %d: value and count must be positive", d.Value, d.Count)
+		}
+		if d.Value > MaxDenominationValue || d.Count > MaxDenominationCount {
+			return fmt.Errorf("denomination %d x %d: value must be at most %d and count at most %d",
+				d.Value, d.Count, MaxDenominationValue, MaxDenominationCount)
+		}
+	}
+	return nil
+}
+
+// ValidateInserted reports what is wrong with the coins and bills inserted
+// for a purchase: a denomination ValidateDenominations rejects, or a total
+// above MaxInsertedCash.
+func ValidateInserted(ds []v1.Denomination) error {
+	if err := ValidateDenominations(ds); err != nil {
+		return err
+	}
+	if total := CashTotal(ds); total > MaxInsertedCash {
+		return fmt.Errorf("%d inserted is more than the %d a purchase can insert", total, MaxInsertedCash)
+	}
+	return nil
+}
+
+// AddCash puts ds into box. It returns an error wrapping ErrConflict, and
+// leaves box unchanged, when box would hold more than
+// MaxCashBoxDenominations distinct denominations.
+func AddCash(box *v1.CashBox, ds []v1.Denomination) error {
+	merged := mergeDenominations(append(append([]v1.Denomination{}, box.Denominations...), ds...))
+	if len(merged) > MaxCashBoxDenominations {
+		return fmt.Errorf("%w: the cash box holds at most %d denominations", ErrConflict, MaxCashBoxDenominations)
+	}
+	box.Denominations = merged
+	return nil
+}
+
+// RemoveCash takes ds out of box. It returns an error wrapping
+// ErrInsufficientCash, and leaves box unchanged, when box does not hold them.
+func RemoveCash(box *v1.CashBox, ds []v1.Denomination) error {
+	counts := map[int64]int{}
+	for _, d := range box.Denominations {
+		counts[d.Value] += d.Count
+	}
+	remaining := make([]v1.Denomination, 0, len(box.Denominations)+len(ds))
+	remaining = append(remaining, box.Denominations...)
+	for _, d := range mergeDenominations(ds) {
+		if counts[d.Value] < d.Count {
+			return fmt.Errorf("%w: the cash box holds %d of denomination %d, not %d", ErrInsufficientCash, counts[d.Value], d.Value, d.Count)
+		}
+		remaining = append(remaining, v1.Denomination{Value: d.Value, Count: -d.Count})
+	}
+	box.Denominations = mergeDenominations(remaining)
+	return nil
+}
+
+// MakeChange returns the fewest coins and bills from box that add up to
+// amount, largest first, without taking them out. Unlike paying out the
+// largest denomination first, it finds change whenever box can make it: 30
+// cents from a quarter and three dimes is three dimes. It returns
+// ErrExactChangeOnly when box cannot make amount, or amount is more than
+// MaxChange.
+func MakeChange(box v1.CashBox, amount int64) ([]v1.Denomination, error) {
+	if amount <= 0 {
+		return []v1.Denomination{}, nil
+	}
+	if amount > MaxChange || amount > CashTotal(box.Denominations) {
+		return nil, fmt.Errorf("%w: the cash box cannot make %s", ErrExactChangeOnly, FormatMoney(v1.Money{Amount: amount, Currency: box.Currency}))
+	}
+
+	// A bounded coin change: every denomination is split into bundles of
+	// 1, 2, 4, ... coins so that each bundle is used at most once, and
+	// pieces[a] is the fewest coins making a. took[b] has bit a set when
+	// bundle b is part of the fewest coins making a with bundles 0 to b.
+	type bundle struct {
+		value int64
+		count int
+	}
+	var bundles []bundle
+	for _, d := range mergeDenominations(box.Denominations) {
+		if d.Value > amount {
+			continue
+		}
+		left := d.Count
+		if most := int(amount / d.Value); left > most {
+			left = most
+		}
+		for n := 1; left > 0; n *= 2 {
+			if n > left {
+				n = left
+			}
+			bundles = append(bundles, bundle{value: d.Value, count: n})
+			left -= n
+		}
+	}
+
+	const unreachable = -1
+	pieces := make([]int, amount+1)
+	took := make([][]uint64, len(bundles))
+	for a := range pieces {
+		pieces[a] = unreachable
+	}
+	pieces[0] = 0
+	for b, bn := range bundles {
+		worth := bn.value * int64(bn.count)
+		took[b] = make([]uint64, amount/64+1)
+		for a := amount; a >= worth; a-- {
+			from := pieces[a-worth]
+			if from == unreachable {
+				continue
+			}
+			if pieces[a] == unreachable || from+bn.count < pieces[a] {
+				pieces[a] = from + bn.count
+				took[b][a/64] |= 1 << (a % 64)
+			}
+		}
+	}
+	if pieces[amount] == unreachable {
+		return nil, fmt.Errorf("%w: the cash box cannot make %s", ErrExactChangeOnly, FormatMoney(v1.Money{Amount: amount, Currency: box.Currency}))
+	}
+
+	var change []v1.Denomination
+	for b, a := len(bundles)-1, amount; a > 0; b-- {
+		if took[b][a/64]&(1<<(a%64)) != 0 {
+			change = append(change, v1.Denomination{Value: bundles[b].value, Count: bundles[b].count})
+			a -= bundles[b].value * int64(bundles[b].count)
+		}
+	}
+	return mergeDenominations(change), nil
+}
@@ -0,0 +1,71 @@
+package svc
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"sort"
+	"strings"
+)
+
+// SodaCatalog is implemented by VendingStorageInterface backends that keep
+// sodas in a catalog of their own instead of only inside the slots. Writing
+// a slot stores its soda in the catalog under SodaID, replacing the previous
+// definition, and every slot holding that soda reads back the catalog's
+// current definition. Sodas stay in the catalog when their slots are deleted.
+// NewLegacyStore uses it when available and otherwise derives the catalog
+// from the sodas found in the slots.
+type SodaCatalog interface {
+	// GetSoda looks up a soda by its ID. The boolean is false when the
+	// catalog has no such soda.
+	GetSoda(id string) (v1.Soda, bool, error)
+	// GetSodas returns every soda in the catalog ordered by ID.
+	GetSodas() []v1.Soda
+}
+
+// SodaID returns the catalog ID of soda: its id, or a slug of its name when
+// it has none. IDs are case-insensitive and returned lower-cased. A soda with
+// neither has the empty ID.
+func SodaID(soda v1.Soda) string {
+	if soda.Id != nil && *soda.Id != "" {
+		return strings.ToLower(*soda.Id)
+	}
+	if soda.Name != nil {
+		return Slug(*soda.Name)
+	}
+	return ""
+}
+
+// Slug turns a soda name into the ID it gets when none was given, for
+// example "Mega Pop" becomes "mega-pop".
+func Slug(name string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(name), " ", "-"))
+}
+
+// WithSodaID returns soda with its Id set to SodaID.
+func WithSodaID(soda v1.Soda) v1.Soda {
+	if id := SodaID(soda); id != "" {
+		soda.Id = &id
+	}
+	return soda
+}
+
+// CatalogFromSlots derives a catalog from the sodas occupying slots, for
+// backends that do not implement SodaCatalog. When slots disagree about a
+// soda the first one wins.
+func CatalogFromSlots(slots []v1.VendingSlot) []v1.Soda {
+	seen := map[string]bool{}
+	var sodas []v1.Soda
+	for _, slot := range slots {
+		if slot.OccupiedSoda == nil {
+			continue
+		}
+		soda := WithSodaID(*slot.OccupiedSoda)
+		id := SodaID(soda)
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		sodas = append(sodas, soda)
+	}
+	sort.Slice(sodas, func(i, j int) bool { return SodaID(sodas[i]) < SodaID(sodas[j]) })
+	return sodas
+}
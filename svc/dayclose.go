@@ -0,0 +1,163 @@
+package svc
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrPeriodClosed is returned when a period is closed that was already
+// closed, typically by a concurrent close.
+var ErrPeriodClosed = errors.New("period already closed")
+
+// ErrPeriodNotFound is returned for a closed period that does not exist.
+var ErrPeriodNotFound = errors.New("period not found")
+
+// DayCloseStorage is implemented by VendingStorageInterface backends that
+// persist the end-of-day reports of closed periods. NewLegacyStore keeps them
+// in memory for backends that do not.
+type DayCloseStorage interface {
+	// AppendDayClose gives report the ID following the last close, stores it
+	// and returns it. Stored reports are never changed. Unless report starts
+	// where the last close ended, see CheckDayClose, nothing is stored and
+	// ErrPeriodClosed is returned.
+	AppendDayClose(report v1.DayClose) (v1.DayClose, error)
+	// DayCloses returns every stored report, oldest first.
+	DayCloses() ([]v1.DayClose, error)
+}
+
+// CheckDayClose returns ErrPeriodClosed unless report covers the period
+// following the last of closes: it must open when and at the transaction
+// where the last close ended.
+func CheckDayClose(closes []v1.DayClose, report v1.DayClose) error {
+	if len(closes) == 0 {
+		return nil
+	}
+	last := closes[len(closes)-1]
+	if report.FromTransactionId != last.ThroughTransactionId || !report.OpenedAt.Equal(last.ClosedAt) {
+		return fmt.Errorf("%w: the period opened at %s", ErrPeriodClosed, report.OpenedAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// DayCloseID returns the ID of report, or 0 before it was stored.
+func DayCloseID(report v1.DayClose) int64 {
+	if report.Id == nil {
+		return 0
+	}
+	return *report.Id
+}
+
+// NewDayClose builds the end-of-day report of the period following previous,
+// nil for the first period, as of closedAt. txs are the ledger transactions
+// recorded since previous, oldest first, slots the slots and box the cash box
+// at the close. The expected cash is the total of box, so counted must be in
+// the currency of box or ErrCurrencyMismatch is returned.
+func NewDayClose(previous *v1.DayClose, txs []v1.Transaction, slots []v1.VendingSlot, box v1.CashBox, counted v1.Money, closedAt time.Time) (v1.DayClose, error) {
+	expected := v1.Money{Amount: CashTotal(box.Denominations), Currency: box.Currency}
+	if counted.Currency != expected.Currency {
+		return v1.DayClose{}, fmt.Errorf("%w: counted %s in a %s cash box", ErrCurrencyMismatch, counted.Currency, expected.Currency)
+	}
+	report := v1.DayClose{
+		OpenedAt:     closedAt.UTC(),
+		ClosedAt:     closedAt.UTC(),
+		ExpectedCash: expected,
+		CountedCash:  counted,
+		Variance:     counted.Amount - expected.Amount,
+		Revenue:      []v1.Money{},
+	}
+	switch {
+	case previous != nil:
+		report.OpenedAt = previous.ClosedAt
+		report.FromTransactionId = previous.ThroughTransactionId
+	case len(txs) > 0:
+		report.OpenedAt = txs[0].Timestamp.UTC()
+	}
+	report.ThroughTransactionId = report.FromTransactionId
+	if len(txs) > 0 {
+		report.ThroughTransactionId = TransactionID(txs[len(txs)-1])
+	}
+
+	moves := map[string]*v1.DayCloseSlot{}
+	move := func(id string) *v1.DayCloseSlot {
+		key := strings.ToLower(id)
+		m, ok := moves[key]
+		if !ok {
+			m = &v1.DayCloseSlot{SlotId: id}
+			moves[key] = m
+		}
+		return m
+	}
+	seen := map[string]bool{}
+	revenue := map[string]int64{}
+	for _, tx := range txs {
+		m := move(tx.SlotId)
+		if !seen[strings.ToLower(tx.SlotId)] {
+			seen[strings.ToLower(tx.SlotId)] = true
+			m.OpeningStock = derefInt(tx.QuantityBefore)
+		}
+		m.ClosingStock = derefInt(tx.QuantityAfter)
+		if tx.SodaId != nil {
+			m.SodaId = tx.SodaId
+		}
+		if tx.SodaName != nil {
+			m.SodaName = tx.SodaName
+		}
+		switch tx.Operation {
+		case v1.Purchase:
+			m.UnitsSold++
+			if tx.Price != nil {
+				revenue[tx.Price.Currency] += tx.Price.Amount
+			}
+		case v1.Restock:
+			m.Restocked += derefInt(tx.QuantityAfter) - derefInt(tx.QuantityBefore)
+			m.Leftover += derefInt(tx.Leftover)
+		}
+	}
+	for _, slot := range slots {
+		id := SlotID(slot)
+		m := move(id)
+		m.ClosingStock = derefInt(slot.Quantity)
+		if !seen[strings.ToLower(id)] {
+			m.OpeningStock = m.ClosingStock
+		}
+		if slot.OccupiedSoda != nil {
+			if sodaID := SodaID(*slot.OccupiedSoda); sodaID != "" {
+				m.SodaId = &sodaID
+			}
+			if slot.OccupiedSoda.Name != nil {
+				name := *slot.OccupiedSoda.Name
+				m.SodaName = &name
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(moves))
+	for k := range moves {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	report.Slots = make([]v1.DayCloseSlot, 0, len(keys))
+	for _, k := range keys {
+		report.Slots = append(report.Slots, *moves[k])
+	}
+	currencies := make([]string, 0, len(revenue))
+	for c := range revenue {
+		currencies = append(currencies, c)
+	}
+	sort.Strings(currencies)
+	for _, c := range currencies {
+		report.Revenue = append(report.Revenue, v1.Money{Amount: revenue[c], Currency: c})
+	}
+	return report, nil
+}
+
+func derefInt(i *int) int {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
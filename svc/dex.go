@@ -0,0 +1,207 @@
+package svc
+
+import (
+	"bytes"
+	v1 "colaco-api/internal/api/v1"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MachineIdentity identifies the vending machine in audit files.
+type MachineIdentity struct {
+	Serial   string
+	Model    string
+	Asset    string
+	Location string
+}
+
+// DefaultMachineIdentity identifies a machine that was not configured with
+// its own serial number.
+var DefaultMachineIdentity = MachineIdentity{Serial: "CC00000001", Model: "COLACO-VM"}
+
+// currencyNumbers maps the alphabetic ISO 4217 codes of common currencies to
+// the numeric codes DEX files identify currencies by.
+var currencyNumbers = map[string]string{
+	"AUD": "036", "CAD": "124", "CHF": "756", "CNY": "156", "EUR": "978",
+	"GBP": "826", "JPY": "392", "MXN": "484", "NZD": "554", "USD": "840",
+}
+
+// DEXAudit is the data a DEX audit file is generated from.
+type DEXAudit struct {
+	Machine MachineIdentity
+	// Slots are listed as the columns of the machine.
+	Slots []v1.VendingSlot
+	// Transactions is the whole ledger, oldest first.
+	Transactions []v1.Transaction
+	// ResetAfter is the ID of the last transaction before the counters were
+	// last reset, by closing the day.
+	ResetAfter int64
+	CashBox    v1.CashBox
+}
+
+// dexCounter counts vends and adds up their value in the audit currency.
+type dexCounter struct {
+	count, value int64
+}
+
+func (c *dexCounter) add(m *v1.Money, currency string) {
+	c.count++
+	if m != nil && m.Currency == currency {
+		c.value += m.Amount
+	}
+}
+
+// dexCounters holds a counter since initialization and one since the last
+// reset.
+type dexCounters struct {
+	init, reset dexCounter
+}
+
+func (c *dexCounters) add(m *v1.Money, currency string, sinceReset bool) {
+	c.init.add(m, currency)
+	if sinceReset {
+		c.reset.add(m, currency)
+	}
+}
+
+// MarshalDEX generates an EVA-DTS DEX/UCS audit file for a. Values are in the
+// minor unit of the currency of the cash box; sales in other currencies are
+// counted without their value.
+func MarshalDEX(a DEXAudit) []byte {
+	currency := a.CashBox.Currency
+	var (
+		paid, cash, cashless dexCounters
+		cashIn, dispensed    dexCounters
+		columns              = map[string]*dexCounters{}
+	)
+	for _, tx := range a.Transactions {
+		if tx.Operation != v1.Purchase {
+			continue
+		}
+		sinceReset := TransactionID(tx) > a.ResetAfter
+		key := strings.ToLower(tx.SlotId)
+		if columns[key] == nil {
+			columns[key] = &dexCounters{}
+		}
+		columns[key].add(tx.Price, currency, sinceReset)
+		paid.add(tx.Price, currency, sinceReset)
+		if tx.PaymentMethod != nil && *tx.PaymentMethod == v1.Cash {
+			cash.add(tx.Price, currency, sinceReset)
+			cashIn.add(tx.Paid, currency, sinceReset)
+			dispensed.add(tx.Change, currency, sinceReset)
+		} else {
+			cashless.add(tx.Price, currency, sinceReset)
+		}
+	}
+
+	var b dexBuilder
+	b.segment("DXS", truncate(a.Machine.Serial, 10), "VA", "V1/1", "1")
+	b.start()
+	b.segment("ST", "001", "0001")
+	b.segment("ID1", a.Machine.Serial, a.Machine.Model, "", a.Machine.Location, "", a.Machine.Asset)
+	b.segment("ID4", strconv.Itoa(int(decimals(currency))), currencyNumbers[currency], currency)
+	b.segment("VA1", value(paid.init), count(paid.init), value(paid.reset), count(paid.reset))
+	b.segment("CA2", value(cash.init), count(cash.init), value(cash.reset), count(cash.reset))
+	b.segment("CA3", value(cashIn.reset), "", value(cashIn.reset), "", value(cashIn.init), "", value(cashIn.init))
+	b.segment("CA4", value(dispensed.reset), "", value(dispensed.init))
+	b.segment("CA15", strconv.FormatInt(CashTotal(a.CashBox.Denominations), 10))
+	b.segment("DA2", value(cashless.init), count(cashless.init), value(cashless.reset), count(cashless.reset))
+	for _, slot := range a.Slots {
+		id := SlotID(slot)
+		price := ""
+		if p := SlotPrice(slot); p != nil && p.Currency == currency {
+			price = strconv.FormatInt(p.Amount, 10)
+		}
+		soda := ""
+		if slot.OccupiedSoda != nil {
+			soda = SodaID(*slot.OccupiedSoda)
+		}
+		c := columns[strings.ToLower(id)]
+		if c == nil {
+			c = &dexCounters{}
+		}
+		b.segment("PA1", id, price, soda)
+		b.segment("PA2", count(c.init), value(c.init), count(c.reset), value(c.reset))
+	}
+	b.segment("G85", fmt.Sprintf("%04X", crc16(b.set())))
+	b.segment("SE", strconv.Itoa(b.segments+1), "0001")
+	b.segment("DXE", "1", "1")
+	return b.buf.Bytes()
+}
+
+func value(c dexCounter) string { return strconv.FormatInt(c.value, 10) }
+func count(c dexCounter) string { return strconv.FormatInt(c.count, 10) }
+
+func truncate(s string, n int) string {
+	if len(s) > n {
+		return s[:n]
+	}
+	return s
+}
+
+// dexBuilder writes DEX segments: elements separated by asterisks, trailing
+// empty elements left out, and every segment ended by CR LF. Elements are
+// cleaned with dexElement.
+type dexBuilder struct {
+	buf bytes.Buffer
+	// from is the offset of the transaction set and segments the number of
+	// segments written since.
+	from     int
+	segments int
+}
+
+func (b *dexBuilder) segment(id string, elements ...string) {
+	clean := make([]string, len(elements))
+	for i, e := range elements {
+		clean[i] = dexElement(e)
+	}
+	for len(clean) > 0 && clean[len(clean)-1] == "" {
+		clean = clean[:len(clean)-1]
+	}
+	b.buf.WriteString(id)
+	for _, e := range clean {
+		b.buf.WriteString("*" + e)
+	}
+	b.buf.WriteString("\r\n")
+	b.segments++
+}
+
+// dexElement returns e as it can be written in a segment: DEX files are
+// printable ASCII, and asterisks separate elements and CR LF segments, so
+// everything else is left out.
+func dexElement(e string) string {
+	return strings.Map(func(r rune) rune {
+		if r < ' ' || r > '~' || r == '*' {
+			return -1
+		}
+		return r
+	}, e)
+}
+
+// start marks the next segment as the start of the transaction set.
+func (b *dexBuilder) start() {
+	b.from, b.segments = b.buf.Len(), 0
+}
+
+// set returns the transaction set written so far.
+func (b *dexBuilder) set() []byte {
+	return b.buf.Bytes()[b.from:]
+}
+
+// crc16 computes the CRC-16 (polynomial 0x8005, reflected, initial value 0)
+// DEX files are checked with.
+func crc16(data []byte) uint16 {
+	var crc uint16
+	for _, c := range data {
+		crc ^= uint16(c)
+		for i := 0; i < 8; i++ {
+			if crc&1 != 0 {
+				crc = crc>>1 ^ 0xA001
+			} else {
+				crc >>= 1
+			}
+		}
+	}
+	return crc
+}
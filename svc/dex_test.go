@@ -0,0 +1,28 @@
+package svc
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCRC16(t *testing.T) {
+	// The check value of CRC-16/ARC, the CRC EVA-DTS specifies for G85.
+	assert.Equal(t, uint16(0xBB3D), crc16([]byte("123456789")))
+	assert.Equal(t, uint16(0), crc16(nil))
+}
+
+func TestMarshalDEXCleansElements(t *testing.T) {
+	ptr := func(s string) *string { return &s }
+	dex := string(MarshalDEX(DEXAudit{
+		Machine: MachineIdentity{Serial: "VM1", Model: "M*1\r\nSE*1", Location: "Zürich\t", Asset: "\r\n"},
+		Slots:   []v1.VendingSlot{{Id: ptr("A1"), OccupiedSoda: &v1.Soda{Id: ptr("cola\n")}}},
+		CashBox: NewCashBox(),
+	}))
+	segments := strings.Split(strings.TrimSuffix(dex, "\r\n"), "\r\n")
+	assert.Equal(t, "ID1*VM1*M1SE1**Zrich", segments[2], "control and non-ASCII characters are left out")
+	assert.Equal(t, "PA1*A1**cola", segments[10])
+	assert.Len(t, segments, 15)
+}
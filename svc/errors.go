@@ -0,0 +1,25 @@
+package svc
+
+import "errors"
+
+// Sentinel errors returned by VendingStore implementations. Implementations
+// may wrap them with more detail, so callers should compare with errors.Is.
+var (
+	// ErrNotFound is returned when the requested slot does not exist.
+	ErrNotFound = errors.New("slot not found")
+	// ErrConflict is returned when a write collides with existing state,
+	// such as adding a slot that already exists.
+	ErrConflict = errors.New("slot conflict")
+	// ErrUnavailable is returned when the backend could not serve the request,
+	// including when the request's context was cancelled or timed out.
+	ErrUnavailable = errors.New("storage unavailable")
+	// ErrSoldOut is returned by a purchase when the slot has nothing left to
+	// vend.
+	ErrSoldOut = errors.New("sold out")
+	// ErrInsufficientFunds is returned by a purchase when the payment does not
+	// cover the cost of the soda.
+	ErrInsufficientFunds = errors.New("insufficient funds")
+	// ErrVersionMismatch is returned by a conditional write when the slot no
+	// longer has the version the caller expected.
+	ErrVersionMismatch = errors.New("slot version mismatch")
+)
@@ -0,0 +1,218 @@
+package svc
+
+import (
+	"cmp"
+	v1 "colaco-api/internal/api/v1"
+	"sort"
+	"strings"
+)
+
+// StockStatus selects slots by the stock they have left.
+type StockStatus string
+
+const (
+	// InStock slots hold at least one soda.
+	InStock StockStatus = "in_stock"
+	// LowStock slots hold at least one soda but no more than a fifth of
+	// their maximum quantity, and are due for a restock.
+	LowStock StockStatus = "low_stock"
+	// SoldOut slots hold no soda.
+	SoldOut StockStatus = "sold_out"
+)
+
+// SlotOrder is what a SlotQuery orders slots by. Slots without the value,
+// such as those whose soda has no calories, come first.
+type SlotOrder string
+
+const (
+	OrderByID       SlotOrder = "id"
+	OrderByName     SlotOrder = "name"
+	OrderByPrice    SlotOrder = "price"
+	OrderByCalories SlotOrder = "calories"
+	OrderByOunces   SlotOrder = "ounces"
+	OrderByQuantity SlotOrder = "quantity"
+)
+
+// ValidSlotOrder reports whether slots can be ordered by order.
+func ValidSlotOrder(order SlotOrder) bool {
+	switch order {
+	case OrderByID, OrderByName, OrderByPrice, OrderByCalories, OrderByOunces, OrderByQuantity:
+		return true
+	}
+	return false
+}
+
+// SlotQueryStorage is implemented by VendingStorageInterface backends that
+// select, order and page slots themselves, such as with SQL. NewLegacyStore
+// uses it when available and otherwise queries the slots of GetSlots with
+// QuerySlots.
+type SlotQueryStorage interface {
+	// QuerySlots returns the slots selected by q, in its order.
+	QuerySlots(q SlotQuery) ([]v1.VendingSlot, error)
+}
+
+// SlotQuery selects slots of the inventory, orders them and pages through
+// them. Zero fields match every slot, so the zero query lists every slot
+// ordered by ID, like GetSlots.
+type SlotQuery struct {
+	// Name selects the slots whose soda name contains it, compared
+	// case-insensitively.
+	Name string
+	// MinPrice and MaxPrice select the slots priced at least and at most
+	// their amount. Slots priced in another currency are not selected.
+	MinPrice *v1.Money
+	MaxPrice *v1.Money
+	// MinCalories, MaxCalories, MinOunces and MaxOunces bound the calories
+	// and ounces of the soda, inclusive. Slots whose soda lacks the value
+	// are not selected by a bound on it.
+	MinCalories *int
+	MaxCalories *int
+	MinOunces   *float32
+	MaxOunces   *float32
+	Stock       StockStatus
+	// OrderBy orders the slots, by ID when empty. Ties are broken by slot
+	// ID, so the order is stable. Descending reverses the whole order.
+	OrderBy    SlotOrder
+	Descending bool
+	// After selects the slots that come after it in the order, to continue
+	// listing after the last one seen. Only its ID and the value the slots
+	// are ordered by are compared.
+	After *v1.VendingSlot
+	// Limit caps the number of slots returned.
+	Limit int
+}
+
+// Matches reports whether slot is selected by the filters of q, ignoring
+// After and Limit.
+func (q SlotQuery) Matches(slot v1.VendingSlot) bool {
+	var soda v1.Soda
+	if slot.OccupiedSoda != nil {
+		soda = *slot.OccupiedSoda
+	}
+	price := SlotPrice(slot)
+	qty := derefInt(slot.Quantity)
+	switch {
+	case q.Name != "" && (soda.Name == nil || !strings.Contains(strings.ToLower(*soda.Name), strings.ToLower(q.Name))),
+		q.MinPrice != nil && (price == nil || !strings.EqualFold(price.Currency, q.MinPrice.Currency) || price.Amount < q.MinPrice.Amount),
+		q.MaxPrice != nil && (price == nil || !strings.EqualFold(price.Currency, q.MaxPrice.Currency) || price.Amount > q.MaxPrice.Amount),
+		q.MinCalories != nil && (soda.Calories == nil || *soda.Calories < *q.MinCalories),
+		q.MaxCalories != nil && (soda.Calories == nil || *soda.Calories > *q.MaxCalories),
+		q.MinOunces != nil && (soda.Ounces == nil || *soda.Ounces < *q.MinOunces),
+		q.MaxOunces != nil && (soda.Ounces == nil || *soda.Ounces > *q.MaxOunces),
+		q.Stock == InStock && qty < 1,
+		q.Stock == LowStock && (qty < 1 || slot.MaxQuantity == nil || qty*5 > *slot.MaxQuantity),
+		q.Stock == SoldOut && qty > 0:
+		return false
+	}
+	return true
+}
+
+// Compare returns a negative number when a comes before b in the order of
+// q, a positive number when it comes after it, and zero for the same slot.
+func (q SlotQuery) Compare(a, b v1.VendingSlot) int {
+	var c int
+	switch q.OrderBy {
+	case OrderByName:
+		c = compareOptional(lowerSodaName(a), lowerSodaName(b))
+	case OrderByPrice:
+		c = compareOptional(priceAmount(a), priceAmount(b))
+	case OrderByCalories:
+		c = compareOptional(sodaField(a, func(s v1.Soda) *int { return s.Calories }),
+			sodaField(b, func(s v1.Soda) *int { return s.Calories }))
+	case OrderByOunces:
+		c = compareOptional(sodaField(a, func(s v1.Soda) *float32 { return s.Ounces }),
+			sodaField(b, func(s v1.Soda) *float32 { return s.Ounces }))
+	case OrderByQuantity:
+		c = compareOptional(a.Quantity, b.Quantity)
+	}
+	if c == 0 {
+		c = strings.Compare(strings.ToLower(SlotID(a)), strings.ToLower(SlotID(b)))
+	}
+	if q.Descending {
+		c = -c
+	}
+	return c
+}
+
+// OrderValue returns the value q orders slot by, or nil when the slot lacks
+// it or the slots are ordered by ID: the lower-cased name of the soda, the
+// amount of the price, the calories or ounces of the soda as an int and a
+// float64, or the quantity. Stores that run the query themselves, such as in
+// SQL, compare it to continue after q.After.
+func (q SlotQuery) OrderValue(slot v1.VendingSlot) any {
+	switch q.OrderBy {
+	case OrderByName:
+		if name := lowerSodaName(slot); name != nil {
+			return *name
+		}
+	case OrderByPrice:
+		if amount := priceAmount(slot); amount != nil {
+			return *amount
+		}
+	case OrderByCalories:
+		if calories := sodaField(slot, func(s v1.Soda) *int { return s.Calories }); calories != nil {
+			return *calories
+		}
+	case OrderByOunces:
+		if ounces := sodaField(slot, func(s v1.Soda) *float32 { return s.Ounces }); ounces != nil {
+			return float64(*ounces)
+		}
+	case OrderByQuantity:
+		if slot.Quantity != nil {
+			return *slot.Quantity
+		}
+	}
+	return nil
+}
+
+// compareOptional compares a and b, a missing value coming first.
+func compareOptional[T cmp.Ordered](a, b *T) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+	return cmp.Compare(*a, *b)
+}
+
+func sodaField[T any](slot v1.VendingSlot, field func(v1.Soda) *T) *T {
+	if slot.OccupiedSoda == nil {
+		return nil
+	}
+	return field(*slot.OccupiedSoda)
+}
+
+func lowerSodaName(slot v1.VendingSlot) *string {
+	name := sodaField(slot, func(s v1.Soda) *string { return s.Name })
+	if name == nil {
+		return nil
+	}
+	lower := strings.ToLower(*name)
+	return &lower
+}
+
+func priceAmount(slot v1.VendingSlot) *int64 {
+	if price := SlotPrice(slot); price != nil {
+		return &price.Amount
+	}
+	return nil
+}
+
+// QuerySlots returns the slots of slots selected by q, in its order, up to
+// q.Limit of them.
+func QuerySlots(slots []v1.VendingSlot, q SlotQuery) []v1.VendingSlot {
+	matched := []v1.VendingSlot{}
+	for _, slot := range slots {
+		if q.Matches(slot) && (q.After == nil || q.Compare(slot, *q.After) > 0) {
+			matched = append(matched, slot)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool { return q.Compare(matched[i], matched[j]) < 0 })
+	if q.Limit > 0 && len(matched) > q.Limit {
+		matched = matched[:q.Limit]
+	}
+	return matched
+}
@@ -0,0 +1,100 @@
+package svc
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"strings"
+	"time"
+)
+
+// LedgerStorage is implemented by VendingStorageInterface backends that
+// persist the transaction ledger. NewLegacyStore keeps the ledger in memory
+// for backends that do not.
+type LedgerStorage interface {
+	// AppendTransaction gives tx the ID following the last one, stores it
+	// and returns it. Stored transactions are never changed.
+	AppendTransaction(tx v1.Transaction) (v1.Transaction, error)
+	// Transactions returns the transactions matching filter, oldest first.
+	Transactions(filter TransactionFilter) ([]v1.Transaction, error)
+}
+
+// TransactionFilter selects transactions from the ledger. Zero fields match
+// every transaction.
+type TransactionFilter struct {
+	Operation v1.TransactionOperation
+	// SlotID, SodaID and Actor are compared case-insensitively.
+	SlotID string
+	SodaID string
+	Actor  string
+	// Since and Until select the transactions recorded at or after Since
+	// and before Until.
+	Since time.Time
+	Until time.Time
+	// After selects the transactions with a higher ID, to continue listing
+	// after the last one seen.
+	After int64
+	// Limit caps the number of transactions returned.
+	Limit int
+}
+
+// Matches reports whether tx is selected by f, ignoring Limit.
+func (f TransactionFilter) Matches(tx v1.Transaction) bool {
+	switch {
+	case f.Operation != "" && tx.Operation != f.Operation,
+		f.SlotID != "" && !strings.EqualFold(tx.SlotId, f.SlotID),
+		f.SodaID != "" && (tx.SodaId == nil || !strings.EqualFold(*tx.SodaId, f.SodaID)),
+		f.Actor != "" && !strings.EqualFold(tx.Actor, f.Actor),
+		!f.Since.IsZero() && tx.Timestamp.Before(f.Since),
+		!f.Until.IsZero() && !tx.Timestamp.Before(f.Until),
+		f.After > 0 && TransactionID(tx) <= f.After:
+		return false
+	}
+	return true
+}
+
+// FilterTransactions returns the transactions of txs, which are ordered
+// oldest first, that match f, up to f.Limit of them.
+func FilterTransactions(txs []v1.Transaction, f TransactionFilter) []v1.Transaction {
+	matched := []v1.Transaction{}
+	for _, tx := range txs {
+		if f.Limit > 0 && len(matched) == f.Limit {
+			break
+		}
+		if f.Matches(tx) {
+			matched = append(matched, tx)
+		}
+	}
+	return matched
+}
+
+// TransactionID returns the ID of tx, or 0 before it was stored.
+func TransactionID(tx v1.Transaction) int64 {
+	if tx.Id == nil {
+		return 0
+	}
+	return *tx.Id
+}
+
+// NewTransaction returns a transaction of op on slot as it is after the
+// operation, recorded now.
+func NewTransaction(op v1.TransactionOperation, slot v1.VendingSlot) v1.Transaction {
+	tx := v1.Transaction{
+		Operation: op,
+		SlotId:    SlotID(slot),
+		Timestamp: time.Now().UTC(),
+		Price:     SlotPrice(slot),
+	}
+	if slot.Quantity != nil {
+		qty := *slot.Quantity
+		tx.QuantityAfter = &qty
+	}
+	if slot.OccupiedSoda != nil {
+		if id := SodaID(*slot.OccupiedSoda); id != "" {
+			tx.SodaId = &id
+		}
+		if slot.OccupiedSoda.Name != nil {
+			name := *slot.OccupiedSoda.Name
+			tx.SodaName = &name
+		}
+	}
+	return tx
+}
@@ -0,0 +1,528 @@
+package svc
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LegacyStore adapts a VendingStorageInterface to VendingStore. The legacy
+// interface cannot distinguish a missing slot from a failing backend for some
+// methods, so the adapter checks for existence first and treats any other
+// error as ErrUnavailable. Check-then-act sequences are serialized by the
+// adapter, which makes them atomic for a single process. Backends that
+// implement AtomicDecrementer or SlotUpdater have those operations delegated
+// to them instead, and so are the soda catalog, the cash box, the ledger and
+// the closed periods for backends implementing SodaCatalog, CashBoxStorage,
+// LedgerStorage and DayCloseStorage, slot queries for backends implementing
+// SlotQueryStorage and replacing every slot for backends implementing
+// SlotReplacer; for other backends the cash box, the ledger and the closed
+// periods only live as long as the adapter. The adapter records the name a
+// slot is written under as its ID and keeps the exact price and the
+// deprecated float cost of the slots it writes and returns in step, see
+// WithPrice.
+type LegacyStore struct {
+	Storage VendingStorageInterface
+	m       sync.Mutex
+	cash    *v1.CashBox
+	ledger  []v1.Transaction
+	closes  []v1.DayClose
+	// versions stamps the slots of backends without versions.
+	versions SlotVersions
+}
+
+var _ VendingStore = (*LegacyStore)(nil)
+
+// NewLegacyStore wraps s so that it can be used wherever a VendingStore is
+// expected.
+func NewLegacyStore(s VendingStorageInterface) *LegacyStore {
+	return &LegacyStore{Storage: s}
+}
+
+func unavailable(err error) error {
+	return fmt.Errorf("%w: %w", ErrUnavailable, err)
+}
+
+func notFound(name string) error {
+	return fmt.Errorf("%w: %q", ErrNotFound, name)
+}
+
+// versioned reports whether the backend maintains slot versions itself.
+func (l *LegacyStore) versioned() (SlotUpdater, bool) {
+	u, ok := l.Storage.(SlotUpdater)
+	return u, ok
+}
+
+// get returns the stored slot or ErrNotFound. The caller must hold l.m when
+// it goes on to write based on the result.
+func (l *LegacyStore) get(name string) (v1.VendingSlot, error) {
+	slot, found, err := l.Storage.GetSlot(name)
+	if err != nil {
+		return v1.VendingSlot{}, unavailable(err)
+	}
+	if !found {
+		return v1.VendingSlot{}, notFound(name)
+	}
+	return WithPrice(slot), nil
+}
+
+// priced applies WithPrice to a slot returned by a backend capability.
+func priced(slot v1.VendingSlot, err error) (v1.VendingSlot, error) {
+	return WithPrice(slot), err
+}
+
+// updateSlot emulates SlotUpdater.UpdateSlot for backends without versions.
+// The caller must hold l.m.
+func (l *LegacyStore) updateSlot(name string, fn func(slot *v1.VendingSlot) error) (v1.VendingSlot, error) {
+	prev, err := l.get(name)
+	if err != nil {
+		return v1.VendingSlot{}, err
+	}
+	next := prev
+	if err := fn(&next); err != nil {
+		return v1.VendingSlot{}, err
+	}
+	l.versions.Next(&prev, &next)
+	l.Storage.UpsertSlot(name, next)
+	return next, nil
+}
+
+func (l *LegacyStore) GetSlot(ctx context.Context, name string) (v1.VendingSlot, error) {
+	if err := CheckContext(ctx); err != nil {
+		return v1.VendingSlot{}, err
+	}
+	return l.get(name)
+}
+
+func (l *LegacyStore) GetSlots(ctx context.Context) ([]v1.VendingSlot, error) {
+	if err := CheckContext(ctx); err != nil {
+		return nil, err
+	}
+	slots := l.Storage.GetSlots()
+	for i := range slots {
+		slots[i] = WithPrice(slots[i])
+	}
+	return slots, nil
+}
+
+func (l *LegacyStore) QuerySlots(ctx context.Context, q SlotQuery) ([]v1.VendingSlot, error) {
+	s, ok := l.Storage.(SlotQueryStorage)
+	if !ok {
+		slots, err := l.GetSlots(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return QuerySlots(slots, q), nil
+	}
+	if err := CheckContext(ctx); err != nil {
+		return nil, err
+	}
+	slots, err := s.QuerySlots(q)
+	if err != nil {
+		return nil, unavailable(err)
+	}
+	for i := range slots {
+		slots[i] = WithPrice(slots[i])
+	}
+	return slots, nil
+}
+
+func (l *LegacyStore) AddSlot(ctx context.Context, name string, slot v1.VendingSlot) error {
+	if err := CheckContext(ctx); err != nil {
+		return err
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	_, found, err := l.Storage.GetSlot(name)
+	if err != nil {
+		return unavailable(err)
+	}
+	if found {
+		return fmt.Errorf("%w: %q already exists", ErrConflict, name)
+	}
+	PrepareSlot(name, &slot)
+	if _, ok := l.versioned(); !ok {
+		l.versions.Next(nil, &slot)
+	}
+	l.Storage.AddSlot(name, slot)
+	return nil
+}
+
+func (l *LegacyStore) UpsertSlot(ctx context.Context, name string, slot v1.VendingSlot) error {
+	if err := CheckContext(ctx); err != nil {
+		return err
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	PrepareSlot(name, &slot)
+	if _, ok := l.versioned(); !ok {
+		prev, found, err := l.Storage.GetSlot(name)
+		if err != nil {
+			return unavailable(err)
+		}
+		if found {
+			l.versions.Next(&prev, &slot)
+		} else {
+			l.versions.Next(nil, &slot)
+		}
+	}
+	l.Storage.UpsertSlot(name, slot)
+	return nil
+}
+
+func (l *LegacyStore) DeleteSlot(ctx context.Context, name string) error {
+	if err := CheckContext(ctx); err != nil {
+		return err
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	deleted, err := l.Storage.DeleteSlot(name)
+	if err != nil {
+		return unavailable(err)
+	}
+	if !deleted {
+		return notFound(name)
+	}
+	return nil
+}
+
+// UpdatePrice sets the exact price through UpdateSlot, as the legacy
+// UpdatePrice only takes a float.
+func (l *LegacyStore) UpdatePrice(ctx context.Context, name string, price v1.Money) error {
+	_, err := l.UpdateSlot(ctx, name, func(slot *v1.VendingSlot) error {
+		SetPrice(slot, price)
+		return nil
+	})
+	return err
+}
+
+func (l *LegacyStore) UpdateQuantity(ctx context.Context, name string, qty int) error {
+	if err := CheckContext(ctx); err != nil {
+		return err
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	if _, ok := l.versioned(); !ok {
+		_, err := l.updateSlot(name, func(slot *v1.VendingSlot) error {
+			slot.Quantity = &qty
+			return nil
+		})
+		return err
+	}
+	if _, err := l.get(name); err != nil {
+		return err
+	}
+	if err := l.Storage.UpdateQuantity(name, qty); err != nil {
+		return unavailable(err)
+	}
+	return nil
+}
+
+func (l *LegacyStore) DecrementIfAvailable(ctx context.Context, name string, payment v1.Money) (v1.VendingSlot, error) {
+	if err := CheckContext(ctx); err != nil {
+		return v1.VendingSlot{}, err
+	}
+	if d, ok := l.Storage.(AtomicDecrementer); ok {
+		return priced(d.DecrementIfAvailable(name, payment))
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	slot, err := l.get(name)
+	if err != nil {
+		return v1.VendingSlot{}, err
+	}
+	if err := CheckPurchase(slot, payment); err != nil {
+		return slot, err
+	}
+	return l.updateSlot(name, func(slot *v1.VendingSlot) error {
+		qty := *slot.Quantity - 1
+		slot.Quantity = &qty
+		return nil
+	})
+}
+
+func (l *LegacyStore) UpdateSlot(ctx context.Context, name string, fn func(slot *v1.VendingSlot) error) (v1.VendingSlot, error) {
+	if err := CheckContext(ctx); err != nil {
+		return v1.VendingSlot{}, err
+	}
+	if u, ok := l.versioned(); ok {
+		return priced(u.UpdateSlot(name, PricedUpdate(fn)))
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	return l.updateSlot(name, PricedUpdate(fn))
+}
+
+func (l *LegacyStore) DeleteSlotIf(ctx context.Context, name string, check func(slot v1.VendingSlot) error) (v1.VendingSlot, error) {
+	if err := CheckContext(ctx); err != nil {
+		return v1.VendingSlot{}, err
+	}
+	if u, ok := l.versioned(); ok {
+		return priced(u.DeleteSlotIf(name, func(slot v1.VendingSlot) error {
+			return check(WithPrice(slot))
+		}))
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	slot, err := l.get(name)
+	if err != nil {
+		return v1.VendingSlot{}, err
+	}
+	if err := check(slot); err != nil {
+		return v1.VendingSlot{}, err
+	}
+	deleted, err := l.Storage.DeleteSlot(name)
+	if err != nil {
+		return v1.VendingSlot{}, unavailable(err)
+	}
+	if !deleted {
+		return v1.VendingSlot{}, notFound(name)
+	}
+	return slot, nil
+}
+
+func (l *LegacyStore) ReplaceSlots(ctx context.Context, fn func(slots []v1.VendingSlot) ([]v1.VendingSlot, error)) ([]v1.VendingSlot, error) {
+	if err := CheckContext(ctx); err != nil {
+		return nil, err
+	}
+	replace := func(slots []v1.VendingSlot) ([]v1.VendingSlot, error) {
+		for i := range slots {
+			slots[i] = WithPrice(slots[i])
+		}
+		next, err := fn(slots)
+		if err != nil {
+			return nil, err
+		}
+		for i := range next {
+			PrepareSlot(SlotID(next[i]), &next[i])
+		}
+		return next, nil
+	}
+	if r, ok := l.Storage.(SlotReplacer); ok {
+		slots, err := r.ReplaceSlots(replace)
+		for i := range slots {
+			slots[i] = WithPrice(slots[i])
+		}
+		return slots, err
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	current := l.Storage.GetSlots()
+	next, err := replace(current)
+	if err != nil {
+		return nil, err
+	}
+	keep := map[string]bool{}
+	for _, slot := range next {
+		keep[strings.ToLower(SlotID(slot))] = true
+	}
+	for _, slot := range current {
+		if keep[strings.ToLower(SlotID(slot))] {
+			continue
+		}
+		if _, err := l.Storage.DeleteSlot(SlotID(slot)); err != nil {
+			return nil, unavailable(err)
+		}
+	}
+	_, versioned := l.versioned()
+	for _, slot := range next {
+		if !versioned {
+			prev, found, err := l.Storage.GetSlot(SlotID(slot))
+			if err != nil {
+				return nil, unavailable(err)
+			}
+			if found {
+				l.versions.Next(&prev, &slot)
+			} else {
+				l.versions.Next(nil, &slot)
+			}
+		}
+		l.Storage.UpsertSlot(SlotID(slot), slot)
+	}
+	slots := l.Storage.GetSlots()
+	for i := range slots {
+		slots[i] = WithPrice(slots[i])
+	}
+	return slots, nil
+}
+
+func (l *LegacyStore) GetSoda(ctx context.Context, id string) (v1.Soda, error) {
+	if err := CheckContext(ctx); err != nil {
+		return v1.Soda{}, err
+	}
+	if c, ok := l.Storage.(SodaCatalog); ok {
+		soda, found, err := c.GetSoda(id)
+		if err != nil {
+			return v1.Soda{}, unavailable(err)
+		}
+		if !found {
+			return v1.Soda{}, fmt.Errorf("%w: soda %q", ErrNotFound, id)
+		}
+		return soda, nil
+	}
+	for _, soda := range CatalogFromSlots(l.Storage.GetSlots()) {
+		if SodaID(soda) == strings.ToLower(id) {
+			return soda, nil
+		}
+	}
+	return v1.Soda{}, fmt.Errorf("%w: soda %q", ErrNotFound, id)
+}
+
+func (l *LegacyStore) GetSodas(ctx context.Context) ([]v1.Soda, error) {
+	if err := CheckContext(ctx); err != nil {
+		return nil, err
+	}
+	if c, ok := l.Storage.(SodaCatalog); ok {
+		return c.GetSodas(), nil
+	}
+	return CatalogFromSlots(l.Storage.GetSlots()), nil
+}
+
+func (l *LegacyStore) GetCashBox(ctx context.Context) (v1.CashBox, error) {
+	if err := CheckContext(ctx); err != nil {
+		return v1.CashBox{}, err
+	}
+	if c, ok := l.Storage.(CashBoxStorage); ok {
+		box, err := c.GetCashBox()
+		if err != nil {
+			return v1.CashBox{}, unavailable(err)
+		}
+		return box, nil
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	if l.cash == nil {
+		return NormalizeCashBox(NewCashBox()), nil
+	}
+	return NormalizeCashBox(*l.cash), nil
+}
+
+func (l *LegacyStore) UpdateCashBox(ctx context.Context, fn func(box *v1.CashBox) error) (v1.CashBox, error) {
+	if err := CheckContext(ctx); err != nil {
+		return v1.CashBox{}, err
+	}
+	if c, ok := l.Storage.(CashBoxStorage); ok {
+		// An error of fn is returned as the backend passes it on, any
+		// other as ErrUnavailable.
+		var rejected error
+		box, err := c.UpdateCashBox(func(box *v1.CashBox) error {
+			rejected = fn(box)
+			return rejected
+		})
+		if rejected != nil {
+			return v1.CashBox{}, err
+		}
+		if err != nil {
+			return v1.CashBox{}, unavailable(err)
+		}
+		return box, nil
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	box := NewCashBox()
+	if l.cash != nil {
+		box = NormalizeCashBox(*l.cash)
+	}
+	if err := fn(&box); err != nil {
+		return v1.CashBox{}, err
+	}
+	box = NormalizeCashBox(box)
+	l.cash = &box
+	return box, nil
+}
+
+func (l *LegacyStore) AppendTransaction(ctx context.Context, tx v1.Transaction) (v1.Transaction, error) {
+	if err := CheckContext(ctx); err != nil {
+		return v1.Transaction{}, err
+	}
+	if tx.Timestamp.IsZero() {
+		tx.Timestamp = time.Now().UTC()
+	}
+	if s, ok := l.Storage.(LedgerStorage); ok {
+		stored, err := s.AppendTransaction(tx)
+		if err != nil {
+			return v1.Transaction{}, unavailable(err)
+		}
+		return stored, nil
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	id := int64(len(l.ledger)) + 1
+	tx.Id = &id
+	l.ledger = append(l.ledger, tx)
+	return tx, nil
+}
+
+func (l *LegacyStore) GetTransactions(ctx context.Context, filter TransactionFilter) ([]v1.Transaction, error) {
+	if err := CheckContext(ctx); err != nil {
+		return nil, err
+	}
+	if s, ok := l.Storage.(LedgerStorage); ok {
+		txs, err := s.Transactions(filter)
+		if err != nil {
+			return nil, unavailable(err)
+		}
+		return txs, nil
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	return FilterTransactions(l.ledger, filter), nil
+}
+
+func (l *LegacyStore) AppendDayClose(ctx context.Context, report v1.DayClose) (v1.DayClose, error) {
+	if err := CheckContext(ctx); err != nil {
+		return v1.DayClose{}, err
+	}
+	if s, ok := l.Storage.(DayCloseStorage); ok {
+		stored, err := s.AppendDayClose(report)
+		if errors.Is(err, ErrPeriodClosed) {
+			return v1.DayClose{}, err
+		}
+		if err != nil {
+			return v1.DayClose{}, unavailable(err)
+		}
+		return stored, nil
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	if err := CheckDayClose(l.closes, report); err != nil {
+		return v1.DayClose{}, err
+	}
+	id := int64(len(l.closes)) + 1
+	report.Id = &id
+	l.closes = append(l.closes, report)
+	return report, nil
+}
+
+func (l *LegacyStore) GetDayCloses(ctx context.Context) ([]v1.DayClose, error) {
+	if err := CheckContext(ctx); err != nil {
+		return nil, err
+	}
+	if s, ok := l.Storage.(DayCloseStorage); ok {
+		closes, err := s.DayCloses()
+		if err != nil {
+			return nil, unavailable(err)
+		}
+		return closes, nil
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	return append([]v1.DayClose{}, l.closes...), nil
+}
+
+func (l *LegacyStore) GetDayClose(ctx context.Context, id int64) (v1.DayClose, error) {
+	closes, err := l.GetDayCloses(ctx)
+	if err != nil {
+		return v1.DayClose{}, err
+	}
+	for _, report := range closes {
+		if DayCloseID(report) == id {
+			return report, nil
+		}
+	}
+	return v1.DayClose{}, fmt.Errorf("%w: %d", ErrPeriodNotFound, id)
+}
@@ -0,0 +1,315 @@
+package svc
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LoginPolicy says how many failed logins are tolerated before further
+// attempts are delayed, and after how many they are locked out.
+type LoginPolicy struct {
+	// FreeFailures is the number of failures that do not delay the next
+	// attempt.
+	FreeFailures int
+	// BaseDelay is the delay after the first failure past FreeFailures. It
+	// doubles with every further failure, up to MaxDelay.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// LockoutFailures is the number of failures that lock out for
+	// LockoutDuration, and every failure after that locks out again.
+	LockoutFailures int
+	LockoutDuration time.Duration
+	// ResetAfter is how long after the last failure the failures are
+	// forgotten.
+	ResetAfter time.Duration
+}
+
+var (
+	// DefaultUserLoginPolicy applies to the failed logins of a username.
+	DefaultUserLoginPolicy = LoginPolicy{
+		FreeFailures:    3,
+		BaseDelay:       time.Second,
+		MaxDelay:        5 * time.Minute,
+		LockoutFailures: 10,
+		LockoutDuration: 15 * time.Minute,
+		ResetAfter:      time.Hour,
+	}
+	// DefaultIPLoginPolicy applies to the failed logins from a client
+	// address. It tolerates more failures than DefaultUserLoginPolicy, as
+	// many people may log in from behind the same address.
+	DefaultIPLoginPolicy = LoginPolicy{
+		FreeFailures:    20,
+		BaseDelay:       time.Second,
+		MaxDelay:        time.Minute,
+		LockoutFailures: 100,
+		LockoutDuration: 15 * time.Minute,
+		ResetAfter:      time.Hour,
+	}
+)
+
+// block returns how long attempts are blocked after the failures-th failure,
+// and whether that is a lockout rather than a delay.
+func (p LoginPolicy) block(failures int) (time.Duration, bool) {
+	switch {
+	case p.LockoutFailures > 0 && failures >= p.LockoutFailures:
+		return p.LockoutDuration, true
+	case failures <= p.FreeFailures:
+		return 0, false
+	}
+	delay := p.BaseDelay
+	for i := p.FreeFailures + 1; i < failures && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, p.MaxDelay), false
+}
+
+// LoginEvent is a lockout started by LoginThrottle.Failed.
+type LoginEvent struct {
+	Kind     v1.LoginLockoutKind
+	Value    string
+	Failures int
+	Until    time.Time
+}
+
+type loginKey struct {
+	kind  v1.LoginLockoutKind
+	value string
+}
+
+type loginFailures struct {
+	count int
+	// pending is the number of attempts allowed whose outcome is not known
+	// yet.
+	pending int
+	last    time.Time
+	until   time.Time
+}
+
+// LoginThrottle slows down password guessing. It counts the failed logins of
+// every username and client address and blocks their attempts for a time
+// that grows exponentially with the failures, up to a lockout, following a
+// LoginPolicy for usernames and another for addresses. The failures are kept
+// in memory, so a restart forgets them.
+type LoginThrottle struct {
+	users    LoginPolicy
+	ips      LoginPolicy
+	onLock   func(LoginEvent)
+	failures map[loginKey]*loginFailures
+	m        sync.Mutex
+}
+
+// WithLoginPolicies sets the policies for the failed logins of usernames and
+// of client addresses.
+func WithLoginPolicies(users, ips LoginPolicy) func(*LoginThrottle) {
+	return func(t *LoginThrottle) {
+		t.users, t.ips = users, ips
+	}
+}
+
+// WithLockoutHandler sets a function called for every lockout, so it can be
+// audited. It is called after the throttle is unlocked, so it may use it.
+func WithLockoutHandler(fn func(LoginEvent)) func(*LoginThrottle) {
+	return func(t *LoginThrottle) {
+		t.onLock = fn
+	}
+}
+
+// NewLoginThrottle returns a throttle following DefaultUserLoginPolicy and
+// DefaultIPLoginPolicy unless configured otherwise.
+func NewLoginThrottle(options ...func(*LoginThrottle)) *LoginThrottle {
+	t := &LoginThrottle{
+		users:    DefaultUserLoginPolicy,
+		ips:      DefaultIPLoginPolicy,
+		failures: make(map[loginKey]*loginFailures),
+	}
+	for _, option := range options {
+		option(t)
+	}
+	return t
+}
+
+func (t *LoginThrottle) keys(username, ip string) []loginKey {
+	return []loginKey{
+		{v1.Username, strings.ToLower(username)},
+		{v1.Ip, ip},
+	}
+}
+
+// entry returns the failures of key, adding them if there are none.
+func (t *LoginThrottle) entry(key loginKey) *loginFailures {
+	f, ok := t.failures[key]
+	if !ok {
+		f = &loginFailures{}
+		t.failures[key] = f
+	}
+	return f
+}
+
+// settle ends an attempt reserved by Allow for key.
+func (t *LoginThrottle) settle(key loginKey) {
+	if f, ok := t.failures[key]; ok && f.pending > 0 {
+		f.pending--
+	}
+}
+
+func (t *LoginThrottle) policy(kind v1.LoginLockoutKind) LoginPolicy {
+	if kind == v1.Ip {
+		return t.ips
+	}
+	return t.users
+}
+
+// Allow returns how long the client at ip has to wait before it may try to
+// log in as username, zero if it may now. Attempts it is not allowed must be
+// refused without checking the password, and are not counted as failures.
+//
+// An attempt it allows is reserved, as if it might fail, until it is ended
+// by Failed, Succeeded or Release, one of which must be called. Attempts
+// made at the same time are thereby allowed no more often than one after
+// the other: while attempts are in flight, another has to wait as long as
+// their failures would block it.
+func (t *LoginThrottle) Allow(username, ip string) time.Duration {
+	t.m.Lock()
+	defer t.m.Unlock()
+	now := time.Now()
+	keys := t.keys(username, ip)
+	var wait time.Duration
+	for _, key := range keys {
+		f, ok := t.failures[key]
+		if !ok {
+			continue
+		}
+		wait = max(wait, f.until.Sub(now))
+		if f.pending > 0 {
+			block, _ := t.policy(key.kind).block(f.count + f.pending)
+			wait = max(wait, block)
+		}
+	}
+	if wait > 0 {
+		return wait
+	}
+	for _, key := range keys {
+		t.entry(key).pending++
+	}
+	return 0
+}
+
+// Failed ends the attempt allowed to log in as username from ip as a failure,
+// counts it against both, and blocks them as their policies say. Failures
+// older than the ResetAfter of their policy are forgotten first.
+func (t *LoginThrottle) Failed(username, ip string) {
+	// The lockout handler runs once the lock is released, as it may be slow
+	// or use the throttle itself.
+	for _, e := range t.failed(username, ip) {
+		t.onLock(e)
+	}
+}
+
+// failed counts the failure for Failed and returns the lockouts it started
+// for the lockout handler, if there is one.
+func (t *LoginThrottle) failed(username, ip string) []LoginEvent {
+	t.m.Lock()
+	defer t.m.Unlock()
+	now := time.Now()
+	for key, f := range t.failures {
+		if f.pending == 0 && now.Sub(f.last) > t.policy(key.kind).ResetAfter && now.After(f.until) {
+			delete(t.failures, key)
+		}
+	}
+	var locked []LoginEvent
+	for _, key := range t.keys(username, ip) {
+		t.settle(key)
+		f := t.entry(key)
+		f.count++
+		f.last = now
+		block, lockout := t.policy(key.kind).block(f.count)
+		f.until = now.Add(block)
+		if lockout && t.onLock != nil {
+			locked = append(locked, LoginEvent{Kind: key.kind, Value: key.value, Failures: f.count, Until: f.until})
+		}
+	}
+	return locked
+}
+
+// Succeeded ends the attempt allowed to log in as username from ip and
+// forgets the failures of username. Those of the address are kept, so that
+// logging in to an account of one's own does not allow guessing the
+// passwords of others.
+func (t *LoginThrottle) Succeeded(username, ip string) {
+	t.m.Lock()
+	defer t.m.Unlock()
+	keys := t.keys(username, ip)
+	for _, key := range keys {
+		t.settle(key)
+	}
+	if f, ok := t.failures[keys[0]]; ok {
+		f.count, f.until = 0, time.Time{}
+		if f.pending == 0 {
+			delete(t.failures, keys[0])
+		}
+	}
+}
+
+// Release ends the attempt allowed to log in as username from ip without
+// counting it, for attempts that neither failed nor succeeded, such as those
+// of disabled users or those the user directory could not answer.
+func (t *LoginThrottle) Release(username, ip string) {
+	t.m.Lock()
+	defer t.m.Unlock()
+	for _, key := range t.keys(username, ip) {
+		t.settle(key)
+	}
+}
+
+// Lockouts returns the usernames and addresses whose attempts are blocked
+// now, those locked out first and then by how long they are blocked.
+func (t *LoginThrottle) Lockouts() []v1.LoginLockout {
+	t.m.Lock()
+	defer t.m.Unlock()
+	now := time.Now()
+	lockouts := make([]v1.LoginLockout, 0)
+	for key, f := range t.failures {
+		if !f.until.After(now) {
+			continue
+		}
+		_, locked := t.policy(key.kind).block(f.count)
+		lockouts = append(lockouts, v1.LoginLockout{
+			Kind:         key.kind,
+			Value:        key.value,
+			Failures:     f.count,
+			BlockedUntil: f.until.UTC(),
+			LockedOut:    locked,
+		})
+	}
+	sort.Slice(lockouts, func(i, j int) bool {
+		if lockouts[i].LockedOut != lockouts[j].LockedOut {
+			return lockouts[i].LockedOut
+		}
+		if !lockouts[i].BlockedUntil.Equal(lockouts[j].BlockedUntil) {
+			return lockouts[i].BlockedUntil.After(lockouts[j].BlockedUntil)
+		}
+		return lockouts[i].Value < lockouts[j].Value
+	})
+	return lockouts
+}
+
+// Clear forgets the failures of username and of ip, either of which may be
+// empty, lifting their lockouts. It reports whether there were any.
+func (t *LoginThrottle) Clear(username, ip string) bool {
+	t.m.Lock()
+	defer t.m.Unlock()
+	cleared := false
+	for _, key := range t.keys(username, ip) {
+		if key.value == "" {
+			continue
+		}
+		if _, ok := t.failures[key]; ok {
+			delete(t.failures, key)
+			cleared = true
+		}
+	}
+	return cleared
+}
@@ -0,0 +1,118 @@
+package svc
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// DefaultCurrency is the currency of amounts given in the deprecated float
+// fields, and of slots priced before prices carried a currency.
+const DefaultCurrency = "USD"
+
+// ErrCurrencyMismatch is returned by a purchase paid in a different currency
+// than the soda is priced in.
+var ErrCurrencyMismatch = errors.New("currency mismatch")
+
+// minorUnits lists the ISO 4217 currencies whose minor unit is not a
+// hundredth of the major unit. Every other currency has two decimals.
+var minorUnits = map[string]int32{
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0,
+	"KRW": 0, "PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0,
+	"XAF": 0, "XOF": 0, "XPF": 0,
+}
+
+// decimals returns the number of decimals of the minor unit of currency.
+func decimals(currency string) int32 {
+	if n, ok := minorUnits[strings.ToUpper(currency)]; ok {
+		return n
+	}
+	return 2
+}
+
+// NewMoney converts amount, given in the major unit of currency such as
+// dollars, to Money, rounding to the nearest minor unit. The float is read as
+// the shortest decimal that represents it, so 1.1 becomes exactly 110 cents.
+func NewMoney(amount float32, currency string) v1.Money {
+	d := decimal.NewFromFloat32(amount).Shift(decimals(currency)).Round(0)
+	return v1.Money{Amount: d.IntPart(), Currency: strings.ToUpper(currency)}
+}
+
+// ParseMoney parses a decimal amount in the major unit of currency, such as
+// "1.50", without going through a float. Amounts finer than the minor unit
+// are rejected.
+func ParseMoney(amount, currency string) (v1.Money, error) {
+	d, err := decimal.NewFromString(amount)
+	if err != nil {
+		return v1.Money{}, fmt.Errorf("parsing amount %q: %w", amount, err)
+	}
+	minor := d.Shift(decimals(currency))
+	if !minor.IsInteger() {
+		return v1.Money{}, fmt.Errorf("amount %q is finer than the minor unit of %s", amount, currency)
+	}
+	return v1.Money{Amount: minor.IntPart(), Currency: strings.ToUpper(currency)}, nil
+}
+
+// MoneyFloat returns m in its major unit as a float, for the deprecated float
+// fields.
+func MoneyFloat(m v1.Money) float32 {
+	f, _ := decimal.New(m.Amount, -decimals(m.Currency)).Float64()
+	return float32(f)
+}
+
+// FormatMoney formats m for people, such as "1.50 USD".
+func FormatMoney(m v1.Money) string {
+	return FormatAmount(m) + " " + m.Currency
+}
+
+// FormatAmount formats the amount of m in its major unit without the
+// currency, such as "1.50".
+func FormatAmount(m v1.Money) string {
+	n := decimals(m.Currency)
+	return decimal.New(m.Amount, -n).StringFixed(n)
+}
+
+// SlotPrice returns the price of slot. Slots written before prices were
+// exact only have the deprecated Cost, which is taken to be in
+// DefaultCurrency. It returns nil for a slot without a price.
+func SlotPrice(slot v1.VendingSlot) *v1.Money {
+	if slot.Price != nil {
+		price := *slot.Price
+		return &price
+	}
+	if slot.Cost != nil {
+		price := NewMoney(*slot.Cost, DefaultCurrency)
+		return &price
+	}
+	return nil
+}
+
+// PriceCurrency returns the currency slot is priced in, or DefaultCurrency
+// when it has no price.
+func PriceCurrency(slot v1.VendingSlot) string {
+	if price := SlotPrice(slot); price != nil {
+		return price.Currency
+	}
+	return DefaultCurrency
+}
+
+// SetPrice sets the price of slot and keeps the deprecated Cost in step.
+func SetPrice(slot *v1.VendingSlot, price v1.Money) {
+	price.Currency = strings.ToUpper(price.Currency)
+	cost := MoneyFloat(price)
+	slot.Price = &price
+	slot.Cost = &cost
+}
+
+// WithPrice returns slot with its Price set from SlotPrice and the deprecated
+// Cost derived from it, so that readers of either field see the same price.
+func WithPrice(slot v1.VendingSlot) v1.VendingSlot {
+	if price := SlotPrice(slot); price != nil {
+		SetPrice(&slot, *price)
+	}
+	return slot
+}
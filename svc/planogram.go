@@ -0,0 +1,265 @@
+package svc
+
+import (
+	"bytes"
+	v1 "colaco-api/internal/api/v1"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ErrInvalidPlanogram is returned when a planogram does not describe a
+// machine that can be built, for example because it loads more sodas into a
+// coil than the coil holds.
+var ErrInvalidPlanogram = errors.New("invalid planogram")
+
+// SlotReplacer is implemented by VendingStorageInterface backends that can
+// replace all of their slots as one atomic write, as importing a planogram
+// does. ReplaceSlots passes the current slots to fn and stores the slots it
+// returns, named by their ID, instead: slots fn leaves out are deleted and
+// the others written with the version following the one they had. It
+// returns the slots stored afterwards. If fn returns an error nothing is
+// written and the error is returned unchanged. NewLegacyStore uses it when
+// available; for other backends it writes the slots one by one under its own
+// lock.
+type SlotReplacer interface {
+	ReplaceSlots(fn func(slots []v1.VendingSlot) ([]v1.VendingSlot, error)) ([]v1.VendingSlot, error)
+}
+
+// PositionID returns the ID of the slot at column of row, such as A1.
+func PositionID(row string, column int) string {
+	return row + strconv.Itoa(column)
+}
+
+// PlanogramSlots validates p and returns the slots it describes, one for
+// every coil in layout order. A slot is named by PositionID, its maximum
+// quantity is the capacity of the coil and it holds the soda assigned to it,
+// if any. Every problem found is reported in a single error wrapping
+// ErrInvalidPlanogram. Sodas may be given by catalog ID alone; resolving them
+// against the catalog is left to the caller.
+func PlanogramSlots(p v1.Planogram) ([]v1.VendingSlot, error) {
+	var problems []string
+	problem := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	if len(p.Layout.Rows) == 0 {
+		problem("layout has no rows")
+	}
+	var slots []v1.VendingSlot
+	index := map[string]int{}
+	rows := map[string]bool{}
+	for i, row := range p.Layout.Rows {
+		name := strings.TrimSpace(row.Row)
+		if name == "" {
+			problem("row %d has no name", i+1)
+			continue
+		}
+		if rows[strings.ToLower(name)] {
+			problem("row %q appears more than once", name)
+			continue
+		}
+		rows[strings.ToLower(name)] = true
+		if len(row.Coils) == 0 {
+			problem("row %q has no coils", name)
+		}
+		for _, coil := range row.Coils {
+			id := PositionID(name, coil.Column)
+			switch {
+			case coil.Column < 1:
+				problem("row %q has a coil in column %d, columns start at 1", name, coil.Column)
+				continue
+			case coil.Capacity < 1:
+				problem("coil %q must hold at least one soda", id)
+			}
+			if _, ok := index[strings.ToLower(id)]; ok {
+				problem("coil %q appears more than once", id)
+				continue
+			}
+			quantity, capacity := 0, coil.Capacity
+			index[strings.ToLower(id)] = len(slots)
+			slots = append(slots, v1.VendingSlot{
+				Id:          &id,
+				MaxQuantity: &capacity,
+				Position:    &v1.SlotPosition{Row: name, Column: coil.Column},
+				Quantity:    &quantity,
+			})
+		}
+	}
+
+	assigned := map[string]bool{}
+	sodas := map[string]string{}
+	for _, a := range deref(p.Assignments) {
+		i, ok := index[strings.ToLower(a.SlotId)]
+		if !ok {
+			problem("slot %q is not part of the layout", a.SlotId)
+			continue
+		}
+		slot := &slots[i]
+		id := *slot.Id
+		if assigned[id] {
+			problem("slot %q is assigned more than once", id)
+			continue
+		}
+		assigned[id] = true
+		soda := WithSodaID(a.Soda)
+		sodaID := SodaID(soda)
+		if sodaID == "" {
+			problem("the soda assigned to slot %q has neither an ID nor a name", id)
+			continue
+		}
+		if other, ok := sodas[sodaID]; ok && !sameSoda(soda, *slots[index[strings.ToLower(other)]].OccupiedSoda) {
+			problem("soda %q is defined differently in slots %q and %q", sodaID, other, id)
+		} else if !ok {
+			sodas[sodaID] = id
+		}
+		if a.Quantity != nil {
+			switch {
+			case *a.Quantity < 0:
+				problem("slot %q cannot hold a negative quantity", id)
+			case *a.Quantity > *slot.MaxQuantity:
+				problem("slot %q is loaded with %d sodas but its coil holds %d", id, *a.Quantity, *slot.MaxQuantity)
+			}
+			quantity := *a.Quantity
+			slot.Quantity = &quantity
+		}
+		switch {
+		case a.Price != nil:
+			if a.Price.Amount < 0 {
+				problem("slot %q cannot have a negative price", id)
+			}
+			SetPrice(slot, *a.Price)
+		case a.Cost != nil:
+			if *a.Cost < 0 {
+				problem("slot %q cannot have a negative price", id)
+			}
+			SetPrice(slot, NewMoney(*a.Cost, DefaultCurrency))
+		}
+		slot.OccupiedSoda = &soda
+	}
+
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPlanogram, strings.Join(problems, "; "))
+	}
+	return slots, nil
+}
+
+// sameSoda reports whether a and b agree on every field both of them set.
+func sameSoda(a, b v1.Soda) bool {
+	return agrees(a.Name, b.Name) && agrees(a.Description, b.Description) &&
+		agrees(a.OriginStory, b.OriginStory) && agrees(a.Calories, b.Calories) &&
+		agrees(a.Ounces, b.Ounces)
+}
+
+func agrees[T comparable](a, b *T) bool {
+	return a == nil || b == nil || *a == *b
+}
+
+func deref[T any](p *[]T) []T {
+	if p == nil {
+		return nil
+	}
+	return *p
+}
+
+// PlanogramFromSlots returns the planogram of the slots that have a
+// position. Rows are ordered by name and coils by column; slots without a
+// position are not part of the layout and are left out.
+func PlanogramFromSlots(slots []v1.VendingSlot) v1.Planogram {
+	var placed []v1.VendingSlot
+	for _, slot := range slots {
+		if slot.Position != nil {
+			placed = append(placed, slot)
+		}
+	}
+	sort.SliceStable(placed, func(i, j int) bool {
+		ri, rj := strings.ToLower(placed[i].Position.Row), strings.ToLower(placed[j].Position.Row)
+		if ri != rj {
+			return ri < rj
+		}
+		return placed[i].Position.Column < placed[j].Position.Column
+	})
+
+	p := v1.Planogram{Layout: v1.MachineLayout{Rows: []v1.LayoutRow{}}}
+	assignments := []v1.PlanogramAssignment{}
+	for _, slot := range placed {
+		rows := p.Layout.Rows
+		if len(rows) == 0 || !strings.EqualFold(rows[len(rows)-1].Row, slot.Position.Row) {
+			p.Layout.Rows = append(p.Layout.Rows, v1.LayoutRow{Row: slot.Position.Row})
+		}
+		row := &p.Layout.Rows[len(p.Layout.Rows)-1]
+		capacity := 0
+		if slot.MaxQuantity != nil {
+			capacity = *slot.MaxQuantity
+		}
+		row.Coils = append(row.Coils, v1.Coil{Column: slot.Position.Column, Capacity: capacity})
+		if slot.OccupiedSoda != nil {
+			slot = WithPrice(slot)
+			assignments = append(assignments, v1.PlanogramAssignment{
+				Cost:     slot.Cost,
+				Price:    slot.Price,
+				Quantity: slot.Quantity,
+				SlotId:   SlotID(slot),
+				Soda:     *slot.OccupiedSoda,
+			})
+		}
+	}
+	p.Assignments = &assignments
+	return p
+}
+
+// MarshalPlanogramYAML encodes p as YAML using the same field names as its
+// JSON encoding.
+func MarshalPlanogramYAML(p v1.Planogram) ([]byte, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	// JSON is YAML, so decoding it keeps the field names and their order;
+	// only the JSON flow and quoting styles need to go.
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+	blockStyle(&node)
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func blockStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
+
+// UnmarshalPlanogramYAML decodes a planogram written in YAML with the field
+// names of its JSON encoding.
+func UnmarshalPlanogramYAML(data []byte) (v1.Planogram, error) {
+	var doc any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return v1.Planogram{}, fmt.Errorf("%w: %w", ErrInvalidPlanogram, err)
+	}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return v1.Planogram{}, fmt.Errorf("%w: %w", ErrInvalidPlanogram, err)
+	}
+	var p v1.Planogram
+	if err := json.Unmarshal(data, &p); err != nil {
+		return v1.Planogram{}, fmt.Errorf("%w: %w", ErrInvalidPlanogram, err)
+	}
+	return p, nil
+}
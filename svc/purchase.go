@@ -0,0 +1,86 @@
+package svc
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// AtomicDecrementer is implemented by VendingStorageInterface backends that
+// can perform the purchase check-and-decrement natively. NewLegacyStore uses
+// it when available and otherwise emulates it under its own lock, which is
+// only atomic within a single process.
+type AtomicDecrementer interface {
+	DecrementIfAvailable(name string, payment v1.Money) (v1.VendingSlot, error)
+}
+
+// CheckPurchase reports whether slot can be sold for payment, returning
+// ErrSoldOut, ErrCurrencyMismatch or ErrInsufficientFunds when it cannot. A
+// slot without a price is not for sale and is treated as sold out. Backends
+// implementing AtomicDecrementer use it so that every backend applies the
+// same rules.
+func CheckPurchase(slot v1.VendingSlot, payment v1.Money) error {
+	if slot.Quantity == nil || *slot.Quantity <= 0 {
+		return ErrSoldOut
+	}
+	price := SlotPrice(slot)
+	if price == nil {
+		return fmt.Errorf("%w: soda has no price", ErrSoldOut)
+	}
+	if !strings.EqualFold(payment.Currency, price.Currency) {
+		return fmt.Errorf("%w: soda is priced in %s but paid in %s", ErrCurrencyMismatch, price.Currency, payment.Currency)
+	}
+	if payment.Amount < price.Amount {
+		return fmt.Errorf("%w: soda costs %v and you only provided %v", ErrInsufficientFunds, FormatMoney(*price), FormatMoney(payment))
+	}
+	return nil
+}
+
+// SlotID returns the ID of slot. Slots written before slots had IDs are
+// named after their soda, so the soda's name is used for them.
+func SlotID(slot v1.VendingSlot) string {
+	if slot.Id != nil && *slot.Id != "" {
+		return *slot.Id
+	}
+	if slot.OccupiedSoda != nil && slot.OccupiedSoda.Name != nil {
+		return *slot.OccupiedSoda.Name
+	}
+	return ""
+}
+
+// HoldsSoda reports whether slot holds the soda called, or with the catalog
+// ID, nameOrID. The comparison is case-insensitive.
+func HoldsSoda(slot v1.VendingSlot, nameOrID string) bool {
+	if slot.OccupiedSoda == nil {
+		return false
+	}
+	soda := *slot.OccupiedSoda
+	return SodaID(soda) == strings.ToLower(nameOrID) ||
+		soda.Name != nil && strings.EqualFold(*soda.Name, nameOrID)
+}
+
+// PurchaseCandidates returns the slots holding the soda nameOrID in the order
+// a purchase should try them: the fullest slot first, ties broken by slot ID.
+func PurchaseCandidates(slots []v1.VendingSlot, nameOrID string) []v1.VendingSlot {
+	var candidates []v1.VendingSlot
+	for _, slot := range slots {
+		if HoldsSoda(slot, nameOrID) {
+			candidates = append(candidates, slot)
+		}
+	}
+	quantity := func(slot v1.VendingSlot) int {
+		if slot.Quantity == nil {
+			return 0
+		}
+		return *slot.Quantity
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		qi, qj := quantity(candidates[i]), quantity(candidates[j])
+		if qi != qj {
+			return qi > qj
+		}
+		return strings.ToLower(SlotID(candidates[i])) < strings.ToLower(SlotID(candidates[j]))
+	})
+	return candidates
+}
@@ -0,0 +1,142 @@
+package svc
+
+import (
+	"bytes"
+	v1 "colaco-api/internal/api/v1"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// PeriodStart returns the start of the period of length bucket that t falls
+// in. Periods are in UTC and weeks start on Monday.
+func PeriodStart(t time.Time, bucket v1.ReportBucket) time.Time {
+	t = t.UTC()
+	switch bucket {
+	case v1.Hour:
+		return t.Truncate(time.Hour)
+	case v1.Week:
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		// Weekday counts from Sunday; shift it so Monday is 0.
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// salesKey groups the sales of a report row.
+type salesKey struct {
+	period   time.Time
+	sodaID   string
+	currency string
+}
+
+// SalesReport aggregates the purchases among txs recorded in [from, to) by
+// soda, currency and period of length bucket, and totals them per soda and
+// currency over the whole range. Revenue is the sum of the prices the sodas
+// sold for; the average price is rounded to the nearest minor unit.
+func SalesReport(txs []v1.Transaction, from, to time.Time, bucket v1.ReportBucket) v1.SalesReport {
+	rows := map[salesKey]*v1.SalesReportRow{}
+	totals := map[salesKey]*v1.SalesReportRow{}
+	add := func(rows map[salesKey]*v1.SalesReportRow, key salesKey, period *time.Time, name *string, price v1.Money) {
+		row, ok := rows[key]
+		if !ok {
+			row = &v1.SalesReportRow{
+				PeriodStart: period,
+				SodaId:      key.sodaID,
+				Revenue:     v1.Money{Currency: key.currency},
+			}
+			rows[key] = row
+		}
+		if row.SodaName == nil {
+			row.SodaName = name
+		}
+		row.UnitsSold++
+		row.Revenue.Amount += price.Amount
+	}
+
+	for _, tx := range txs {
+		if tx.Operation != v1.Purchase || tx.Price == nil ||
+			tx.Timestamp.Before(from) || !tx.Timestamp.Before(to) {
+			continue
+		}
+		sodaID := ""
+		if tx.SodaId != nil {
+			sodaID = *tx.SodaId
+		}
+		period := PeriodStart(tx.Timestamp, bucket)
+		add(rows, salesKey{period, sodaID, tx.Price.Currency}, &period, tx.SodaName, *tx.Price)
+		add(totals, salesKey{sodaID: sodaID, currency: tx.Price.Currency}, nil, tx.SodaName, *tx.Price)
+	}
+
+	return v1.SalesReport{
+		Bucket: bucket,
+		From:   from.UTC(),
+		To:     to.UTC(),
+		Rows:   sortedSales(rows),
+		Totals: sortedSales(totals),
+	}
+}
+
+func sortedSales(m map[salesKey]*v1.SalesReportRow) []v1.SalesReportRow {
+	keys := make([]salesKey, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		switch {
+		case !a.period.Equal(b.period):
+			return a.period.Before(b.period)
+		case a.sodaID != b.sodaID:
+			return a.sodaID < b.sodaID
+		}
+		return a.currency < b.currency
+	})
+	rows := []v1.SalesReportRow{}
+	for _, k := range keys {
+		row := *m[k]
+		row.AveragePrice = v1.Money{
+			Amount:   (2*row.Revenue.Amount + int64(row.UnitsSold)) / (2 * int64(row.UnitsSold)),
+			Currency: row.Revenue.Currency,
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// MarshalSalesReportCSV encodes the rows of r followed by its totals as CSV
+// with a header line. The period of the totals is left empty and amounts are
+// given in their major unit.
+func MarshalSalesReportCSV(r v1.SalesReport) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	records := [][]string{{"period_start", "soda_id", "soda_name", "units_sold", "revenue", "average_price", "currency"}}
+	for _, rows := range [][]v1.SalesReportRow{r.Rows, r.Totals} {
+		for _, row := range rows {
+			period := ""
+			if row.PeriodStart != nil {
+				period = row.PeriodStart.UTC().Format(time.RFC3339)
+			}
+			name := ""
+			if row.SodaName != nil {
+				name = *row.SodaName
+			}
+			records = append(records, []string{
+				period,
+				row.SodaId,
+				name,
+				strconv.Itoa(row.UnitsSold),
+				FormatAmount(row.Revenue),
+				FormatAmount(row.AveragePrice),
+				row.Revenue.Currency,
+			})
+		}
+	}
+	if err := w.WriteAll(records); err != nil {
+		return nil, fmt.Errorf("writing sales report: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,82 @@
+package svc
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"fmt"
+)
+
+// The scopes operations require in api.yml, granted to users through their
+// role.
+const (
+	ScopeVendingRead      = "vending:read"
+	ScopePurchaseWrite    = "purchase:write"
+	ScopeRestockWrite     = "restock:write"
+	ScopePriceWrite       = "price:write"
+	ScopeSlotsWrite       = "slots:write"
+	ScopePlanogramRead    = "planogram:read"
+	ScopeCashBoxRead      = "cashbox:read"
+	ScopeCashBoxWrite     = "cashbox:write"
+	ScopeTransactionsRead = "transactions:read"
+	ScopeReportsRead      = "reports:read"
+	ScopePeriodsWrite     = "periods:write"
+	ScopeAuditRead        = "audit:read"
+	ScopeUsersRead        = "users:read"
+	ScopeUsersWrite       = "users:write"
+	ScopeAPIKeysRead      = "apikeys:read"
+	ScopeAPIKeysWrite     = "apikeys:write"
+	ScopeAuditTrailRead   = "audittrail:read"
+)
+
+var (
+	customerScopes = []string{ScopeVendingRead, ScopePurchaseWrite}
+	operatorScopes = []string{ScopeRestockWrite, ScopePriceWrite, ScopeSlotsWrite, ScopePlanogramRead,
+		ScopeCashBoxRead, ScopeCashBoxWrite, ScopeTransactionsRead, ScopeReportsRead,
+		ScopePeriodsWrite, ScopeAuditRead}
+	adminScopes = []string{ScopeUsersRead, ScopeUsersWrite, ScopeAPIKeysRead, ScopeAPIKeysWrite,
+		ScopeAuditTrailRead}
+)
+
+// RoleScopes returns the scopes granted to role, each role getting those of
+// the roles below it as well. Unknown roles get none.
+func RoleScopes(role v1.Role) []string {
+	var scopes []string
+	switch role {
+	case v1.Admin:
+		scopes = append(scopes, adminScopes...)
+		fallthrough
+	case v1.Operator:
+		scopes = append(scopes, operatorScopes...)
+		fallthrough
+	case v1.Customer:
+		scopes = append(scopes, customerScopes...)
+	}
+	return scopes
+}
+
+// ValidRole returns ErrInvalidUser unless role is customer, operator or
+// admin.
+func ValidRole(role v1.Role) error {
+	switch role {
+	case v1.Customer, v1.Operator, v1.Admin:
+		return nil
+	}
+	return fmt.Errorf("%w: unknown role %q", ErrInvalidUser, role)
+}
+
+// UserRole returns the role of user. Users stored before roles existed have
+// none, and are admins if they were flagged as one and customers otherwise.
+func UserRole(user v1.User) v1.Role {
+	switch {
+	case user.Role != "":
+		return user.Role
+	case user.Admin != nil && *user.Admin:
+		return v1.Admin
+	}
+	return v1.Customer
+}
+
+// SetRole gives user role, keeping the deprecated admin flag in step.
+func SetRole(user *UserRecord, role v1.Role) {
+	admin := role == v1.Admin
+	user.Role, user.Admin = role, &admin
+}
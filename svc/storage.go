@@ -1,7 +1,18 @@
 package svc
 
-import v1 "colaco-api/internal/api/v1"
+import (
+	v1 "colaco-api/internal/api/v1"
+	"context"
+	"errors"
+	"strings"
+)
 
+// VendingStorageInterface is implemented by every slot storage backend. Slot
+// names are case-insensitive, GetSlots returns slots ordered by their
+// lower-cased name, and slots handed out or passed in are copies that do not
+// alias the stored state. DeleteSlot reports false when nothing was deleted,
+// while UpdatePrice and UpdateQuantity return an error for an unknown slot.
+// The storagetest package verifies all of this for an implementation.
 type VendingStorageInterface interface {
 	GetSlot(name string) (v1.VendingSlot, bool, error)
 	UpsertSlot(name string, slot v1.VendingSlot)
@@ -11,3 +22,108 @@ type VendingStorageInterface interface {
 	UpdateQuantity(name string, qty int) error
 	AddSlot(name string, slot v1.VendingSlot)
 }
+
+// LegacyStorage adapts a VendingStore to VendingStorageInterface, for callers
+// that have not moved to VendingStore yet; it is the reverse of LegacyStore.
+// AddSlot upserts like the older backends do, and a missing slot is reported
+// the way VendingStorageInterface expects rather than as ErrNotFound. The
+// older interface has no room for the errors of AddSlot, UpsertSlot and
+// GetSlots, so those are dropped and GetSlots returns nil; use the
+// VendingStore itself where they matter. It also serves the store's soda
+// catalog as a SodaCatalog and its atomic updates as a SlotUpdater.
+type LegacyStorage struct {
+	Store VendingStore
+}
+
+var (
+	_ VendingStorageInterface = (*LegacyStorage)(nil)
+	_ SodaCatalog             = (*LegacyStorage)(nil)
+	_ SlotUpdater             = (*LegacyStorage)(nil)
+)
+
+// NewLegacyStorage wraps s so that it can be used wherever a
+// VendingStorageInterface is expected.
+func NewLegacyStorage(s VendingStore) *LegacyStorage {
+	return &LegacyStorage{Store: s}
+}
+
+// found turns ErrNotFound into false, as VendingStorageInterface reports a
+// missing slot.
+func found(err error) (bool, error) {
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (l *LegacyStorage) GetSlot(name string) (v1.VendingSlot, bool, error) {
+	slot, err := l.Store.GetSlot(context.Background(), name)
+	ok, err := found(err)
+	return slot, ok, err
+}
+
+// UpsertSlot writes slot under its own ID when that names it in another
+// case, as VendingStore takes the name as the slot's ID and the older
+// backends keep the ID as given.
+func (l *LegacyStorage) UpsertSlot(name string, slot v1.VendingSlot) {
+	if slot.Id != nil && strings.EqualFold(*slot.Id, name) {
+		name = *slot.Id
+	}
+	_ = l.Store.UpsertSlot(context.Background(), name, slot)
+}
+
+func (l *LegacyStorage) GetSlots() []v1.VendingSlot {
+	slots, err := l.Store.GetSlots(context.Background())
+	if err != nil {
+		return nil
+	}
+	return slots
+}
+
+func (l *LegacyStorage) DeleteSlot(name string) (bool, error) {
+	return found(l.Store.DeleteSlot(context.Background(), name))
+}
+
+// UpdatePrice sets the price in the currency the slot is priced in.
+func (l *LegacyStorage) UpdatePrice(name string, price float32) error {
+	_, err := l.Store.UpdateSlot(context.Background(), name, func(slot *v1.VendingSlot) error {
+		SetPrice(slot, NewMoney(price, PriceCurrency(*slot)))
+		return nil
+	})
+	return err
+}
+
+func (l *LegacyStorage) UpdateQuantity(name string, qty int) error {
+	return l.Store.UpdateQuantity(context.Background(), name, qty)
+}
+
+func (l *LegacyStorage) AddSlot(name string, slot v1.VendingSlot) {
+	l.UpsertSlot(name, slot)
+}
+
+// GetSoda implements SodaCatalog.
+func (l *LegacyStorage) GetSoda(id string) (v1.Soda, bool, error) {
+	soda, err := l.Store.GetSoda(context.Background(), id)
+	ok, err := found(err)
+	return soda, ok, err
+}
+
+// GetSodas implements SodaCatalog. Like GetSlots it returns nil if the store
+// fails.
+func (l *LegacyStorage) GetSodas() []v1.Soda {
+	sodas, err := l.Store.GetSodas(context.Background())
+	if err != nil {
+		return nil
+	}
+	return sodas
+}
+
+// UpdateSlot implements SlotUpdater.
+func (l *LegacyStorage) UpdateSlot(name string, fn func(slot *v1.VendingSlot) error) (v1.VendingSlot, error) {
+	return l.Store.UpdateSlot(context.Background(), name, fn)
+}
+
+// DeleteSlotIf implements SlotUpdater.
+func (l *LegacyStorage) DeleteSlotIf(name string, check func(slot v1.VendingSlot) error) (v1.VendingSlot, error) {
+	return l.Store.DeleteSlotIf(context.Background(), name, check)
+}
@@ -0,0 +1,132 @@
+package svc
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"context"
+	"fmt"
+)
+
+// VendingStore is the context-aware successor of VendingStorageInterface.
+// Every method honours cancellation of ctx and reports failures with the
+// sentinel errors ErrNotFound, ErrConflict and ErrUnavailable, so that
+// network or disk backed stores can surface problems to the caller. Naming,
+// ordering and copy semantics are the same as VendingStorageInterface.
+//
+// Slots returned by a VendingStore carry both their exact Price and the
+// deprecated float Cost derived from it, see WithPrice.
+//
+// The name passed to the slot methods is the slot's ID, such as A1. Slots
+// refer to the sodas of a catalog by soda ID, see SodaCatalog, so the same
+// soda can occupy several slots.
+//
+// Existing VendingStorageInterface implementations can be used as a
+// VendingStore through NewLegacyStore.
+type VendingStore interface {
+	// GetSlot returns ErrNotFound when there is no slot called name.
+	GetSlot(ctx context.Context, name string) (v1.VendingSlot, error)
+	GetSlots(ctx context.Context) ([]v1.VendingSlot, error)
+	// QuerySlots returns the slots selected by q, in its order, see
+	// SlotQuery.
+	QuerySlots(ctx context.Context, q SlotQuery) ([]v1.VendingSlot, error)
+	// AddSlot returns ErrConflict when a slot called name already exists.
+	AddSlot(ctx context.Context, name string, slot v1.VendingSlot) error
+	UpsertSlot(ctx context.Context, name string, slot v1.VendingSlot) error
+	// DeleteSlot, UpdatePrice and UpdateQuantity return ErrNotFound when
+	// there is no slot called name.
+	DeleteSlot(ctx context.Context, name string) error
+	UpdatePrice(ctx context.Context, name string, price v1.Money) error
+	UpdateQuantity(ctx context.Context, name string, qty int) error
+	// DecrementIfAvailable is the purchase primitive. As one atomic unit it
+	// checks that the slot is in stock and that payment covers its price, then
+	// decrements its quantity, so replicas sharing a backend cannot oversell.
+	// It returns the slot after the decrement, or ErrNotFound, ErrSoldOut,
+	// ErrCurrencyMismatch or ErrInsufficientFunds. With the latter three the
+	// current slot is returned alongside the error so callers can report the
+	// price.
+	DecrementIfAvailable(ctx context.Context, name string, payment v1.Money) (v1.VendingSlot, error)
+	// UpdateSlot atomically reads the slot, lets fn modify it and writes it
+	// back with a bumped version, returning the written slot. If fn returns
+	// an error nothing is written and the error is returned unchanged, which
+	// lets callers enforce preconditions such as an expected version by
+	// returning ErrVersionMismatch.
+	UpdateSlot(ctx context.Context, name string, fn func(slot *v1.VendingSlot) error) (v1.VendingSlot, error)
+	// DeleteSlotIf atomically deletes the slot if check, given the current
+	// slot, returns nil, and returns the deleted slot. Otherwise the error
+	// from check is returned unchanged.
+	DeleteSlotIf(ctx context.Context, name string, check func(slot v1.VendingSlot) error) (v1.VendingSlot, error)
+	// ReplaceSlots atomically replaces every slot with the slots fn returns
+	// given the current ones, and returns the slots stored afterwards, see
+	// SlotReplacer. If fn returns an error nothing is written and the error
+	// is returned unchanged.
+	ReplaceSlots(ctx context.Context, fn func(slots []v1.VendingSlot) ([]v1.VendingSlot, error)) ([]v1.VendingSlot, error)
+	// GetSoda returns the catalog's soda with the given ID, or ErrNotFound.
+	// See SodaCatalog for how slots and the catalog relate.
+	GetSoda(ctx context.Context, id string) (v1.Soda, error)
+	// GetSodas returns the whole catalog ordered by soda ID.
+	GetSodas(ctx context.Context) ([]v1.Soda, error)
+	// GetCashBox returns the coins and bills held to give change, see
+	// CashBoxStorage.
+	GetCashBox(ctx context.Context) (v1.CashBox, error)
+	// UpdateCashBox atomically applies fn to the cash box and returns the
+	// stored result. If fn returns an error nothing is written and the error
+	// is returned unchanged.
+	UpdateCashBox(ctx context.Context, fn func(box *v1.CashBox) error) (v1.CashBox, error)
+	// AppendTransaction records tx in the append-only ledger, see
+	// LedgerStorage, stamping it with the current time unless it has one,
+	// and returns it with its ID.
+	AppendTransaction(ctx context.Context, tx v1.Transaction) (v1.Transaction, error)
+	// GetTransactions returns the ledger's transactions matching filter,
+	// oldest first.
+	GetTransactions(ctx context.Context, filter TransactionFilter) ([]v1.Transaction, error)
+	// AppendDayClose stores the end-of-day report of a closed period, see
+	// DayCloseStorage, and returns it with its ID. It returns ErrPeriodClosed
+	// if the period was already closed.
+	AppendDayClose(ctx context.Context, report v1.DayClose) (v1.DayClose, error)
+	// GetDayCloses returns the reports of every closed period, oldest first.
+	GetDayCloses(ctx context.Context) ([]v1.DayClose, error)
+	// GetDayClose returns the report of the closed period with the given ID,
+	// or ErrPeriodNotFound.
+	GetDayClose(ctx context.Context, id int64) (v1.DayClose, error)
+}
+
+// CheckContext reports a cancelled or expired context as ErrUnavailable while
+// keeping the context error in the chain.
+func CheckContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("%w: %w", ErrUnavailable, err)
+	}
+	return nil
+}
+
+// PrepareSlot readies slot for being written under name: name becomes its ID,
+// its soda carries its catalog ID and its price is set in both fields.
+func PrepareSlot(name string, slot *v1.VendingSlot) {
+	*slot = WithPrice(*slot)
+	slot.Id = &name
+	if slot.OccupiedSoda != nil {
+		soda := WithSodaID(*slot.OccupiedSoda)
+		slot.OccupiedSoda = &soda
+	}
+}
+
+// PricedUpdate wraps an UpdateSlot callback so that it sees the price in both
+// fields. A callback that only changes the deprecated Cost changes the price
+// to it, in the currency the slot was priced in.
+func PricedUpdate(fn func(slot *v1.VendingSlot) error) func(slot *v1.VendingSlot) error {
+	return func(slot *v1.VendingSlot) error {
+		before := WithPrice(*slot)
+		*slot = before
+		if err := fn(slot); err != nil {
+			return err
+		}
+		samePrice := slot.Price == nil && before.Price == nil ||
+			slot.Price != nil && before.Price != nil && *slot.Price == *before.Price
+		sameCost := slot.Cost == nil && before.Cost == nil ||
+			slot.Cost != nil && before.Cost != nil && *slot.Cost == *before.Cost
+		if samePrice && !sameCost && slot.Cost != nil {
+			SetPrice(slot, NewMoney(*slot.Cost, PriceCurrency(before)))
+		}
+		*slot = WithPrice(*slot)
+		return nil
+	}
+}
@@ -0,0 +1,141 @@
+package svc
+
+import (
+	v1 "colaco-api/internal/api/v1"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	// ErrUserNotFound is returned for a username that is not in the
+	// directory.
+	ErrUserNotFound = errors.New("user not found")
+	// ErrUserExists is returned when creating a user whose username is taken.
+	ErrUserExists = errors.New("user already exists")
+	// ErrInvalidCredentials is returned by Login for an unknown username or a
+	// wrong password, without telling which.
+	ErrInvalidCredentials = errors.New("invalid username and/or password")
+	// ErrUserDisabled is returned by Login for a user that was disabled.
+	ErrUserDisabled = errors.New("user is disabled")
+	// ErrInvalidUser is returned for a username or password that cannot be
+	// used.
+	ErrInvalidUser = errors.New("invalid user")
+)
+
+// MinPasswordLength is the number of characters a password needs at least.
+const MinPasswordLength = 8
+
+// UserRecord is a user of the directory together with the bcrypt hash of
+// their password. The hash is never returned by the API.
+type UserRecord struct {
+	v1.User
+	PasswordHash string `json:"passwordHash"`
+}
+
+// UserStore is the user directory. Usernames are case-insensitive.
+type UserStore interface {
+	// GetUser returns ErrUserNotFound when there is no such user.
+	GetUser(ctx context.Context, username string) (UserRecord, error)
+	// GetUsers returns every user ordered by username.
+	GetUsers(ctx context.Context) ([]UserRecord, error)
+	// CreateUser returns ErrUserExists when the username is taken.
+	CreateUser(ctx context.Context, user UserRecord) error
+	// UpdateUser atomically applies fn to the user, sets its UpdatedAt and
+	// returns the stored result. If fn returns an error nothing is written and the error is
+	// returned unchanged.
+	UpdateUser(ctx context.Context, username string, fn func(user *UserRecord) error) (UserRecord, error)
+}
+
+// HashPassword validates password and returns its bcrypt hash.
+func HashPassword(password string) (string, error) {
+	if utf8.RuneCountInString(password) < MinPasswordLength {
+		return "", fmt.Errorf("%w: passwords need at least %d characters", ErrInvalidUser, MinPasswordLength)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidUser, err)
+	}
+	return string(hash), nil
+}
+
+// NewUser returns the record of a new user with role and a hashed password.
+func NewUser(username, password string, role v1.Role) (UserRecord, error) {
+	username = strings.TrimSpace(username)
+	if username == "" || strings.ContainsAny(username, " \t\r\n/") {
+		return UserRecord{}, fmt.Errorf("%w: %q is not a valid username", ErrInvalidUser, username)
+	}
+	if err := ValidRole(role); err != nil {
+		return UserRecord{}, err
+	}
+	hash, err := HashPassword(password)
+	if err != nil {
+		return UserRecord{}, err
+	}
+	now := time.Now().UTC()
+	user := UserRecord{
+		User: v1.User{
+			Username:  username,
+			CreatedAt: &now,
+			UpdatedAt: &now,
+		},
+		PasswordHash: hash,
+	}
+	SetRole(&user, role)
+	return user, nil
+}
+
+// dummyHash is compared against when a username is unknown, so that logging
+// in as an unknown user takes as long as with a wrong password. It is only
+// generated once it is first needed.
+var dummyHash = sync.OnceValue(func() []byte {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("not a password"), bcrypt.DefaultCost)
+	return hash
+})
+
+// Login checks password against the user's hash. Unknown users and wrong
+// passwords are both ErrInvalidCredentials; a disabled user with the right
+// password is ErrUserDisabled. Other store errors are returned unchanged.
+func Login(ctx context.Context, users UserStore, username, password string) (UserRecord, error) {
+	user, err := users.GetUser(ctx, username)
+	if errors.Is(err, ErrUserNotFound) {
+		bcrypt.CompareHashAndPassword(dummyHash(), []byte(password))
+		return UserRecord{}, ErrInvalidCredentials
+	}
+	if err != nil {
+		return UserRecord{}, err
+	}
+	if bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)) != nil {
+		return UserRecord{}, ErrInvalidCredentials
+	}
+	if user.Disabled {
+		return UserRecord{}, ErrUserDisabled
+	}
+	return user, nil
+}
+
+// BootstrapAdmin creates an admin called username with password unless the
+// directory already has users. It reports whether the admin was created.
+func BootstrapAdmin(ctx context.Context, users UserStore, username, password string) (bool, error) {
+	existing, err := users.GetUsers(ctx)
+	if err != nil {
+		return false, err
+	}
+	if len(existing) > 0 {
+		return false, nil
+	}
+	admin, err := NewUser(username, password, v1.Admin)
+	if err != nil {
+		return false, err
+	}
+	if err := users.CreateUser(ctx, admin); err != nil {
+		return false, err
+	}
+	return true, nil
+}
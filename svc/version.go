@@ -0,0 +1,53 @@
+package svc
+
+import v1 "colaco-api/internal/api/v1"
+
+// SlotUpdater is implemented by VendingStorageInterface backends that
+// maintain slot versions themselves and can run the UpdateSlot and
+// DeleteSlotIf callbacks atomically. Such backends bump the version on every
+// write, including the plain VendingStorageInterface methods. NewLegacyStore
+// uses it when available; for other backends it stamps versions and
+// serializes the callbacks under its own lock.
+type SlotUpdater interface {
+	UpdateSlot(name string, fn func(slot *v1.VendingSlot) error) (v1.VendingSlot, error)
+	DeleteSlotIf(name string, check func(slot v1.VendingSlot) error) (v1.VendingSlot, error)
+}
+
+// SlotVersion returns the version of slot, or 0 if it has none.
+func SlotVersion(slot v1.VendingSlot) int64 {
+	if slot.Version == nil {
+		return 0
+	}
+	return *slot.Version
+}
+
+// SlotVersions hands out the versions of the slots of a store. A slot that
+// is created gets a version above any handed out before, so that a slot
+// deleted and created again under the same ID never gets back a version an
+// If-Match taken from the old slot could name. The zero value is ready to
+// use. It is not safe for concurrent use; stores guard it with their lock.
+type SlotVersions struct {
+	last int64
+}
+
+// Next stamps next with the version that follows prev, or with a new one
+// when prev is nil.
+func (s *SlotVersions) Next(prev, next *v1.VendingSlot) {
+	v := s.last + 1
+	if prev != nil {
+		v = SlotVersion(*prev) + 1
+	}
+	s.Observe(v)
+	next.Version = &v
+}
+
+// Observe records that version was handed out, for stores loading their
+// slots from elsewhere.
+func (s *SlotVersions) Observe(version int64) {
+	s.last = max(s.last, version)
+}
+
+// Last returns the highest version handed out, for stores to persist.
+func (s *SlotVersions) Last() int64 {
+	return s.last
+}